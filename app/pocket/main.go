@@ -3,15 +3,22 @@ package main
 import (
 	"flag"
 	"log"
+	"os"
 
 	"github.com/pokt-network/pocket/shared"
 	"github.com/pokt-network/pocket/shared/config"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
 )
 
 // See `docs/build/README.md` for details on how this is injected via mage.
 var version = "UNKNOWN"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export-genesis" {
+		exportGenesis(os.Args[2:])
+		return
+	}
+
 	config_filename := flag.String("config", "", "Relative or absolute path to config file.")
 	v := flag.Bool("version", false, "")
 	flag.Parse()
@@ -32,3 +39,59 @@ func main() {
 		log.Fatalf("Failed to start pocket node: %s", err)
 	}
 }
+
+// exportGenesis runs the node until it halts at `height`, then writes the state at `height` as the genesis of a new
+// chain. The genesis keeps the genesis time of the chain, so exporting the same height always writes the same file.
+func exportGenesis(args []string) {
+	flags := flag.NewFlagSet("export-genesis", flag.ExitOnError)
+	config_filename := flags.String("config", "", "Relative or absolute path to config file.")
+	height := flags.Int64("height", 0, "The height of the state to export; the node halts once the block before it is committed.")
+	output := flags.String("output", "genesis.json", "Relative or absolute path to write the genesis to.")
+	flags.Parse(args)
+
+	if *height <= 0 {
+		log.Fatalf("The height to export must be positive")
+	}
+
+	cfg := config.LoadConfig(*config_filename)
+	if cfg.Utility == nil {
+		cfg.Utility = &config.UtilityConfig{}
+	}
+	cfg.Utility.HaltHeight = *height
+
+	genesis, err := typesGenesis.PocketGenesisFromFileOrJSON(cfg.Genesis)
+	if err != nil {
+		log.Fatalf("Failed to load genesis: %s", err)
+	}
+
+	pocketNode, err := shared.Create(cfg)
+	if err != nil {
+		log.Fatalf("Failed to create pocket node: %s", err)
+	}
+
+	if err = pocketNode.Start(); err != nil {
+		log.Fatalf("Failed to start pocket node: %s", err)
+	}
+
+	genesisState, err := pocketNode.ExportGenesis(*height)
+	if err != nil {
+		log.Fatalf("Failed to export the state at height %d: %s", *height, err)
+	}
+
+	exported := &typesGenesis.Genesis{
+		GenesisTime:  genesis.GenesisTime,
+		AppHash:      typesGenesis.GetNodeState(nil).AppHash,
+		GenesisState: genesisState,
+	}
+	if err := exported.Validate(); err != nil {
+		log.Fatalf("Failed to validate the exported genesis: %s", err)
+	}
+	jsonBlob, err := exported.ToJSON()
+	if err != nil {
+		log.Fatalf("Failed to encode the exported genesis: %s", err)
+	}
+	if err := os.WriteFile(*output, jsonBlob, 0644); err != nil {
+		log.Fatalf("Failed to write the exported genesis: %s", err)
+	}
+	log.Printf("Exported the state at height %d to %s\n", *height, *output)
+}
@@ -34,12 +34,14 @@ func (m *consensusModule) prepareBlock() (*types.Block, error) {
 
 	appHash, err := m.utilityContext.ApplyBlock(int64(m.Height), m.privateKey.Address(), txs, lastByzValidators)
 	if err != nil {
+		m.haltIfUpgradeRequired(err)
 		return nil, err
 	}
 
 	blockHeader := &types.BlockHeader{
 		Height:            int64(m.Height),
 		Hash:              hex.EncodeToString(appHash),
+		NetworkId:         typesGenesis.GetNodeState(nil).GenesisState.ChainId, // signed with the block, see `typesCons.SignableBytes`
 		NumTxs:            uint32(len(txs)),
 		LastBlockHash:     typesGenesis.GetNodeState(nil).AppHash, // testing temporary
 		ProposerAddress:   m.privateKey.Address(),
@@ -71,6 +73,7 @@ func (m *consensusModule) applyBlock(block *types.Block) error {
 
 	appHash, err := m.utilityContext.ApplyBlock(int64(m.Height), m.privateKey.Address(), block.Transactions, lastByzValidators)
 	if err != nil {
+		m.haltIfUpgradeRequired(err)
 		return err
 	}
 
@@ -82,6 +85,16 @@ func (m *consensusModule) applyBlock(block *types.Block) error {
 	return nil
 }
 
+// haltIfUpgradeRequired stops the node when the utility refuses to apply a block because the protocol version of
+// this node is behind the scheduled upgrade, so its operator can restart it with the upgraded version
+func (m *consensusModule) haltIfUpgradeRequired(err error) {
+	if utilityErr, ok := err.(types.Error); !ok || utilityErr.Code() != types.CodeUpgradeRequiredError {
+		return
+	}
+	m.nodeLogError(typesCons.ErrUpgradeRequired.Error(), err)
+	m.GetBus().PublishEventToBus(&types.PocketEvent{Topic: types.PocketTopic_NODE_HALTED_TOPIC})
+}
+
 // Creates a new Utility context and clears/nullifies any previous contexts if they exist
 func (m *consensusModule) updateUtilityContext() error {
 	if m.utilityContext != nil {
@@ -105,9 +118,19 @@ func (m *consensusModule) commitBlock(block *types.Block) error {
 	if err := m.utilityContext.GetPersistenceContext().Commit(); err != nil {
 		return err
 	}
+	// the events are only published once the block is committed, so subscribers never see events of a reverted block
+	blockEvents, err := m.utilityContext.GetEvents()
+	if err != nil {
+		return err
+	}
+	m.GetBus().PublishEventToBus(&types.PocketEvent{Topic: types.PocketTopic_UTILITY_EVENTS_TOPIC, Data: blockEvents})
 	m.utilityContext.ReleaseContext()
 	m.utilityContext = nil
 
+	if err := m.GetBus().GetUtilityModule().ReconcileMempool(int64(m.Height)+1, block.Transactions); err != nil {
+		m.nodeLogError(typesCons.ErrReconcileMempool.Error(), err)
+	}
+
 	state := typesGenesis.GetNodeState(nil)
 	state.UpdateAppHash(block.BlockHeader.Hash)
 	state.UpdateBlockHeight(uint64(block.BlockHeader.Height))
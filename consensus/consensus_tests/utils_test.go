@@ -323,6 +323,10 @@ func baseUtilityMock(t *testing.T, _ modules.EventsChannel) *modulesMock.MockUti
 		NewContext(gomock.Any()).
 		Return(utilityContextMock, nil).
 		MaxTimes(4)
+	utilityMock.EXPECT().
+		ReconcileMempool(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
 
 	utilityContextMock.EXPECT().GetPersistenceContext().Return(persistenceContextMock).AnyTimes()
 	utilityContextMock.EXPECT().ReleaseContext().Return().AnyTimes()
@@ -336,6 +340,8 @@ func baseUtilityMock(t *testing.T, _ modules.EventsChannel) *modulesMock.MockUti
 		Return(appHash, nil).
 		AnyTimes()
 
+	utilityContextMock.EXPECT().GetEvents().Return(&anypb.Any{}, nil).AnyTimes()
+
 	persistenceContextMock.EXPECT().Commit().Return(nil).AnyTimes()
 
 	return utilityMock
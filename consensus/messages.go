@@ -7,7 +7,6 @@ import (
 
 	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/shared/crypto"
-	"google.golang.org/protobuf/proto"
 )
 
 func CreateProposeMessage(
@@ -87,11 +86,5 @@ func getMessageSignature(m *typesCons.HotstuffMessage, privKey crypto.PrivateKey
 
 // Signature should only be over a subset of the fields in a HotstuffMessage
 func getSignableBytes(m *typesCons.HotstuffMessage) ([]byte, error) {
-	msgToSign := &typesCons.HotstuffMessage{
-		Height: m.Height,
-		Step:   m.Step,
-		Round:  m.Round,
-		Block:  m.Block,
-	}
-	return proto.Marshal(msgToSign)
+	return typesCons.SignableBytes(m.Height, m.Step, m.Round, m.Block)
 }
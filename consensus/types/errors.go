@@ -149,6 +149,8 @@ const (
 	createConsensusMessageError                 = "error creating consensus message"
 	anteValidationError                         = "discarding hotstuff message because ante validation failed"
 	nilLeaderIdError                            = "attempting to send a message to leader when LeaderId is nil"
+	reconcileMempoolError                       = "could not reconcile the mempool after commit"
+	upgradeRequiredError                        = "halting the node until it runs the scheduled protocol upgrade"
 )
 
 var (
@@ -183,6 +185,8 @@ var (
 	ErrCreateConsensusMessage                 = errors.New(createConsensusMessageError)
 	ErrHotstuffValidation                     = errors.New(anteValidationError)
 	ErrNilLeaderId                            = errors.New(nilLeaderIdError)
+	ErrReconcileMempool                       = errors.New(reconcileMempoolError)
+	ErrUpgradeRequired                        = errors.New(upgradeRequiredError)
 )
 
 func ErrInvalidBlockSize(blockSize, maxSize uint64) error {
@@ -3,7 +3,9 @@ package types
 import (
 	"sort"
 
+	"github.com/pokt-network/pocket/shared/types"
 	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+	"google.golang.org/protobuf/proto"
 )
 
 type NodeId uint64
@@ -38,3 +40,15 @@ func GetValAddrToIdMap(validatorMap map[string]*typesGenesis.Validator) (ValAddr
 
 	return valToIdMap, idToValMap
 }
+
+// SignableBytes returns the bytes a validator signs in a hotstuff message, i.e. only its height, step, round and block.
+// The double sign evidence of the utility module is verified against the same bytes
+func SignableBytes(height uint64, step HotstuffStep, round uint64, block *types.Block) ([]byte, error) {
+	msgToSign := &HotstuffMessage{
+		Height: height,
+		Step:   step,
+		Round:  round,
+		Block:  block,
+	}
+	return proto.Marshal(msgToSign)
+}
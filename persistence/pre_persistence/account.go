@@ -2,6 +2,7 @@ package pre_persistence
 
 import (
 	"bytes"
+	"encoding/binary"
 
 	"github.com/pokt-network/pocket/shared/types"
 
@@ -233,6 +234,80 @@ func (m *PrePersistenceContext) SetAccountAmount(address []byte, amount string)
 	return db.Put(key, bz)
 }
 
+// GetAccountSequence returns the sequence the next transaction signed by `address` must have; zero if it never signed one
+func (m *PrePersistenceContext) GetAccountSequence(address []byte) (uint64, error) {
+	db := m.Store()
+	key := append(AccountSequencePrefixKey, address...)
+	if !db.Contains(key) {
+		return 0, nil
+	}
+	val, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(val), nil
+}
+
+func (m *PrePersistenceContext) SetAccountSequence(address []byte, sequence uint64) error {
+	db := m.Store()
+	key := append(AccountSequencePrefixKey, address...)
+	val := make([]byte, 8)
+	binary.BigEndian.PutUint64(val, sequence)
+	return db.Put(key, val)
+}
+
+// GetTotalSupply returns the amount of tokens in existence, i.e. in accounts and pools, as set at genesis and updated
+// by every mint and burn
+func (m *PrePersistenceContext) GetTotalSupply() (amount string, err error) {
+	db := m.Store()
+	if !db.Contains(TotalSupplyKey) {
+		return types.BigIntToString(big.NewInt(0)), nil
+	}
+	val, err := db.Get(TotalSupplyKey)
+	if err != nil {
+		return types.EmptyString, err
+	}
+	return string(val), nil
+}
+
+func (m *PrePersistenceContext) SetTotalSupply(amount string) error {
+	db := m.Store()
+	return db.Put(TotalSupplyKey, []byte(amount))
+}
+
+// GetChainId returns the chain id set at genesis
+func (m *PrePersistenceContext) GetChainId() (chainId string, err error) {
+	db := m.Store()
+	if !db.Contains(ChainIdKey) {
+		return types.EmptyString, nil
+	}
+	val, err := db.Get(ChainIdKey)
+	if err != nil {
+		return types.EmptyString, err
+	}
+	return string(val), nil
+}
+
+func (m *PrePersistenceContext) SetChainId(chainId string) error {
+	db := m.Store()
+	return db.Put(ChainIdKey, []byte(chainId))
+}
+
+// GetAccountVesting returns the serialized vesting schedule of `address`, or nil if it has none
+func (m *PrePersistenceContext) GetAccountVesting(address []byte) ([]byte, error) {
+	db := m.Store()
+	key := append(AccountVestingPrefixKey, address...)
+	if !db.Contains(key) {
+		return nil, nil
+	}
+	return db.Get(key)
+}
+
+func (m *PrePersistenceContext) SetAccountVesting(address []byte, vesting []byte) error {
+	db := m.Store()
+	return db.Put(append(AccountVestingPrefixKey, address...), vesting)
+}
+
 func (m *PrePersistenceContext) GetAllAccounts(height int64) (accs []*typesGenesis.Account, err error) {
 	codec := types.GetCodec()
 	accs = make([]*typesGenesis.Account, 0)
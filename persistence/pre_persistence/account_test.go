@@ -106,3 +106,46 @@ func TestGetAllPoolsAmount(t *testing.T) {
 		t.Fatal("not all pools returned")
 	}
 }
+
+func TestAccountSequence(t *testing.T) {
+	ctx := NewTestingPrePersistenceContext(t)
+	address := []byte("address")
+	sequence, err := ctx.GetAccountSequence(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sequence != 0 {
+		t.Fatalf("unexpected initial sequence, expected: %d got %d", 0, sequence)
+	}
+	if err := ctx.SetAccountSequence(address, 7); err != nil {
+		t.Fatal(err)
+	}
+	sequence, err = ctx.GetAccountSequence(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sequence != 7 {
+		t.Fatalf("unexpected sequence, expected: %d got %d", 7, sequence)
+	}
+}
+
+func TestTotalSupply(t *testing.T) {
+	ctx := NewTestingPrePersistenceContext(t)
+	totalSupply, err := ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalSupply != "0" {
+		t.Fatalf("unexpected initial total supply, expected: %s got %s", "0", totalSupply)
+	}
+	if err := ctx.SetTotalSupply("100"); err != nil {
+		t.Fatal(err)
+	}
+	totalSupply, err = ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalSupply != "100" {
+		t.Fatalf("unexpected total supply, expected: %s got %s", "100", totalSupply)
+	}
+}
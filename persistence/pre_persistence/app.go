@@ -317,3 +317,33 @@ func (m *PrePersistenceContext) GetAppOutputAddress(operator []byte) (output []b
 	}
 	return app.Output, nil
 }
+
+func (m *PrePersistenceContext) GetAppStakedTokens(address []byte) (tokens string, err error) {
+	app, err := m.GetApp(address)
+	if err != nil {
+		return types.EmptyString, err
+	}
+	if app == nil {
+		return types.EmptyString, fmt.Errorf("does not exist in world state")
+	}
+	return app.StakedTokens, nil
+}
+
+func (m *PrePersistenceContext) SetAppStakedTokensAndMaxRelays(address []byte, tokens string, maxRelays string) error {
+	codec := types.GetCodec()
+	db := m.Store()
+	app, err := m.GetApp(address)
+	if err != nil {
+		return err
+	}
+	if app == nil {
+		return fmt.Errorf("does not exist in world state")
+	}
+	app.StakedTokens = tokens
+	app.MaxRelays = maxRelays
+	bz, err := codec.Marshal(app)
+	if err != nil {
+		return err
+	}
+	return db.Put(append(AppPrefixKey, address...), bz)
+}
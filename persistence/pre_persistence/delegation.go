@@ -0,0 +1,73 @@
+package pre_persistence
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// SetDelegation saves the serialized delegation of `delegator` to `validator`
+func (m *PrePersistenceContext) SetDelegation(validator, delegator []byte, delegation []byte) error {
+	db := m.Store()
+	return db.Put(DelegationKey(validator, delegator), delegation)
+}
+
+// GetDelegation returns nil if `delegator` has not delegated to `validator`
+func (m *PrePersistenceContext) GetDelegation(validator, delegator []byte) (delegation []byte, err error) {
+	db := m.Store()
+	key := DelegationKey(validator, delegator)
+	if !db.Contains(key) {
+		return nil, nil
+	}
+	bz, err := db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(bz, DeletedPrefixKey) {
+		return nil, nil
+	}
+	return bz, nil
+}
+
+func (m *PrePersistenceContext) DeleteDelegation(validator, delegator []byte) error {
+	db := m.Store()
+	key := DelegationKey(validator, delegator)
+	if !db.Contains(key) {
+		return fmt.Errorf("does not exist in world state")
+	}
+	return db.Put(key, DeletedPrefixKey)
+}
+
+func (m *PrePersistenceContext) GetValidatorDelegations(validator []byte) (delegations [][]byte, err error) {
+	return m.getDelegations(DelegationValidatorKey(validator))
+}
+
+func (m *PrePersistenceContext) GetAllDelegations() (delegations [][]byte, err error) {
+	return m.getDelegations(DelegationPrefixKey)
+}
+
+func (m *PrePersistenceContext) getDelegations(prefix []byte) (delegations [][]byte, err error) {
+	db := m.Store()
+	it := db.NewIterator(util.BytesPrefix(prefix))
+	defer it.Release()
+	delegations = make([][]byte, 0)
+	for valid := it.First(); valid; valid = it.Next() {
+		if bytes.Equal(it.Value(), DeletedPrefixKey) {
+			continue
+		}
+		bz := make([]byte, len(it.Value()))
+		copy(bz, it.Value())
+		delegations = append(delegations, bz)
+	}
+	return delegations, nil
+}
+
+func DelegationKey(validator, delegator []byte) []byte {
+	return append(DelegationValidatorKey(validator), []byte(hex.EncodeToString(delegator))...)
+}
+
+func DelegationValidatorKey(validator []byte) []byte {
+	return []byte(fmt.Sprintf("%s%s/", DelegationPrefixKeyName, hex.EncodeToString(validator)))
+}
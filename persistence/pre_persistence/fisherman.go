@@ -301,3 +301,32 @@ func (m *PrePersistenceContext) GetFishermanOutputAddress(operator []byte) (outp
 	}
 	return fish.Output, nil
 }
+
+func (m *PrePersistenceContext) GetFishermanStakedTokens(address []byte) (tokens string, err error) {
+	fish, exists, err := m.GetFisherman(address)
+	if err != nil {
+		return types.EmptyString, err
+	}
+	if !exists {
+		return types.EmptyString, fmt.Errorf("does not exist in world state")
+	}
+	return fish.StakedTokens, nil
+}
+
+func (m *PrePersistenceContext) SetFishermanStakedTokens(address []byte, tokens string) error {
+	codec := types.GetCodec()
+	db := m.Store()
+	fish, exists, err := m.GetFisherman(address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("does not exist in world state")
+	}
+	fish.StakedTokens = tokens
+	bz, err := codec.Marshal(fish)
+	if err != nil {
+		return err
+	}
+	return db.Put(append(FishermanPrefixKey, address...), bz)
+}
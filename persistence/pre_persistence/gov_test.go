@@ -10,20 +10,16 @@ import (
 func TestGetAllParams(t *testing.T) {
 	ctx := NewTestingPrePersistenceContext(t)
 	expected := typesGenesis.DefaultParams()
-	err := ctx.(*PrePersistenceContext).SetParams(expected)
+	err := ctx.SetParams(expected)
 	if err != nil {
 		t.Fatal(err)
 	}
-	params, err := ctx.(*PrePersistenceContext).GetParams(0)
-	if err != nil {
-		t.Fatal(err)
-	}
-	fee, err := ctx.GetMessagePauseServiceNodeFee()
+	params, err := ctx.GetParams(0)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if params.BlocksPerSession != expected.BlocksPerSession ||
-		fee != expected.MessagePauseServiceNodeFee ||
+		params.MessagePauseServiceNodeFee != expected.MessagePauseServiceNodeFee ||
 		!bytes.Equal(params.MessageChangeParameterFeeOwner, params.MessageChangeParameterFeeOwner) {
 		t.Fatalf("wrong params, expected %v got %v", expected, params)
 	}
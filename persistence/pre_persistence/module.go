@@ -60,47 +60,114 @@ func InitGenesis(u *PrePersistenceContext, state *typesGenesis.GenesisState) err
 	if err := InsertPersistenceParams(u, state.Params); err != nil {
 		return err
 	}
+	if err := u.SetChainId(state.ChainId); err != nil {
+		return err
+	}
 	for _, account := range state.Accounts {
 		if err := u.SetAccountAmount(account.Address, account.Amount); err != nil {
 			return err
 		}
+		if account.Vesting == nil {
+			continue
+		}
+		vesting, err := types.GetCodec().Marshal(account.Vesting)
+		if err != nil {
+			return err
+		}
+		if err := u.SetAccountVesting(account.Address, vesting); err != nil {
+			return err
+		}
 	}
 	for _, p := range state.Pools {
 		if err := u.InsertPool(p.Name, p.Account.Address, p.Account.Amount); err != nil {
 			return err
 		}
 	}
+	// every token in existence at genesis is either in an account or in a pool, staked tokens included
+	totalSupply := big.NewInt(0)
+	for _, account := range state.Accounts {
+		amount, err := types.StringToBigInt(account.Amount)
+		if err != nil {
+			return err
+		}
+		totalSupply.Add(totalSupply, amount)
+	}
+	for _, p := range state.Pools {
+		amount, err := types.StringToBigInt(p.Account.Amount)
+		if err != nil {
+			return err
+		}
+		totalSupply.Add(totalSupply, amount)
+	}
+	if err := u.SetTotalSupply(types.BigIntToString(totalSupply)); err != nil {
+		return err
+	}
+	// the status, pause and unstaking heights of the actors are only set by an exported state, see `ExportState`
 	for _, validator := range state.Validators {
-		err := u.InsertValidator(validator.Address, validator.PublicKey, validator.Output, false, 2, validator.ServiceUrl, validator.StakedTokens, 0, 0)
+		err := u.InsertValidator(validator.Address, validator.PublicKey, validator.Output, validator.Paused, genesisStatus(validator.Status), validator.ServiceUrl, validator.StakedTokens, int64(validator.PausedHeight), validator.UnstakingHeight)
 		if err != nil {
 			return err
 		}
+		if err := u.SetValidatorMissedBlocks(validator.Address, int(validator.MissedBlocks)); err != nil {
+			return err
+		}
+		if err := u.SetValidatorCommission(validator.Address, int(validator.Commission)); err != nil {
+			return err
+		}
+		if err := u.SetValidatorDelegatedTokensAndShares(validator.Address, validator.DelegatedTokens, validator.DelegatorShares); err != nil {
+			return err
+		}
 	}
 	for _, fisherman := range state.Fishermen {
-		err := u.InsertFisherman(fisherman.Address, fisherman.PublicKey, fisherman.Output, false, 2, fisherman.ServiceUrl, fisherman.StakedTokens, fisherman.Chains, 0, 0)
+		err := u.InsertFisherman(fisherman.Address, fisherman.PublicKey, fisherman.Output, fisherman.Paused, genesisStatus(fisherman.Status), fisherman.ServiceUrl, fisherman.StakedTokens, fisherman.Chains, int64(fisherman.PausedHeight), fisherman.UnstakingHeight)
 		if err != nil {
 			return err
 		}
 	}
 	for _, serviceNode := range state.ServiceNodes {
-		err := u.InsertServiceNode(serviceNode.Address, serviceNode.PublicKey, serviceNode.Output, false, 2, serviceNode.ServiceUrl, serviceNode.StakedTokens, serviceNode.Chains, 0, 0)
+		err := u.InsertServiceNode(serviceNode.Address, serviceNode.PublicKey, serviceNode.Output, serviceNode.Paused, genesisStatus(serviceNode.Status), serviceNode.ServiceUrl, serviceNode.StakedTokens, serviceNode.Chains, int64(serviceNode.PausedHeight), serviceNode.UnstakingHeight)
 		if err != nil {
 			return err
 		}
+		if err := u.SetServiceNodeQoSScore(serviceNode.Address, int(serviceNode.QosScore), int(serviceNode.QosSamples)); err != nil {
+			return err
+		}
+		if err := u.SetServiceNodeJailedUntilHeight(serviceNode.Address, serviceNode.JailedUntilHeight); err != nil {
+			return err
+		}
 	}
 	for _, application := range state.Apps {
-		maxRelays, err := CalculateAppRelays(u, 0, application.StakedTokens)
+		maxRelays := application.MaxRelays
+		if maxRelays == "" {
+			var err error
+			maxRelays, err = CalculateAppRelays(u, 0, application.StakedTokens)
+			if err != nil {
+				return err
+			}
+		}
+		err := u.InsertApplication(application.Address, application.PublicKey, application.Output, application.Paused, genesisStatus(application.Status), maxRelays, application.StakedTokens, application.Chains, int64(application.PausedHeight), application.UnstakingHeight)
 		if err != nil {
 			return err
 		}
-		err = u.InsertApplication(application.Address, application.PublicKey, application.Output, false, 2, maxRelays, application.StakedTokens, application.Chains, 0, 0)
-		if err != nil {
+	}
+	// the entries of an exported state go last, as they overwrite the total supply computed above with the same value
+	db := u.Store()
+	for _, entry := range state.Entries {
+		if err := db.Put(entry.Key, entry.Value); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// genesisStatus defaults the status of the actors of a genesis that doesn't specify it to staked
+func genesisStatus(status int32) int {
+	if status == 0 {
+		return int(typesGenesis.DefaultStakeStatus)
+	}
+	return int(status)
+}
+
 // TODO(andrew): this is a state operation that really shouldn't live here, rather the utility module... but is needed for genesis creation
 func CalculateAppRelays(u *PrePersistenceContext, height int64, stakedTokens string) (string, error) {
 	tokens, err := types.StringToBigInt(stakedTokens)
@@ -119,22 +186,11 @@ func CalculateAppRelays(u *PrePersistenceContext, height int64, stakedTokens str
 	if err != nil {
 		return types.EmptyString, err
 	}
-	// convert tokens to int64
-	tokensFloat64 := big.NewFloat(float64(tokens.Int64()))
-	// get the percentage of the baseline stake rate (can be over 100%)
-	basePercentage := big.NewFloat(float64(baseRate) / float64(100))
-	// multiply the two
-	baselineThroughput := basePercentage.Mul(basePercentage, tokensFloat64)
-	// adjust for uPOKT
-	baselineThroughput.Quo(baselineThroughput, big.NewFloat(1000000))
+	// the baseline stake rate is a percentage (can be over 100%) of the stake in uPOKT
+	baselineThroughput := types.MulDiv(tokens, int64(baseRate), 100*1000000)
 	// add staking adjustment (can be negative)
-	adjusted := baselineThroughput.Add(baselineThroughput, big.NewFloat(float64(stakingAdjustment)))
-	// truncate the integer
-	result, _ := adjusted.Int(nil)
-	// bounding Max Amount of relays to maxint64
-	max := big.NewInt(math.MaxInt64)
-	if i := result.Cmp(max); i < -1 {
-		result = max
-	}
+	adjusted := baselineThroughput.Add(baselineThroughput, big.NewInt(int64(stakingAdjustment)))
+	// bounding Max Amount of relays to [0, maxint64]
+	result := types.BoundBigInt(adjusted, big.NewInt(0), big.NewInt(math.MaxInt64))
 	return types.BigIntToString(result), nil
 }
@@ -0,0 +1,147 @@
+package pre_persistence
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+)
+
+func TestExportStateInitGenesis(t *testing.T) {
+	ctx := NewTestingPrePersistenceContext(t).(*PrePersistenceContext)
+	if err := InitGenesis(ctx, typesGenesis.GetNodeState(nil).GenesisState); err != nil {
+		t.Fatal(err)
+	}
+	validators, err := ctx.GetAllValidators(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apps, err := ctx.GetAllApps(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts, err := ctx.GetAllAccounts(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the state of a chain that ran for a while: paused and unstaking actors, delegations, sequences and vesting
+	validator, app, account := validators[0].Address, apps[0].Address, accounts[0].Address
+	if err := ctx.SetValidatorPauseHeightAndMissedBlocks(validator, 1, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetValidatorCommission(validator, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetValidatorDelegatedTokensAndShares(validator, "100", "100"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetAppUnstakingHeightAndStatus(app, 10, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetAccountSequence(account, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetDelegation(validator, account, []byte("delegation")); err != nil {
+		t.Fatal(err)
+	}
+	vesting, err := types.GetCodec().Marshal(&typesGenesis.VestingSchedule{
+		LockedAmount: "10",
+		StartHeight:  1,
+		CliffHeight:  2,
+		EndHeight:    3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetAccountVesting(account, vesting); err != nil {
+		t.Fatal(err)
+	}
+	// and its records kept on behalf of the utility module: evidence, proposals, unbonding stakes, claimed relays,
+	// events and the transaction index
+	if err := ctx.SetDoubleSignEvidence(validator, 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetProposal(1, 5, []byte("proposal")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetProposalVote(1, validator, []byte("vote")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetUnbondingStake(5, []byte("unbonding"), []byte("unbonding_stake")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetClaimedRelays([]byte("session"), account, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.StoreTransaction("hash", 1, 0, account, validator, []byte("transaction_result")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.StoreBlockEvents(1, []byte("block_events")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.StoreStatusEvent(app, 1, 0, []byte("status_event")); err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ctx.AppHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exported := exportGenesisJSON(t, ctx)
+	if !bytes.Equal(exported, exportGenesisJSON(t, ctx)) {
+		t.Fatal("exporting the same state twice resulted in different genesis files")
+	}
+	genesis, err := typesGenesis.PocketGenesisFromJSON(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported := NewTestingPrePersistenceContext(t).(*PrePersistenceContext)
+	if err := InitGenesis(imported, genesis.GenesisState); err != nil {
+		t.Fatal(err)
+	}
+	actual, err := imported.AppHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, actual) {
+		t.Fatalf("unexpected app hash after importing the exported state, expected %x got %x", expected, actual)
+	}
+	if exists, _ := imported.GetDoubleSignEvidenceExists(validator, 1, 0); !exists {
+		t.Fatal("the double sign evidence was not imported")
+	}
+	if !imported.TransactionExists("hash") {
+		t.Fatal("the transaction index was not imported")
+	}
+	signed, _, err := imported.GetTransactionsBySigner(account, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signed) != 1 || !bytes.Equal(signed[0], []byte("transaction_result")) {
+		t.Fatalf("unexpected transactions of the signer after import %v", signed)
+	}
+	statusEvents, err := imported.GetStatusEvents(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statusEvents) != 1 {
+		t.Fatalf("unexpected number of status events after import, expected %d got %d", 1, len(statusEvents))
+	}
+}
+
+func exportGenesisJSON(t *testing.T, ctx *PrePersistenceContext) []byte {
+	state, err := ctx.ExportState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := &typesGenesis.Genesis{
+		GenesisTime:  time.Date(2022, 1, 19, 0, 0, 0, 0, time.UTC),
+		AppHash:      "exported_block_hash",
+		GenesisState: state,
+	}
+	bz, er := genesis.ToJSON()
+	if er != nil {
+		t.Fatal(er)
+	}
+	return bz
+}
@@ -1,6 +1,7 @@
 package pre_persistence
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -22,8 +23,15 @@ const (
 	DeletedPrefixKeyName              = "deleted/"
 	BlockPrefixName                   = "block/"
 	TransactionKeyPrefixName          = "transaction/"
+	TransactionSignerPrefixKeyName    = "transaction_signer/"
+	TransactionRecipientPrefixKeyName = "transaction_recipient/"
+	TransactionHeightPrefixKeyName    = "transaction_height/"
+	BlockEventsPrefixKeyName          = "block_events/"
+	StatusEventPrefixKeyName          = "status_event/"
 	PoolPrefixKeyName                 = "pool/"
 	AccountPrefixKeyName              = "account/"
+	AccountSequencePrefixKeyName      = "account_sequence/"
+	AccountVestingPrefixKeyName       = "account_vesting/"
 	AppPrefixKeyName                  = "app/"
 	UnstakingAppPrefixKeyName         = "unstaking_app/"
 	ServiceNodePrefixKeyName          = "service_node/"
@@ -33,6 +41,18 @@ const (
 	ValidatorPrefixKeyName            = "validator/"
 	UnstakingValidatorPrefixKeyName   = "unstaking_validator/"
 	ParamsPrefixKeyName               = "params/"
+	ProposalPrefixKeyName             = "proposal/"
+	ProposalVotingEndPrefixKeyName    = "proposal_voting_end/"
+	ProposalVotePrefixKeyName         = "proposal_vote/"
+	DoubleSignEvidencePrefixKeyName   = "double_sign_evidence/"
+	TotalSupplyKeyName                = "total_supply"
+	ChainIdKeyName                    = "chain_id"
+	UnbondingStakePrefixKeyName       = "unbonding_stake/"
+	DelegationPrefixKeyName           = "delegation/"
+	PendingParamChangePrefixKeyName   = "pending_param_change/"
+	UpgradeKeyName                    = "upgrade"
+	ClaimedRelaysPrefixKeyName        = "claimed_relays/"
+	TestScoreReportedPrefixKeyName    = "test_score_reported/"
 )
 
 var (
@@ -40,8 +60,15 @@ var (
 	DeletedPrefixKey                                         = []byte(DeletedPrefixKeyName)
 	BlockPrefix                                              = []byte(BlockPrefixName)
 	TransactionKeyPrefix                                     = []byte(TransactionKeyPrefixName)
+	TransactionSignerPrefixKey                               = []byte(TransactionSignerPrefixKeyName)
+	TransactionRecipientPrefixKey                            = []byte(TransactionRecipientPrefixKeyName)
+	TransactionHeightPrefixKey                               = []byte(TransactionHeightPrefixKeyName)
+	BlockEventsPrefixKey                                     = []byte(BlockEventsPrefixKeyName)
+	StatusEventPrefixKey                                     = []byte(StatusEventPrefixKeyName)
 	PoolPrefixKey                                            = []byte(PoolPrefixKeyName)
 	AccountPrefixKey                                         = []byte(AccountPrefixKeyName)
+	AccountSequencePrefixKey                                 = []byte(AccountSequencePrefixKeyName)
+	AccountVestingPrefixKey                                  = []byte(AccountVestingPrefixKeyName)
 	AppPrefixKey                                             = []byte(AppPrefixKeyName)
 	UnstakingAppPrefixKey                                    = []byte(UnstakingAppPrefixKeyName)
 	ServiceNodePrefixKey                                     = []byte(ServiceNodePrefixKeyName)
@@ -51,11 +78,52 @@ var (
 	ValidatorPrefixKey                                       = []byte(ValidatorPrefixKeyName)
 	UnstakingValidatorPrefixKey                              = []byte(UnstakingValidatorPrefixKeyName)
 	ParamsPrefixKey                                          = []byte(ParamsPrefixKeyName)
+	ProposalPrefixKey                                        = []byte(ProposalPrefixKeyName)
+	ProposalVotingEndPrefixKey                               = []byte(ProposalVotingEndPrefixKeyName)
+	ProposalVotePrefixKey                                    = []byte(ProposalVotePrefixKeyName)
+	DoubleSignEvidencePrefixKey                              = []byte(DoubleSignEvidencePrefixKeyName)
+	TotalSupplyKey                                           = []byte(TotalSupplyKeyName)
+	ChainIdKey                                               = []byte(ChainIdKeyName)
+	UnbondingStakePrefixKey                                  = []byte(UnbondingStakePrefixKeyName)
+	DelegationPrefixKey                                      = []byte(DelegationPrefixKeyName)
+	PendingParamChangePrefixKey                              = []byte(PendingParamChangePrefixKeyName)
+	UpgradeKey                                               = []byte(UpgradeKeyName)
+	ClaimedRelaysPrefixKey                                   = []byte(ClaimedRelaysPrefixKeyName)
+	TestScoreReportedPrefixKey                               = []byte(TestScoreReportedPrefixKeyName)
 	_                             modules.PersistenceModule  = &PrePersistenceModule{}
 	_                             modules.PersistenceContext = &PrePersistenceContext{}
 	elenEncoder                                              = lexnum.NewEncoder('=', '-')
 )
 
+// stateEntryPrefixes are the keys that `ExportState` exports as they are: the records persisted as bytes on behalf
+// of the utility module (including the double sign evidence, the events and the transaction index, so evidence can't
+// be replayed and history stays queryable after a restart), the indexes of the unstaking actors by height, and the
+// total supply
+var stateEntryPrefixes = [][]byte{
+	AccountSequencePrefixKey,
+	UnstakingAppPrefixKey,
+	UnstakingServiceNodePrefixKey,
+	UnstakingFishermanPrefixKey,
+	UnstakingValidatorPrefixKey,
+	ProposalPrefixKey,
+	ProposalVotingEndPrefixKey,
+	ProposalVotePrefixKey,
+	TotalSupplyKey,
+	UnbondingStakePrefixKey,
+	DelegationPrefixKey,
+	PendingParamChangePrefixKey,
+	UpgradeKey,
+	ClaimedRelaysPrefixKey,
+	TestScoreReportedPrefixKey,
+	DoubleSignEvidencePrefixKey,
+	BlockEventsPrefixKey,
+	StatusEventPrefixKey,
+	TransactionKeyPrefix,
+	TransactionSignerPrefixKey,
+	TransactionRecipientPrefixKey,
+	TransactionHeightPrefixKey,
+}
+
 type PrePersistenceModule struct { // TODO make private if possible
 	bus modules.Bus
 
@@ -82,9 +150,10 @@ func (m *PrePersistenceModule) NewContext(height int64) (modules.PersistenceCont
 		}
 	}
 	context := &PrePersistenceContext{
-		Height: height,
-		Parent: m,
-		DBs:    make([]*memdb.DB, 0),
+		Height:     height,
+		Parent:     m,
+		SavePoints: make(map[string]int),
+		DBs:        make([]*memdb.DB, 0),
 	}
 	context.DBs = append(context.DBs, newDB)
 	return context, nil
@@ -105,7 +174,9 @@ func (m *PrePersistenceContext) GetLatestBlockHeight() (uint64, error) {
 	return uint64(m.Height), nil
 }
 
-// ExportState Unused but high potential for usefulness for telemetry
+// ExportState returns the state of the context as a genesis that `InitGenesis` restores with the same `AppHash`.
+// The history of the chain (blocks, transactions, block events and double sign evidence) isn't part of the state
+// a chain restarts from, so it isn't exported
 func (m *PrePersistenceContext) ExportState() (*typesGenesis.GenesisState, types.Error) {
 	var err error
 	state := &typesGenesis.GenesisState{}
@@ -133,13 +204,55 @@ func (m *PrePersistenceContext) ExportState() (*typesGenesis.GenesisState, types
 	if err != nil {
 		return nil, types.ErrGetAllAccounts(err)
 	}
+	// the vesting schedules are stored apart from the balances
+	for _, account := range state.Accounts {
+		vesting, err := m.GetAccountVesting(account.Address)
+		if err != nil {
+			return nil, types.ErrExportState(err)
+		}
+		if vesting == nil {
+			continue
+		}
+		account.Vesting = &typesGenesis.VestingSchedule{}
+		if err := types.GetCodec().Unmarshal(vesting, account.Vesting); err != nil {
+			return nil, types.ErrExportState(err)
+		}
+	}
 	state.Params, err = m.GetParams(m.Height)
 	if err != nil {
 		return nil, types.ErrGetAllParams(err)
 	}
+	state.ChainId, err = m.GetChainId()
+	if err != nil {
+		return nil, types.ErrExportState(err)
+	}
+	state.Entries, err = m.getStateEntries()
+	if err != nil {
+		return nil, types.ErrExportState(err)
+	}
 	return state, nil
 }
 
+// getStateEntries returns the keys of `stateEntryPrefixes` in order, along with their values
+func (m *PrePersistenceContext) getStateEntries() ([]*typesGenesis.StateEntry, error) {
+	db := m.Store()
+	entries := make([]*typesGenesis.StateEntry, 0)
+	for _, prefix := range stateEntryPrefixes {
+		it := db.NewIterator(util.BytesPrefix(prefix))
+		for valid := it.First(); valid; valid = it.Next() {
+			if bytes.Equal(it.Value(), DeletedPrefixKey) {
+				continue
+			}
+			entries = append(entries, &typesGenesis.StateEntry{
+				Key:   CopyBytes(it.Key()),
+				Value: CopyBytes(it.Value()),
+			})
+		}
+		it.Release()
+	}
+	return entries, nil
+}
+
 // NewSavePoint Create a save point
 // Needed for atomic rollbacks in the case of failed transactions during proposal or blocks during validation
 func (m *PrePersistenceContext) NewSavePoint(bytes []byte) error {
@@ -179,6 +292,68 @@ func (m *PrePersistenceContext) RollbackToSavePoint(bytes []byte) error {
 	return nil
 }
 
+// ReleaseSavePoint forgets the latest save point while keeping the changes made after it, freeing the copy of the db
+// the save point would roll back to. Save points are released in the reverse order they were created
+func (m *PrePersistenceContext) ReleaseSavePoint(bytes []byte) error {
+	releaseIndex, ok := m.SavePoints[hex.EncodeToString(bytes)]
+	if !ok {
+		return fmt.Errorf("save point not found")
+	}
+	for _, i := range m.SavePoints {
+		if i > releaseIndex {
+			return fmt.Errorf("save point is not the latest")
+		}
+	}
+	delete(m.SavePoints, hex.EncodeToString(bytes))
+	// the latest db replaces the db the save point was copied from
+	last := len(m.DBs) - 1
+	for _, db := range m.DBs[releaseIndex-1 : last] {
+		db.Reset()
+	}
+	m.DBs[releaseIndex-1] = m.DBs[last]
+	m.DBs = m.DBs[:releaseIndex]
+	return nil
+}
+
+// GetStateChangesSinceSavePoint diffs the latest db against the db the save point would roll back to
+func (m *PrePersistenceContext) GetStateChangesSinceSavePoint(savePoint []byte) ([]*types.StateChange, error) {
+	index, ok := m.SavePoints[hex.EncodeToString(savePoint)]
+	if !ok {
+		return nil, fmt.Errorf("save point not found")
+	}
+	before, after := m.DBs[index-1], m.Store()
+	changes := make([]*types.StateChange, 0)
+	it := after.NewIterator(&util.Range{})
+	defer it.Release()
+	for valid := it.First(); valid; valid = it.Next() {
+		beforeValue, err := before.Get(it.Key())
+		if err != nil && err != memdb.ErrNotFound {
+			return nil, err
+		}
+		if bytes.Equal(beforeValue, it.Value()) {
+			continue
+		}
+		changes = append(changes, &types.StateChange{
+			Key:    CopyBytes(it.Key()),
+			Before: CopyBytes(beforeValue),
+			After:  CopyBytes(it.Value()),
+		})
+	}
+	// keys removed since the save point
+	beforeIt := before.NewIterator(&util.Range{})
+	defer beforeIt.Release()
+	for valid := beforeIt.First(); valid; valid = beforeIt.Next() {
+		if after.Contains(beforeIt.Key()) {
+			continue
+		}
+		changes = append(changes, &types.StateChange{
+			Key:    CopyBytes(beforeIt.Key()),
+			Before: CopyBytes(beforeIt.Value()),
+		})
+	}
+	return changes, nil
+}
+
 // AppHash creates a unique hash based on the global state object
 // NOTE: AppHash is an inefficient, arbitrary, mock implementation that enables the functionality
 // TODO written for replacement, taking any and all better implementation suggestions - even if a temporary measure
@@ -270,11 +445,6 @@ func (m *PrePersistenceContext) GetBlockHash(height int64) ([]byte, error) {
 	return []byte(block.BlockHeader.Hash), nil
 }
 
-func (m *PrePersistenceContext) TransactionExists(transactionHash string) bool {
-	db := m.Store()
-	return db.Contains(append(TransactionKeyPrefix, []byte(transactionHash)...))
-}
-
 func NewMemDB() *memdb.DB {
 	return memdb.New(comparer.DefaultComparer, 100000)
 }
@@ -291,6 +461,16 @@ func CopyMemDB(src, dest *memdb.DB) error {
 	return nil
 }
 
+// CopyBytes copies the bytes of an iterator key or value, which are only valid until the iterator moves
+func CopyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+	result := make([]byte, len(bz))
+	copy(result, bz)
+	return result
+}
+
 func HeightKey(height int64, k []byte) (key []byte) {
 	keyString := fmt.Sprintf("%s/%s", elenEncoder.EncodeInt(int(height)), k)
 	return []byte(keyString)
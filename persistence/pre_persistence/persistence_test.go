@@ -41,3 +41,47 @@ func genesisJson() string {
 		"app_hash": "genesis_block_or_state_hash"
 	}`, 42)
 }
+
+func TestReleaseSavePoint(t *testing.T) {
+	ctx := NewTestingPrePersistenceContext(t)
+	address := []byte("address")
+	if err := ctx.NewSavePoint([]byte("outer")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.NewSavePoint([]byte("inner")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetAccountSequence(address, 7); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ReleaseSavePoint([]byte("outer")); err == nil {
+		t.Fatal("released a save point that is not the latest")
+	}
+	if err := ctx.ReleaseSavePoint([]byte("inner")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ReleaseSavePoint([]byte("inner")); err == nil {
+		t.Fatal("released a save point twice")
+	}
+	sequence, err := ctx.GetAccountSequence(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sequence != 7 {
+		t.Fatalf("unexpected sequence after release, expected: %d got %d", 7, sequence)
+	}
+	if dbs := len(ctx.(*PrePersistenceContext).DBs); dbs != 2 {
+		t.Fatalf("unexpected number of dbs after release, expected: %d got %d", 2, dbs)
+	}
+	// the changes made after the released save point are still rolled back with the outer one
+	if err := ctx.RollbackToSavePoint([]byte("outer")); err != nil {
+		t.Fatal(err)
+	}
+	sequence, err = ctx.GetAccountSequence(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sequence != 0 {
+		t.Fatalf("unexpected sequence after rollback, expected: %d got %d", 0, sequence)
+	}
+}
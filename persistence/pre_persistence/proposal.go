@@ -0,0 +1,85 @@
+package pre_persistence
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func (m *PrePersistenceContext) GetProposalExists(proposalID uint64) (exists bool, err error) {
+	db := m.Store()
+	return db.Contains(ProposalKey(proposalID)), nil
+}
+
+// GetProposalCount returns the number of proposals ever submitted, which is also the id of the next proposal
+func (m *PrePersistenceContext) GetProposalCount() (count uint64, err error) {
+	db := m.Store()
+	it := db.NewIterator(util.BytesPrefix(ProposalPrefixKey))
+	defer it.Release()
+	for valid := it.First(); valid; valid = it.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// SetProposal saves the serialized proposal under its id and indexes the id by the height its voting period ends
+func (m *PrePersistenceContext) SetProposal(proposalID uint64, votingEndHeight int64, proposal []byte) error {
+	db := m.Store()
+	key := ProposalKey(proposalID)
+	if err := db.Put(key, proposal); err != nil {
+		return err
+	}
+	return db.Put(append(ProposalVotingEndKey(votingEndHeight), key...), key)
+}
+
+func (m *PrePersistenceContext) GetProposal(proposalID uint64) (proposal []byte, err error) {
+	db := m.Store()
+	return db.Get(ProposalKey(proposalID))
+}
+
+func (m *PrePersistenceContext) GetProposalsEndingAt(height int64) (proposals [][]byte, err error) {
+	db := m.Store()
+	it := db.NewIterator(util.BytesPrefix(ProposalVotingEndKey(height)))
+	defer it.Release()
+	proposals = make([][]byte, 0)
+	for valid := it.First(); valid; valid = it.Next() {
+		bz, err := db.Get(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, bz)
+	}
+	return proposals, nil
+}
+
+// SetProposalVote saves the serialized vote of `voter`, replacing any previous vote of theirs on the proposal
+func (m *PrePersistenceContext) SetProposalVote(proposalID uint64, voter []byte, vote []byte) error {
+	db := m.Store()
+	return db.Put(append(ProposalVotesKey(proposalID), []byte(hex.EncodeToString(voter))...), vote)
+}
+
+func (m *PrePersistenceContext) GetProposalVotes(proposalID uint64) (votes [][]byte, err error) {
+	db := m.Store()
+	it := db.NewIterator(util.BytesPrefix(ProposalVotesKey(proposalID)))
+	defer it.Release()
+	votes = make([][]byte, 0)
+	for valid := it.First(); valid; valid = it.Next() {
+		vote := make([]byte, len(it.Value()))
+		copy(vote, it.Value())
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+func ProposalKey(proposalID uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s", ProposalPrefixKeyName, elenEncoder.EncodeInt(int(proposalID))))
+}
+
+func ProposalVotingEndKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s%s/", ProposalVotingEndPrefixKeyName, elenEncoder.EncodeInt(int(height))))
+}
+
+func ProposalVotesKey(proposalID uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s/", ProposalVotePrefixKeyName, elenEncoder.EncodeInt(int(proposalID))))
+}
@@ -2,6 +2,7 @@ package pre_persistence
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/pokt-network/pocket/shared/types"
@@ -347,3 +348,107 @@ func (m *PrePersistenceContext) GetServiceNodeOutputAddress(operator []byte) (ou
 	}
 	return sn.Output, nil
 }
+
+func (m *PrePersistenceContext) GetServiceNodeStakedTokens(address []byte) (tokens string, err error) {
+	sn, exists, err := m.GetServiceNode(address)
+	if err != nil {
+		return types.EmptyString, err
+	}
+	if !exists {
+		return types.EmptyString, fmt.Errorf("does not exist in world state")
+	}
+	return sn.StakedTokens, nil
+}
+
+func (m *PrePersistenceContext) SetServiceNodeStakedTokens(address []byte, tokens string) error {
+	codec := types.GetCodec()
+	db := m.Store()
+	sn, exists, err := m.GetServiceNode(address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("does not exist in world state")
+	}
+	sn.StakedTokens = tokens
+	bz, err := codec.Marshal(sn)
+	if err != nil {
+		return err
+	}
+	return db.Put(append(ServiceNodePrefixKey, address...), bz)
+}
+
+func (m *PrePersistenceContext) SetServiceNodeQoSScore(address []byte, score int, samples int) error {
+	codec := types.GetCodec()
+	db := m.Store()
+	sn, exists, err := m.GetServiceNode(address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("does not exist in world state")
+	}
+	sn.QosScore = uint32(score)
+	sn.QosSamples = uint32(samples)
+	bz, err := codec.Marshal(sn)
+	if err != nil {
+		return err
+	}
+	return db.Put(append(ServiceNodePrefixKey, address...), bz)
+}
+
+func (m *PrePersistenceContext) SetServiceNodeJailedUntilHeight(address []byte, height int64) error {
+	codec := types.GetCodec()
+	db := m.Store()
+	sn, exists, err := m.GetServiceNode(address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("does not exist in world state")
+	}
+	sn.JailedUntilHeight = height
+	bz, err := codec.Marshal(sn)
+	if err != nil {
+		return err
+	}
+	return db.Put(append(ServiceNodePrefixKey, address...), bz)
+}
+
+func (m *PrePersistenceContext) GetClaimedRelays(sessionKey, address []byte) (uint64, error) {
+	db := m.Store()
+	key := ClaimedRelaysKey(sessionKey, address)
+	if !db.Contains(key) {
+		return 0, nil
+	}
+	val, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(val), nil
+}
+
+func (m *PrePersistenceContext) SetClaimedRelays(sessionKey, address []byte, relays uint64) error {
+	db := m.Store()
+	val := make([]byte, 8)
+	binary.BigEndian.PutUint64(val, relays)
+	return db.Put(ClaimedRelaysKey(sessionKey, address), val)
+}
+
+func ClaimedRelaysKey(sessionKey, address []byte) []byte {
+	return bytes.Join([][]byte{ClaimedRelaysPrefixKey, sessionKey, address}, nil)
+}
+
+func (m *PrePersistenceContext) GetTestScoreReported(sessionKey, address []byte) (bool, error) {
+	db := m.Store()
+	return db.Contains(TestScoreReportedKey(sessionKey, address)), nil
+}
+
+func (m *PrePersistenceContext) SetTestScoreReported(sessionKey, address []byte) error {
+	db := m.Store()
+	return db.Put(TestScoreReportedKey(sessionKey, address), []byte{})
+}
+
+func TestScoreReportedKey(sessionKey, address []byte) []byte {
+	return bytes.Join([][]byte{TestScoreReportedPrefixKey, sessionKey, address}, nil)
+}
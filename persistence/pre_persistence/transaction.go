@@ -0,0 +1,121 @@
+package pre_persistence
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pokt-network/pocket/shared/types"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func (m *PrePersistenceContext) TransactionExists(transactionHash string) bool {
+	db := m.Store()
+	return db.Contains(append(TransactionKeyPrefix, []byte(transactionHash)...))
+}
+
+// StoreTransaction saves the serialized transaction result under its hash and indexes the hash by signer,
+// recipient (if any) and height. The index keys embed the height and index in the block so iterating a
+// prefix returns the results in block order
+func (m *PrePersistenceContext) StoreTransaction(transactionHash string, height int64, index int, signer []byte, recipient []byte, transactionResult []byte) error {
+	db := m.Store()
+	hash := []byte(transactionHash)
+	if err := db.Put(append(TransactionKeyPrefix, hash...), transactionResult); err != nil {
+		return err
+	}
+	position := TransactionPositionKey(height, index)
+	if err := db.Put(append(TransactionHeightPrefixKey, position...), hash); err != nil {
+		return err
+	}
+	if err := db.Put(TransactionAddressKey(TransactionSignerPrefixKey, signer, position), hash); err != nil {
+		return err
+	}
+	if recipient == nil {
+		return nil
+	}
+	return db.Put(TransactionAddressKey(TransactionRecipientPrefixKey, recipient, position), hash)
+}
+
+func (m *PrePersistenceContext) GetTransactionByHash(transactionHash string) (transactionResult []byte, err error) {
+	db := m.Store()
+	return db.Get(append(TransactionKeyPrefix, []byte(transactionHash)...))
+}
+
+func (m *PrePersistenceContext) GetTransactionsBySigner(signer []byte, page, perPage int) (transactionResults [][]byte, totalCount int, err error) {
+	return m.getTransactionsByIndex(append(TransactionSignerPrefixKey, []byte(hex.EncodeToString(signer)+"/")...), page, perPage)
+}
+
+func (m *PrePersistenceContext) GetTransactionsByRecipient(recipient []byte, page, perPage int) (transactionResults [][]byte, totalCount int, err error) {
+	return m.getTransactionsByIndex(append(TransactionRecipientPrefixKey, []byte(hex.EncodeToString(recipient)+"/")...), page, perPage)
+}
+
+func (m *PrePersistenceContext) GetTransactionsByHeight(height int64, page, perPage int) (transactionResults [][]byte, totalCount int, err error) {
+	return m.getTransactionsByIndex(append(TransactionHeightPrefixKey, []byte(elenEncoder.EncodeInt(int(height))+"/")...), page, perPage)
+}
+
+// StoreBlockEvents saves the serialized events of the block at `height`
+func (m *PrePersistenceContext) StoreBlockEvents(height int64, blockEvents []byte) error {
+	db := m.Store()
+	return db.Put(append(BlockEventsPrefixKey, []byte(elenEncoder.EncodeInt(int(height)))...), blockEvents)
+}
+
+// GetBlockEvents returns nil if no events were stored at `height`
+func (m *PrePersistenceContext) GetBlockEvents(height int64) (blockEvents []byte, err error) {
+	db := m.Store()
+	key := append(BlockEventsPrefixKey, []byte(elenEncoder.EncodeInt(int(height)))...)
+	if !db.Contains(key) {
+		return nil, nil
+	}
+	return db.Get(key)
+}
+
+// StoreStatusEvent indexes the serialized event that changed the status of the actor at `address` by its position in
+// the block, so iterating the prefix of the actor returns its status history from oldest to newest
+func (m *PrePersistenceContext) StoreStatusEvent(address []byte, height int64, index int, event []byte) error {
+	db := m.Store()
+	return db.Put(TransactionAddressKey(StatusEventPrefixKey, address, TransactionPositionKey(height, index)), event)
+}
+
+func (m *PrePersistenceContext) GetStatusEvents(address []byte) (events [][]byte, err error) {
+	db := m.Store()
+	it := db.NewIterator(util.BytesPrefix(append(StatusEventPrefixKey, []byte(hex.EncodeToString(address)+"/")...)))
+	defer it.Release()
+	events = make([][]byte, 0)
+	for valid := it.First(); valid; valid = it.Next() {
+		event := make([]byte, len(it.Value()))
+		copy(event, it.Value())
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// getTransactionsByIndex pages through the index entries under `prefix` from newest to oldest. `page` is one-based
+func (m *PrePersistenceContext) getTransactionsByIndex(prefix []byte, page, perPage int) (transactionResults [][]byte, totalCount int, err error) {
+	if page < 1 || perPage < 1 {
+		return nil, 0, types.ErrInvalidPagination(page, perPage)
+	}
+	db := m.Store()
+	it := db.NewIterator(util.BytesPrefix(prefix))
+	defer it.Release()
+	skip := (page - 1) * perPage
+	transactionResults = make([][]byte, 0)
+	for valid := it.Last(); valid; valid = it.Prev() {
+		totalCount++
+		if totalCount <= skip || len(transactionResults) == perPage {
+			continue
+		}
+		bz, err := db.Get(append(TransactionKeyPrefix, it.Value()...))
+		if err != nil {
+			return nil, 0, err
+		}
+		transactionResults = append(transactionResults, bz)
+	}
+	return transactionResults, totalCount, nil
+}
+
+func TransactionPositionKey(height int64, index int) []byte {
+	return []byte(fmt.Sprintf("%s/%s", elenEncoder.EncodeInt(int(height)), elenEncoder.EncodeInt(index)))
+}
+
+func TransactionAddressKey(prefix []byte, address []byte, position []byte) []byte {
+	return append(append(prefix, []byte(hex.EncodeToString(address)+"/")...), position...)
+}
@@ -0,0 +1,100 @@
+package pre_persistence
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/modules"
+)
+
+func TestStoreTransaction(t *testing.T) {
+	ctx := NewTestingPrePersistenceContext(t)
+	signer, recipient := []byte("signer"), []byte("recipient")
+	hash := "hash"
+	result := []byte("result")
+	if err := ctx.StoreTransaction(hash, 0, 0, signer, recipient, result); err != nil {
+		t.Fatal(err)
+	}
+	if !ctx.TransactionExists(hash) {
+		t.Fatal("transaction should exist after being stored")
+	}
+	got, err := ctx.GetTransactionByHash(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, result) {
+		t.Fatalf("unexpected transaction result; expected %s got %s", result, got)
+	}
+	for _, results := range [][][]byte{
+		getTransactionsBySigner(t, ctx, signer, 1, 10),
+		getTransactionsByRecipient(t, ctx, recipient, 1, 10),
+		getTransactionsByHeight(t, ctx, 0, 1, 10),
+	} {
+		if len(results) != 1 || !bytes.Equal(results[0], result) {
+			t.Fatalf("unexpected indexed results; expected [%s] got %s", result, results)
+		}
+	}
+}
+
+func TestGetTransactionsPagination(t *testing.T) {
+	ctx := NewTestingPrePersistenceContext(t)
+	signer := []byte("signer")
+	numOfTransactions := 5
+	for i := 0; i < numOfTransactions; i++ {
+		// spread over two heights to verify the ordering crosses blocks
+		height := int64(i / 3)
+		result := []byte(fmt.Sprintf("result%d", i))
+		if err := ctx.StoreTransaction(fmt.Sprintf("hash%d", i), height, i, signer, nil, result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	results, totalCount, err := ctx.GetTransactionsBySigner(signer, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalCount != numOfTransactions {
+		t.Fatalf("unexpected total count; expected %d got %d", numOfTransactions, totalCount)
+	}
+	// newest first
+	if len(results) != 2 || string(results[0]) != "result4" || string(results[1]) != "result3" {
+		t.Fatalf("unexpected first page; got %s", results)
+	}
+	results = getTransactionsBySigner(t, ctx, signer, 3, 2)
+	if len(results) != 1 || string(results[0]) != "result0" {
+		t.Fatalf("unexpected last page; got %s", results)
+	}
+	if results = getTransactionsBySigner(t, ctx, signer, 4, 2); len(results) != 0 {
+		t.Fatalf("expected an empty page; got %s", results)
+	}
+	if results = getTransactionsByRecipient(t, ctx, signer, 1, 10); len(results) != 0 {
+		t.Fatalf("transactions without a recipient should not be indexed by recipient; got %s", results)
+	}
+	if _, _, err := ctx.GetTransactionsBySigner(signer, 0, 2); err == nil {
+		t.Fatal("expected an error for a zero page")
+	}
+}
+
+func getTransactionsBySigner(t *testing.T, ctx modules.PersistenceContext, signer []byte, page, perPage int) [][]byte {
+	results, _, err := ctx.GetTransactionsBySigner(signer, page, perPage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return results
+}
+
+func getTransactionsByRecipient(t *testing.T, ctx modules.PersistenceContext, recipient []byte, page, perPage int) [][]byte {
+	results, _, err := ctx.GetTransactionsByRecipient(recipient, page, perPage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return results
+}
+
+func getTransactionsByHeight(t *testing.T, ctx modules.PersistenceContext, height int64, page, perPage int) [][]byte {
+	results, _, err := ctx.GetTransactionsByHeight(height, page, perPage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return results
+}
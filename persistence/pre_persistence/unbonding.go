@@ -0,0 +1,74 @@
+package pre_persistence
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// SetUnbondingStake saves the serialized unbonding stake under the height it is released at; `id` tells it apart from
+// the other unbonding stakes released at that height
+func (m *PrePersistenceContext) SetUnbondingStake(releaseHeight int64, id []byte, unbondingStake []byte) error {
+	db := m.Store()
+	return db.Put(UnbondingStakeKey(releaseHeight, id), unbondingStake)
+}
+
+// GetUnbondingStake returns nil if nothing is unbonding under `id` until `releaseHeight`
+func (m *PrePersistenceContext) GetUnbondingStake(releaseHeight int64, id []byte) (unbondingStake []byte, err error) {
+	db := m.Store()
+	key := UnbondingStakeKey(releaseHeight, id)
+	if !db.Contains(key) {
+		return nil, nil
+	}
+	bz, err := db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(bz, DeletedPrefixKey) {
+		return nil, nil
+	}
+	return bz, nil
+}
+
+func (m *PrePersistenceContext) GetUnbondingStakesReleasedAt(height int64) (unbondingStakes [][]byte, err error) {
+	return m.getUnbondingStakes(UnbondingStakeHeightKey(height))
+}
+
+func (m *PrePersistenceContext) GetAllUnbondingStakes() (unbondingStakes [][]byte, err error) {
+	return m.getUnbondingStakes(UnbondingStakePrefixKey)
+}
+
+func (m *PrePersistenceContext) DeleteUnbondingStake(releaseHeight int64, id []byte) error {
+	db := m.Store()
+	key := UnbondingStakeKey(releaseHeight, id)
+	if !db.Contains(key) {
+		return fmt.Errorf("does not exist in world state")
+	}
+	return db.Put(key, DeletedPrefixKey)
+}
+
+func (m *PrePersistenceContext) getUnbondingStakes(prefix []byte) (unbondingStakes [][]byte, err error) {
+	db := m.Store()
+	it := db.NewIterator(util.BytesPrefix(prefix))
+	defer it.Release()
+	unbondingStakes = make([][]byte, 0)
+	for valid := it.First(); valid; valid = it.Next() {
+		if bytes.Equal(it.Value(), DeletedPrefixKey) {
+			continue
+		}
+		bz := make([]byte, len(it.Value()))
+		copy(bz, it.Value())
+		unbondingStakes = append(unbondingStakes, bz)
+	}
+	return unbondingStakes, nil
+}
+
+func UnbondingStakeKey(releaseHeight int64, id []byte) []byte {
+	return append(UnbondingStakeHeightKey(releaseHeight), []byte(hex.EncodeToString(id))...)
+}
+
+func UnbondingStakeHeightKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s%s/", UnbondingStakePrefixKeyName, elenEncoder.EncodeInt(int(height))))
+}
@@ -2,6 +2,7 @@ package pre_persistence
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/pokt-network/pocket/shared/types"
@@ -370,6 +371,43 @@ func (m *PrePersistenceContext) GetValidatorStakedTokens(address []byte) (tokens
 	return val.StakedTokens, nil
 }
 
+func (m *PrePersistenceContext) SetValidatorCommission(address []byte, commission int) error {
+	codec := types.GetCodec()
+	db := m.Store()
+	val, exists, err := m.GetValidator(address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("does not exist in world state")
+	}
+	val.Commission = int32(commission)
+	bz, err := codec.Marshal(val)
+	if err != nil {
+		return err
+	}
+	return db.Put(append(ValidatorPrefixKey, address...), bz)
+}
+
+func (m *PrePersistenceContext) SetValidatorDelegatedTokensAndShares(address []byte, tokens string, shares string) error {
+	codec := types.GetCodec()
+	db := m.Store()
+	val, exists, err := m.GetValidator(address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("does not exist in world state")
+	}
+	val.DelegatedTokens = tokens
+	val.DelegatorShares = shares
+	bz, err := codec.Marshal(val)
+	if err != nil {
+		return err
+	}
+	return db.Put(append(ValidatorPrefixKey, address...), bz)
+}
+
 func (m *PrePersistenceContext) GetValidatorOutputAddress(operator []byte) (output []byte, err error) {
 	val, exists, err := m.GetValidator(operator)
 	if err != nil {
@@ -380,3 +418,18 @@ func (m *PrePersistenceContext) GetValidatorOutputAddress(operator []byte) (outp
 	}
 	return val.Output, nil
 }
+
+// SetDoubleSignEvidence records that `address` was slashed for double signing at `height` and `round`
+func (m *PrePersistenceContext) SetDoubleSignEvidence(address []byte, height int64, round uint32) error {
+	db := m.Store()
+	return db.Put(DoubleSignEvidenceKey(address, height, round), address)
+}
+
+func (m *PrePersistenceContext) GetDoubleSignEvidenceExists(address []byte, height int64, round uint32) (exists bool, err error) {
+	db := m.Store()
+	return db.Contains(DoubleSignEvidenceKey(address, height, round)), nil
+}
+
+func DoubleSignEvidenceKey(address []byte, height int64, round uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s/%d/%s", DoubleSignEvidencePrefixKeyName, elenEncoder.EncodeInt(int(height)), round, hex.EncodeToString(address)))
+}
@@ -83,6 +83,12 @@ type PersistenceConfig struct {
 }
 
 type UtilityConfig struct {
+	// CheckInvariants checks that no tokens are created or destroyed outside of mints and burns after every block and
+	// halts the node with a diagnostic report if they are. Meant for debugging and testing, as the check reads all state
+	CheckInvariants bool `json:"check_invariants"`
+	// HaltHeight stops the node once the block before it is committed, leaving the state at `HaltHeight` to be
+	// exported as the genesis of a new chain (see `export-genesis`). Zero never halts
+	HaltHeight int64 `json:"halt_height"`
 }
 
 // TODO(insert tooling issue # here): Re-evaluate how load configs should be handeled.
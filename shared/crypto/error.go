@@ -15,6 +15,9 @@ const (
 	CreatePrivateKeyError         = "an error occurred creating the private key"
 	InvalidPublicKeyLenError      = "the public key length is not valid"
 	CreatePublicKeyError          = "an error occurred creating the public key"
+	InvalidMultisigKeysLenError   = "the number of multisig public keys is not valid"
+	InvalidMultisigThresholdError = "the multisig threshold is not valid"
+	DuplicateMultisigKeyError     = "the multisig public keys contain a duplicate"
 )
 
 func ErrInvalidAddressLen(len int) error {
@@ -45,6 +48,18 @@ func ErrInvalidPublicKeyLen(len int) error {
 	return fmt.Errorf("%s, expected length %d, actual length: %d", InvalidPublicKeyLenError, ed25519.PrivateKeySize, len)
 }
 
+func ErrInvalidMultisigKeysLen(len int) error {
+	return fmt.Errorf("%s, expected between 1 and %d keys, actual: %d", InvalidMultisigKeysLenError, MaxMultisigKeys, len)
+}
+
+func ErrInvalidMultisigThreshold(threshold, keys int) error {
+	return fmt.Errorf("%s, expected between 1 and %d, actual: %d", InvalidMultisigThresholdError, keys, threshold)
+}
+
+func ErrDuplicateMultisigKey(publicKey PublicKey) error {
+	return fmt.Errorf("%s: %s", DuplicateMultisigKeyError, publicKey.String())
+}
+
 func ErrCreatePublicKey(err error) error {
 	return fmt.Errorf("%s; %s", CreatePublicKeyError, err.Error())
 	return errors.New(fmt.Sprintf("%s, expected length %d", InvalidAddressLenError, AddressLen))
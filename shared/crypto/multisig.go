@@ -0,0 +1,102 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+)
+
+const MaxMultisigKeys = 16
+
+// MultisigPublicKey is an M-of-N key: a message is signed once `threshold` of its public keys signed it. The keys are
+// kept sorted, so the address only depends on the set of keys and the threshold
+type MultisigPublicKey struct {
+	threshold  int
+	publicKeys []PublicKey
+}
+
+func NewMultisigPublicKey(threshold int, publicKeys []PublicKey) (*MultisigPublicKey, error) {
+	if len(publicKeys) == 0 || len(publicKeys) > MaxMultisigKeys {
+		return nil, ErrInvalidMultisigKeysLen(len(publicKeys))
+	}
+	if threshold < 1 || threshold > len(publicKeys) {
+		return nil, ErrInvalidMultisigThreshold(threshold, len(publicKeys))
+	}
+	sorted := make([]PublicKey, len(publicKeys))
+	copy(sorted, publicKeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Bytes(), sorted[j].Bytes()) < 0
+	})
+	for i := 1; i < len(sorted); i++ {
+		if bytes.Equal(sorted[i-1].Bytes(), sorted[i].Bytes()) {
+			return nil, ErrDuplicateMultisigKey(sorted[i])
+		}
+	}
+	return &MultisigPublicKey{
+		threshold:  threshold,
+		publicKeys: sorted,
+	}, nil
+}
+
+func NewMultisigPublicKeyFromBytes(threshold int, publicKeys [][]byte) (*MultisigPublicKey, error) {
+	keys := make([]PublicKey, 0, len(publicKeys))
+	for _, bz := range publicKeys {
+		publicKey, err := NewPublicKeyFromBytes(bz)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, publicKey)
+	}
+	return NewMultisigPublicKey(threshold, keys)
+}
+
+func (m *MultisigPublicKey) Threshold() int {
+	return m.threshold
+}
+
+// PublicKeys returns the keys of the multisig in sorted order
+func (m *MultisigPublicKey) PublicKeys() []PublicKey {
+	return m.publicKeys
+}
+
+// Bytes encodes the threshold as a big endian uint32 followed by the sorted public keys
+func (m *MultisigPublicKey) Bytes() []byte {
+	bz := make([]byte, 4, 4+len(m.publicKeys)*PublicKeyLen)
+	binary.BigEndian.PutUint32(bz, uint32(m.threshold))
+	for _, publicKey := range m.publicKeys {
+		bz = append(bz, publicKey.Bytes()...)
+	}
+	return bz
+}
+
+func (m *MultisigPublicKey) Address() Address {
+	hash := sha256.Sum256(m.Bytes())
+	return hash[:AddressLen]
+}
+
+// Verify checks that at least `threshold` keys of the multisig signed `msg`. `signatures[i]` is the signature of
+// `signers[i]`; signers that aren't keys of the multisig or that appear more than once don't count
+func (m *MultisigPublicKey) Verify(msg []byte, signers []PublicKey, signatures [][]byte) bool {
+	if len(signers) != len(signatures) {
+		return false
+	}
+	signed := make(map[string]bool, len(signers))
+	for i, signer := range signers {
+		key := string(signer.Bytes())
+		if signed[key] || !m.contains(signer) || !signer.Verify(msg, signatures[i]) {
+			continue
+		}
+		signed[key] = true
+	}
+	return len(signed) >= m.threshold
+}
+
+func (m *MultisigPublicKey) contains(publicKey PublicKey) bool {
+	for _, key := range m.publicKeys {
+		if bytes.Equal(key.Bytes(), publicKey.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
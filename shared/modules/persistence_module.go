@@ -2,6 +2,7 @@ package modules
 
 import (
 	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
 	"github.com/syndtr/goleveldb/leveldb/memdb"
 )
 
@@ -19,14 +20,31 @@ type PersistenceContext interface {
 	// Context Operations
 	NewSavePoint([]byte) error
 	RollbackToSavePoint([]byte) error
+	// ReleaseSavePoint drops the latest save point without rolling back the changes made after it
+	ReleaseSavePoint([]byte) error
 	AppHash() ([]byte, error)
 	Reset() error
 	Commit() error
 	Release()
 	GetHeight() (int64, error)
+	// GetStateChangesSinceSavePoint returns every key written after `savePoint` along with its value before and after
+	GetStateChangesSinceSavePoint(savePoint []byte) ([]*types.StateChange, error)
+	// ExportState returns the state at the height of the context as a genesis to restart the chain from
+	ExportState() (*typesGenesis.GenesisState, types.Error)
 
 	// Indexer
 	TransactionExists(transactionHash string) bool
+	StoreTransaction(transactionHash string, height int64, index int, signer []byte, recipient []byte, transactionResult []byte) error
+	GetTransactionByHash(transactionHash string) (transactionResult []byte, err error)
+	// Paginated queries are one-based and return the newest results first, along with the total number of matches
+	GetTransactionsBySigner(signer []byte, page, perPage int) (transactionResults [][]byte, totalCount int, err error)
+	GetTransactionsByRecipient(recipient []byte, page, perPage int) (transactionResults [][]byte, totalCount int, err error)
+	GetTransactionsByHeight(height int64, page, perPage int) (transactionResults [][]byte, totalCount int, err error)
+	StoreBlockEvents(height int64, blockEvents []byte) error
+	GetBlockEvents(height int64) (blockEvents []byte, err error)
+	// The status history of an actor is the events that changed its status, indexed by their position in the block
+	StoreStatusEvent(address []byte, height int64, index int, event []byte) error
+	GetStatusEvents(address []byte) (events [][]byte, err error)
 
 	//Account
 	AddPoolAmount(name string, amount string) error
@@ -38,8 +56,21 @@ type PersistenceContext interface {
 	SubtractAccountAmount(address []byte, amount string) error
 	GetAccountAmount(address []byte) (string, error)
 	SetAccountAmount(address []byte, amount string) error
+	GetAccountSequence(address []byte) (sequence uint64, err error)
+	SetAccountSequence(address []byte, sequence uint64) error
+	GetAccountVesting(address []byte) (vesting []byte, err error)
+	SetAccountVesting(address []byte, vesting []byte) error
+	GetAllAccounts(height int64) ([]*typesGenesis.Account, error)
+	GetAllPools(height int64) ([]*typesGenesis.Pool, error)
+	// GetTotalSupply returns the sum of every account and pool, which only changes when tokens are minted or burned
+	GetTotalSupply() (amount string, err error)
+	SetTotalSupply(amount string) error
+	// GetChainId returns the chain id of the genesis, which the block headers carry as their network id
+	GetChainId() (chainId string, err error)
+	SetChainId(chainId string) error
 
 	// App
+	GetApp(address []byte) (app *typesGenesis.App, err error)
 	GetAppExists(address []byte) (exists bool, err error)
 	InsertApplication(address []byte, publicKey []byte, output []byte, paused bool, status int, maxRelays string, stakedTokens string, chains []string, pausedHeight int64, unstakingHeight int64) error
 	UpdateApplication(address []byte, maxRelaysToAdd string, amountToAdd string, chainsToUpdate []string) error
@@ -51,8 +82,12 @@ type PersistenceContext interface {
 	SetAppsStatusAndUnstakingHeightPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int) error
 	SetAppPauseHeight(address []byte, height int64) error
 	GetAppOutputAddress(operator []byte) (output []byte, err error)
+	GetAppStakedTokens(address []byte) (tokens string, err error)
+	SetAppStakedTokensAndMaxRelays(address []byte, tokens string, maxRelays string) error
+	GetAllApps(height int64) ([]*typesGenesis.App, error)
 
 	// ServiceNode
+	GetServiceNode(address []byte) (sn *typesGenesis.ServiceNode, exists bool, err error)
 	GetServiceNodeExists(address []byte) (exists bool, err error)
 	InsertServiceNode(address []byte, publicKey []byte, output []byte, paused bool, status int, serviceURL string, stakedTokens string, chains []string, pausedHeight int64, unstakingHeight int64) error
 	UpdateServiceNode(address []byte, serviceURL string, amountToAdd string, chains []string) error
@@ -66,6 +101,17 @@ type PersistenceContext interface {
 	GetServiceNodesPerSessionAt(height int64) (int, error)
 	GetServiceNodeCount(chain string, height int64) (int, error)
 	GetServiceNodeOutputAddress(operator []byte) (output []byte, err error)
+	GetServiceNodeStakedTokens(address []byte) (tokens string, err error)
+	SetServiceNodeStakedTokens(address []byte, tokens string) error
+	SetServiceNodeQoSScore(address []byte, score int, samples int) error
+	SetServiceNodeJailedUntilHeight(address []byte, height int64) error
+	GetAllServiceNodes(height int64) ([]*typesGenesis.ServiceNode, error)
+	// Claimed relays are the relays a service node claimed in a session, indexed by the session key
+	GetClaimedRelays(sessionKey, address []byte) (relays uint64, err error)
+	SetClaimedRelays(sessionKey, address []byte, relays uint64) error
+	// A service node is tested once per session, by the fisherman of the session
+	GetTestScoreReported(sessionKey, address []byte) (reported bool, err error)
+	SetTestScoreReported(sessionKey, address []byte) error
 
 	// Fisherman
 	GetFishermanExists(address []byte) (exists bool, err error)
@@ -79,9 +125,13 @@ type PersistenceContext interface {
 	SetFishermansStatusAndUnstakingHeightPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int) error
 	SetFishermanPauseHeight(address []byte, height int64) error
 	GetFishermanOutputAddress(operator []byte) (output []byte, err error)
+	GetFishermanStakedTokens(address []byte) (tokens string, err error)
+	SetFishermanStakedTokens(address []byte, tokens string) error
+	GetAllFishermen(height int64) ([]*typesGenesis.Fisherman, error)
 
 	// Validator
 	GetValidatorExists(address []byte) (exists bool, err error)
+	GetValidator(address []byte) (validator *typesGenesis.Validator, exists bool, err error)
 	InsertValidator(address []byte, publicKey []byte, output []byte, paused bool, status int, serviceURL string, stakedTokens string, pausedHeight int64, unstakingHeight int64) error
 	UpdateValidator(address []byte, serviceURL string, amountToAdd string) error
 	DeleteValidator(address []byte) error
@@ -97,242 +147,46 @@ type PersistenceContext interface {
 	SetValidatorStakedTokens(address []byte, tokens string) error
 	GetValidatorStakedTokens(address []byte) (tokens string, err error)
 	GetValidatorOutputAddress(operator []byte) (output []byte, err error)
+	GetAllValidators(height int64) ([]*typesGenesis.Validator, error)
+	SetDoubleSignEvidence(address []byte, height int64, round uint32) error
+	GetDoubleSignEvidenceExists(address []byte, height int64, round uint32) (exists bool, err error)
+	SetValidatorCommission(address []byte, commission int) error
+	SetValidatorDelegatedTokensAndShares(address []byte, tokens string, shares string) error
+
+	// Delegations
+	SetDelegation(validator, delegator []byte, delegation []byte) error
+	GetDelegation(validator, delegator []byte) (delegation []byte, err error)
+	DeleteDelegation(validator, delegator []byte) error
+	GetValidatorDelegations(validator []byte) (delegations [][]byte, err error)
+	GetAllDelegations() (delegations [][]byte, err error)
+
+	// Unbonding stakes are the partially unstaked tokens, indexed by the height they are released at
+	SetUnbondingStake(releaseHeight int64, id []byte, unbondingStake []byte) error
+	GetUnbondingStake(releaseHeight int64, id []byte) (unbondingStake []byte, err error)
+	GetUnbondingStakesReleasedAt(height int64) (unbondingStakes [][]byte, err error)
+	GetAllUnbondingStakes() (unbondingStakes [][]byte, err error)
+	DeleteUnbondingStake(releaseHeight int64, id []byte) error
 
 	// Params
 	InitParams() error
-
-	GetBlocksPerSession() (int, error)
-
-	GetParamAppMinimumStake() (string, error)
-	GetMaxAppChains() (int, error)
-	GetBaselineAppStakeRate() (int, error)
-	GetStabilityAdjustment() (int, error)
-	GetAppUnstakingBlocks() (int, error)
-	GetAppMinimumPauseBlocks() (int, error)
-	GetAppMaxPausedBlocks() (int, error)
-
-	GetParamServiceNodeMinimumStake() (string, error)
-	GetServiceNodeMaxChains() (int, error)
-	GetServiceNodeUnstakingBlocks() (int, error)
-	GetServiceNodeMinimumPauseBlocks() (int, error)
-	GetServiceNodeMaxPausedBlocks() (int, error)
-	GetServiceNodesPerSession() (int, error)
-
-	GetParamFishermanMinimumStake() (string, error)
-	GetFishermanMaxChains() (int, error)
-	GetFishermanUnstakingBlocks() (int, error)
-	GetFishermanMinimumPauseBlocks() (int, error)
-	GetFishermanMaxPausedBlocks() (int, error)
-
-	GetParamValidatorMinimumStake() (string, error)
-	GetValidatorUnstakingBlocks() (int, error)
-	GetValidatorMinimumPauseBlocks() (int, error)
-	GetValidatorMaxPausedBlocks() (int, error)
-	GetValidatorMaximumMissedBlocks() (int, error)
-	GetProposerPercentageOfFees() (int, error)
-	GetMaxEvidenceAgeInBlocks() (int, error)
-	GetMissedBlocksBurnPercentage() (int, error)
-	GetDoubleSignBurnPercentage() (int, error)
-
-	GetMessageDoubleSignFee() (string, error)
-	GetMessageSendFee() (string, error)
-	GetMessageStakeFishermanFee() (string, error)
-	GetMessageEditStakeFishermanFee() (string, error)
-	GetMessageUnstakeFishermanFee() (string, error)
-	GetMessagePauseFishermanFee() (string, error)
-	GetMessageUnpauseFishermanFee() (string, error)
-	GetMessageFishermanPauseServiceNodeFee() (string, error)
-	GetMessageTestScoreFee() (string, error)
-	GetMessageProveTestScoreFee() (string, error)
-	GetMessageStakeAppFee() (string, error)
-	GetMessageEditStakeAppFee() (string, error)
-	GetMessageUnstakeAppFee() (string, error)
-	GetMessagePauseAppFee() (string, error)
-	GetMessageUnpauseAppFee() (string, error)
-	GetMessageStakeValidatorFee() (string, error)
-	GetMessageEditStakeValidatorFee() (string, error)
-	GetMessageUnstakeValidatorFee() (string, error)
-	GetMessagePauseValidatorFee() (string, error)
-	GetMessageUnpauseValidatorFee() (string, error)
-	GetMessageStakeServiceNodeFee() (string, error)
-	GetMessageEditStakeServiceNodeFee() (string, error)
-	GetMessageUnstakeServiceNodeFee() (string, error)
-	GetMessagePauseServiceNodeFee() (string, error)
-	GetMessageUnpauseServiceNodeFee() (string, error)
-	GetMessageChangeParameterFee() (string, error)
-
-	// Setters
-	SetBlocksPerSession(int) error
-
-	SetParamAppMinimumStake(string) error
-	SetMaxAppChains(int) error
-	SetBaselineAppStakeRate(int) error
-	SetStakingAdjustment(int) error
-	SetAppUnstakingBlocks(int) error
-	SetAppMinimumPauseBlocks(int) error
-	SetAppMaxPausedBlocks(int) error
-
-	SetParamServiceNodeMinimumStake(string) error
-	SetServiceNodeMaxChains(int) error
-	SetServiceNodeUnstakingBlocks(int) error
-	SetServiceNodeMinimumPauseBlocks(int) error
-	SetServiceNodeMaxPausedBlocks(int) error
-	SetServiceNodesPerSession(int) error
-
-	SetParamFishermanMinimumStake(string) error
-	SetFishermanMaxChains(int) error
-	SetFishermanUnstakingBlocks(int) error
-	SetFishermanMinimumPauseBlocks(int) error
-	SetFishermanMaxPausedBlocks(int) error
-
-	SetParamValidatorMinimumStake(string) error
-	SetValidatorUnstakingBlocks(int) error
-	SetValidatorMinimumPauseBlocks(int) error
-	SetValidatorMaxPausedBlocks(int) error
-	SetValidatorMaximumMissedBlocks(int) error
-	SetProposerPercentageOfFees(int) error
-	SetMaxEvidenceAgeInBlocks(int) error
-	SetMissedBlocksBurnPercentage(int) error
-	SetDoubleSignBurnPercentage(int) error
-
-	SetMessageDoubleSignFee(string) error
-	SetMessageSendFee(string) error
-	SetMessageStakeFishermanFee(string) error
-	SetMessageEditStakeFishermanFee(string) error
-	SetMessageUnstakeFishermanFee(string) error
-	SetMessagePauseFishermanFee(string) error
-	SetMessageUnpauseFishermanFee(string) error
-	SetMessageFishermanPauseServiceNodeFee(string) error
-	SetMessageTestScoreFee(string) error
-	SetMessageProveTestScoreFee(string) error
-	SetMessageStakeAppFee(string) error
-	SetMessageEditStakeAppFee(string) error
-	SetMessageUnstakeAppFee(string) error
-	SetMessagePauseAppFee(string) error
-	SetMessageUnpauseAppFee(string) error
-	SetMessageStakeValidatorFee(string) error
-	SetMessageEditStakeValidatorFee(string) error
-	SetMessageUnstakeValidatorFee(string) error
-	SetMessagePauseValidatorFee(string) error
-	SetMessageUnpauseValidatorFee(string) error
-	SetMessageStakeServiceNodeFee(string) error
-	SetMessageEditStakeServiceNodeFee(string) error
-	SetMessageUnstakeServiceNodeFee(string) error
-	SetMessagePauseServiceNodeFee(string) error
-	SetMessageUnpauseServiceNodeFee(string) error
-	SetMessageChangeParameterFee(string) error
-
-	SetMessageDoubleSignFeeOwner([]byte) error
-	SetMessageSendFeeOwner([]byte) error
-	SetMessageStakeFishermanFeeOwner([]byte) error
-	SetMessageEditStakeFishermanFeeOwner([]byte) error
-	SetMessageUnstakeFishermanFeeOwner([]byte) error
-	SetMessagePauseFishermanFeeOwner([]byte) error
-	SetMessageUnpauseFishermanFeeOwner([]byte) error
-	SetMessageFishermanPauseServiceNodeFeeOwner([]byte) error
-	SetMessageTestScoreFeeOwner([]byte) error
-	SetMessageProveTestScoreFeeOwner([]byte) error
-	SetMessageStakeAppFeeOwner([]byte) error
-	SetMessageEditStakeAppFeeOwner([]byte) error
-	SetMessageUnstakeAppFeeOwner([]byte) error
-	SetMessagePauseAppFeeOwner([]byte) error
-	SetMessageUnpauseAppFeeOwner([]byte) error
-	SetMessageStakeValidatorFeeOwner([]byte) error
-	SetMessageEditStakeValidatorFeeOwner([]byte) error
-	SetMessageUnstakeValidatorFeeOwner([]byte) error
-	SetMessagePauseValidatorFeeOwner([]byte) error
-	SetMessageUnpauseValidatorFeeOwner([]byte) error
-	SetMessageStakeServiceNodeFeeOwner([]byte) error
-	SetMessageEditStakeServiceNodeFeeOwner([]byte) error
-	SetMessageUnstakeServiceNodeFeeOwner([]byte) error
-	SetMessagePauseServiceNodeFeeOwner([]byte) error
-	SetMessageUnpauseServiceNodeFeeOwner([]byte) error
-	SetMessageChangeParameterFeeOwner([]byte) error
-
-	// ACL
-	GetAclOwner() ([]byte, error)
-	SetAclOwner(owner []byte) error
-	SetBlocksPerSessionOwner(owner []byte) error
-	GetBlocksPerSessionOwner() ([]byte, error)
-	GetMaxAppChainsOwner() ([]byte, error)
-	SetMaxAppChainsOwner(owner []byte) error
-	GetAppMinimumStakeOwner() ([]byte, error)
-	SetAppMinimumStakeOwner(owner []byte) error
-	GetBaselineAppOwner() ([]byte, error)
-	SetBaselineAppOwner(owner []byte) error
-	GetStakingAdjustmentOwner() ([]byte, error)
-	SetStakingAdjustmentOwner(owner []byte) error
-	GetAppUnstakingBlocksOwner() ([]byte, error)
-	SetAppUnstakingBlocksOwner(owner []byte) error
-	GetAppMinimumPauseBlocksOwner() ([]byte, error)
-	SetAppMinimumPauseBlocksOwner(owner []byte) error
-	GetAppMaxPausedBlocksOwner() ([]byte, error)
-	SetAppMaxPausedBlocksOwner(owner []byte) error
-	GetParamServiceNodeMinimumStakeOwner() ([]byte, error)
-	SetParamServiceNodeMinimumStakeOwner(owner []byte) error
-	GetServiceNodeMaxChainsOwner() ([]byte, error)
-	SetMaxServiceNodeChainsOwner(owner []byte) error
-	GetServiceNodeUnstakingBlocksOwner() ([]byte, error)
-	SetServiceNodeUnstakingBlocksOwner(owner []byte) error
-	GetServiceNodeMinimumPauseBlocksOwner() ([]byte, error)
-	SetServiceNodeMinimumPauseBlocksOwner(owner []byte) error
-	GetServiceNodeMaxPausedBlocksOwner() ([]byte, error)
-	SetServiceNodeMaxPausedBlocksOwner(owner []byte) error
-	GetFishermanMinimumStakeOwner() ([]byte, error)
-	SetFishermanMinimumStakeOwner(owner []byte) error
-	GetMaxFishermanChainsOwner() ([]byte, error)
-	SetMaxFishermanChainsOwner(owner []byte) error
-	GetFishermanUnstakingBlocksOwner() ([]byte, error)
-	SetFishermanUnstakingBlocksOwner(owner []byte) error
-	GetFishermanMinimumPauseBlocksOwner() ([]byte, error)
-	SetFishermanMinimumPauseBlocksOwner(owner []byte) error
-	GetFishermanMaxPausedBlocksOwner() ([]byte, error)
-	SetFishermanMaxPausedBlocksOwner(owner []byte) error
-	GetParamValidatorMinimumStakeOwner() ([]byte, error)
-	SetParamValidatorMinimumStakeOwner(owner []byte) error
-	GetValidatorUnstakingBlocksOwner() ([]byte, error)
-	SetValidatorUnstakingBlocksOwner(owner []byte) error
-	GetValidatorMinimumPauseBlocksOwner() ([]byte, error)
-	SetValidatorMinimumPauseBlocksOwner(owner []byte) error
-	GetValidatorMaxPausedBlocksOwner() ([]byte, error)
-	SetValidatorMaxPausedBlocksOwner(owner []byte) error
-	GetValidatorMaximumMissedBlocksOwner() ([]byte, error)
-	SetValidatorMaximumMissedBlocksOwner(owner []byte) error
-	GetProposerPercentageOfFeesOwner() ([]byte, error)
-	SetProposerPercentageOfFeesOwner(owner []byte) error
-	GetMaxEvidenceAgeInBlocksOwner() ([]byte, error)
-	SetMaxEvidenceAgeInBlocksOwner(owner []byte) error
-	GetMissedBlocksBurnPercentageOwner() ([]byte, error)
-	SetMissedBlocksBurnPercentageOwner(owner []byte) error
-	GetDoubleSignBurnPercentageOwner() ([]byte, error)
-	SetDoubleSignBurnPercentageOwner(owner []byte) error
-	SetServiceNodesPerSessionOwner(owner []byte) error
-	GetServiceNodesPerSessionOwner() ([]byte, error)
-
-	GetMessageDoubleSignFeeOwner() ([]byte, error)
-	GetMessageSendFeeOwner() ([]byte, error)
-	GetMessageStakeFishermanFeeOwner() ([]byte, error)
-	GetMessageEditStakeFishermanFeeOwner() ([]byte, error)
-	GetMessageUnstakeFishermanFeeOwner() ([]byte, error)
-	GetMessagePauseFishermanFeeOwner() ([]byte, error)
-	GetMessageUnpauseFishermanFeeOwner() ([]byte, error)
-	GetMessageFishermanPauseServiceNodeFeeOwner() ([]byte, error)
-	GetMessageTestScoreFeeOwner() ([]byte, error)
-	GetMessageProveTestScoreFeeOwner() ([]byte, error)
-	GetMessageStakeAppFeeOwner() ([]byte, error)
-	GetMessageEditStakeAppFeeOwner() ([]byte, error)
-	GetMessageUnstakeAppFeeOwner() ([]byte, error)
-	GetMessagePauseAppFeeOwner() ([]byte, error)
-	GetMessageUnpauseAppFeeOwner() ([]byte, error)
-	GetMessageStakeValidatorFeeOwner() ([]byte, error)
-	GetMessageEditStakeValidatorFeeOwner() ([]byte, error)
-	GetMessageUnstakeValidatorFeeOwner() ([]byte, error)
-	GetMessagePauseValidatorFeeOwner() ([]byte, error)
-	GetMessageUnpauseValidatorFeeOwner() ([]byte, error)
-	GetMessageStakeServiceNodeFeeOwner() ([]byte, error)
-	GetMessageEditStakeServiceNodeFeeOwner() ([]byte, error)
-	GetMessageUnstakeServiceNodeFeeOwner() ([]byte, error)
-	GetMessagePauseServiceNodeFeeOwner() ([]byte, error)
-	GetMessageUnpauseServiceNodeFeeOwner() ([]byte, error)
-	GetMessageChangeParameterFeeOwner() ([]byte, error)
+	GetParams(height int64) (*typesGenesis.Params, error)
+	SetParams(params *typesGenesis.Params) error
+	// Pending param changes are the param changes scheduled for a future height, indexed by their activation height
+	SetPendingParamChange(activationHeight int64, paramKey string, paramChange []byte) error
+	GetPendingParamChangesAt(height int64) (paramChanges [][]byte, err error)
+	GetAllPendingParamChanges() (paramChanges [][]byte, err error)
+	DeletePendingParamChange(activationHeight int64, paramKey string) error
+
+	// Upgrade
+	SetUpgrade(upgrade []byte) error
+	GetUpgrade() (upgrade []byte, err error)
+
+	// Proposals
+	GetProposalExists(proposalID uint64) (exists bool, err error)
+	GetProposalCount() (count uint64, err error)
+	SetProposal(proposalID uint64, votingEndHeight int64, proposal []byte) error
+	GetProposal(proposalID uint64) (proposal []byte, err error)
+	GetProposalsEndingAt(height int64) (proposals [][]byte, err error)
+	SetProposalVote(proposalID uint64, voter []byte, vote []byte) error
+	GetProposalVotes(proposalID uint64) (votes [][]byte, err error)
 }
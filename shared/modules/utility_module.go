@@ -1,5 +1,10 @@
 package modules
 
+import (
+	"github.com/pokt-network/pocket/shared/types"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
 type UnstakingActor interface {
 	GetAddress() []byte
 	GetStakeAmount() string
@@ -13,9 +18,17 @@ type UtilityContext interface {
 	CheckTransaction(tx []byte) error
 	GetTransactionsForProposal(proposer []byte, maxTransactionBytes int, lastBlockByzantineValidators [][]byte) (transactions [][]byte, err error)
 	ApplyBlock(Height int64, proposer []byte, transactions [][]byte, lastBlockByzantineValidators [][]byte) (appHash []byte, err error)
+	// GetEvents returns the events recorded by ApplyBlock, to be published on the bus once the block is committed
+	GetEvents() (blockEvents *anypb.Any, err error)
 }
 
 type UtilityModule interface {
 	Module
 	NewContext(height int64) (UtilityContext, error)
+	// ReconcileMempool removes `committedTransactions` from the mempool and rechecks the remaining transactions against the state at `height`
+	ReconcileMempool(height int64, committedTransactions [][]byte) error
+	// SimulateTransaction dry-runs a transaction against the latest committed state without adding it to the mempool
+	SimulateTransaction(transactionProtoBytes []byte, skipSignatureCheck bool) (*types.SimulationResult, error)
+	// ServeRelay meters a relay of the application on `chain` that the service node `servicer` is about to serve
+	ServeRelay(servicer, appPublicKey []byte, chain string) error
 }
@@ -101,12 +101,31 @@ func (node *Node) Start() error {
 	// While loop lasting throughout the entire lifecycle of the node.
 	for {
 		event := node.GetBus().GetBusEvent()
+		if event.Topic == types.PocketTopic_NODE_HALTED_TOPIC {
+			log.Println("Pocket node halted")
+			return nil
+		}
 		if err := node.handleEvent(event); err != nil {
 			log.Println("Error handling event: ", err)
 		}
 	}
 }
 
+// ExportGenesis returns the state at `height` as a genesis state, which requires the node to have committed the
+// block before it; see `UtilityConfig.HaltHeight` to stop the node there
+func (node *Node) ExportGenesis(height int64) (*typesGenesis.GenesisState, error) {
+	ctx, err := node.GetBus().GetPersistenceModule().NewContext(height)
+	if err != nil {
+		return nil, err
+	}
+	defer ctx.Release()
+	state, er := ctx.ExportState()
+	if er != nil {
+		return nil, er
+	}
+	return state, nil
+}
+
 func (node *Node) Stop() error {
 	log.Println("Stopping pocket node...")
 	return nil
@@ -131,6 +150,8 @@ func (node *Node) handleEvent(event *types.PocketEvent) error {
 		return node.handleDebugEvent(event.Data)
 	case types.PocketTopic_POCKET_NODE_TOPIC:
 		log.Println("NOOP - Received pocket node topic signal")
+	case types.PocketTopic_UTILITY_EVENTS_TOPIC:
+		// NOOP - the events of committed blocks are for subscribers outside of the node's modules
 	default:
 		log.Printf("[WARN] Unsupported PocketEvent topic: %s \n", event.Topic)
 	}
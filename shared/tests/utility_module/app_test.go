@@ -14,6 +14,7 @@ import (
 	"github.com/pokt-network/pocket/shared/types/genesis"
 	"github.com/pokt-network/pocket/utility"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestUtilityContext_HandleMessageStakeApp(t *testing.T) {
@@ -135,7 +136,7 @@ func TestUtilityContext_HandleMessagePauseApp(t *testing.T) {
 
 func TestUtilityContext_HandleMessageUnpauseApp(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
-	if err := ctx.Context.SetAppMinimumPauseBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.AppMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingApps(t, ctx)[0]
@@ -165,7 +166,7 @@ func TestUtilityContext_HandleMessageUnpauseApp(t *testing.T) {
 
 func TestUtilityContext_HandleMessageUnstakeApp(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
-	if err := ctx.Context.SetAppMinimumPauseBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.AppMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingApps(t, ctx)[0]
@@ -182,10 +183,145 @@ func TestUtilityContext_HandleMessageUnstakeApp(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_HandleMessagePartialUnstakeApp(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	actor := GetAllTestingApps(t, ctx)[0]
+	stakedTokens, err := types.StringToBigInt(actor.StakedTokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minStake, err := ctx.GetAppMinimumStake()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &typesUtil.MessagePartialUnstakeApp{
+		Address: actor.Address,
+		Amount:  types.BigIntToString(new(big.Int).Sub(stakedTokens, minStake)),
+		Signer:  actor.Address,
+	}
+	if err := ctx.HandleMessagePartialUnstakeApp(msg); err != nil {
+		t.Fatal(err)
+	}
+	// the max relays follow the lower stake
+	expectedMaxRelays, err := ctx.CalculateAppRelays(types.BigIntToString(minStake))
+	if err != nil {
+		t.Fatal(err)
+	}
+	actor = GetAllTestingApps(t, ctx)[0]
+	if actor.StakedTokens != types.BigIntToString(minStake) || actor.MaxRelays != expectedMaxRelays {
+		t.Fatalf("unexpected app after the partial unstake: staked tokens %s, max relays %s", actor.StakedTokens, actor.MaxRelays)
+	}
+	unstakingHeight, err := ctx.CalculateAppUnstakingHeight()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unbondingStake, err := ctx.GetUnbondingStake(unstakingHeight, actor.Address, actor.Output, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unbondingStake == nil || unbondingStake.Amount != msg.Amount || unbondingStake.StakePool != typesUtil.AppStakePoolName {
+		t.Fatalf("unexpected unbonding stake: %v", unbondingStake)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_HandleMessagePartialUnstakeAppNegativeAmount(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	actor := GetAllTestingApps(t, ctx)[0]
+	msg := &typesUtil.MessagePartialUnstakeApp{
+		Address: actor.Address,
+		Amount:  "-1",
+		Signer:  actor.Address,
+	}
+	if err := ctx.HandleMessagePartialUnstakeApp(msg); err == nil || err.Code() != types.CodeInvalidAmountError {
+		t.Fatalf("expected an invalid amount error, got %v", err)
+	}
+	if after := GetAllTestingApps(t, ctx)[0]; after.StakedTokens != actor.StakedTokens {
+		t.Fatalf("the stake changed from %s to %s", actor.StakedTokens, after.StakedTokens)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_ReleaseUnbondingStakesToEachOutput(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	// the partial unstake and the undelegation are released at the same height
+	for _, paramName := range []string{typesUtil.AppUnstakingBlocksParamName, typesUtil.ValidatorUnstakingBlocksParamName} {
+		if err := ctx.UpdateParam(paramName, wrapperspb.Int32(0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pubKey, _ := crypto.GeneratePublicKey()
+	out, _ := crypto.GenerateAddress()
+	if err := ctx.MintToAccount(out, defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleMessageStakeApp(&typesUtil.MessageStakeApp{
+		PublicKey:     pubKey.Bytes(),
+		Chains:        defaultTestingChains,
+		Amount:        defaultAmountString,
+		OutputAddress: out,
+		Signer:        out,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	minStake, err := ctx.GetAppMinimumStake()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unstaked := new(big.Int).Sub(defaultAmount, minStake)
+	if err := ctx.HandleMessagePartialUnstakeApp(&typesUtil.MessagePartialUnstakeApp{
+		Address: pubKey.Address(),
+		Amount:  types.BigIntToString(unstaked),
+		Signer:  out,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// the operator of the app also undelegates from a validator, which pays the operator itself
+	undelegated := big.NewInt(100)
+	if err := ctx.MintToAccount(pubKey.Address(), undelegated); err != nil {
+		t.Fatal(err)
+	}
+	validator := GetAllTestingValidators(t, ctx)[0]
+	delegateTestingTokens(t, ctx, pubKey.Address(), validator.Address, undelegated)
+	if err := ctx.HandleMessageUndelegate(&typesUtil.MessageUndelegate{
+		Delegator: pubKey.Address(),
+		Validator: validator.Address,
+		Shares:    types.BigIntToString(undelegated),
+		Signer:    pubKey.Address(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	unbondingStakes, err := ctx.GetActorUnbondingStakes(pubKey.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unbondingStakes) != 2 {
+		t.Fatalf("expected an unbonding stake for each output, got %v", unbondingStakes)
+	}
+	if err := ctx.ReleaseUnbondingStakes(); err != nil {
+		t.Fatal(err)
+	}
+	outAmount, err := ctx.GetAccountAmount(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outAmount.Cmp(unstaked) != 0 {
+		t.Fatalf("unexpected amount paid to the output; expected %v got %v", unstaked, outAmount)
+	}
+	operatorAmount, err := ctx.GetAccountAmount(pubKey.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if operatorAmount.Cmp(undelegated) != 0 {
+		t.Fatalf("unexpected amount paid to the delegator; expected %v got %v", undelegated, operatorAmount)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
 func TestUtilityContext_BeginUnstakingMaxPausedApps(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	actor := GetAllTestingApps(t, ctx)[0]
-	err := ctx.Context.SetAppMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.AppMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -213,6 +349,19 @@ func TestUtilityContext_CalculateAppRelays(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_CalculateAppRelaysLargeStake(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	// a stake that doesn't fit in an int64 is bounded rather than overflowing
+	stake := new(big.Int).Lsh(big.NewInt(1), 100)
+	maxRelays, err := ctx.CalculateAppRelays(types.BigIntToString(stake))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := types.BigIntToString(big.NewInt(math.MaxInt64)); maxRelays != expected {
+		t.Fatalf("unexpected max relays; expected %v got %v", expected, maxRelays)
+	}
+}
+
 func TestUtilityContext_CalculateAppUnstakingHeight(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	unstakingBlocks, err := ctx.GetAppUnstakingBlocks()
@@ -430,7 +579,7 @@ func TestUtilityContext_UnstakeAppsPausedBefore(t *testing.T) {
 	if actor.Status != typesUtil.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetAppMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.AppMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -456,14 +605,14 @@ func TestUtilityContext_UnstakeAppsPausedBefore(t *testing.T) {
 func TestUtilityContext_UnstakeAppsThatAreReady(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	ctx.SetPoolAmount(typesUtil.AppStakePoolName, big.NewInt(math.MaxInt64))
-	if err := ctx.Context.SetAppUnstakingBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.AppUnstakingBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingApps(t, ctx)[0]
 	if actor.Status != typesUtil.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetAppMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.AppMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2,7 +2,10 @@ package utility_module
 
 import (
 	"bytes"
+	"github.com/pokt-network/pocket/shared/crypto"
+	sharedTypes "github.com/pokt-network/pocket/shared/types"
 	"github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"math"
 	"math/big"
 	"testing"
@@ -67,6 +70,97 @@ func TestUtilityContext_ApplyBlock(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_ApplyBlockFeeAccounting(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	proposer := GetAllTestingValidators(t, ctx)[0]
+	feeOwner, err := crypto.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.UpdateParam(types.MessageSendFeeOwner, wrapperspb.Bytes(feeOwner.Address())); err != nil {
+		t.Fatal(err)
+	}
+	// a fee that isn't a multiple of 100 makes the shares round
+	if err := ctx.UpdateParam(types.MessageSendFee, wrapperspb.String("10007")); err != nil {
+		t.Fatal(err)
+	}
+	minimumFee, err := ctx.GetMessageSendFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	transactions := make([][]byte, 0)
+	feesCollected, tips := big.NewInt(0), big.NewInt(0)
+	for i := int64(0); i < 3; i++ {
+		tx, _, _, signer := NewTestingTransaction(t, ctx)
+		tip := big.NewInt(i * 13)
+		setTestingTransactionFee(t, tx, signer, new(big.Int).Add(minimumFee, tip))
+		txBz, err := tx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		transactions = append(transactions, txBz)
+		feesCollected.Add(feesCollected, minimumFee).Add(feesCollected, tip)
+		tips.Add(tips, tip)
+	}
+	proposerBefore, err := ctx.GetAccountAmount(proposer.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	daoBefore, err := ctx.GetPoolAmount(types.DAOPoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.ApplyBlock(0, proposer.Address, transactions, nil); err != nil {
+		t.Fatal(err)
+	}
+	proposerAfter, err := ctx.GetAccountAmount(proposer.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	daoAfter, err := ctx.GetPoolAmount(types.DAOPoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeOwnerAmount, err := ctx.GetAccountAmount(feeOwner.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerAmount := new(big.Int).Sub(proposerAfter, proposerBefore)
+	daoAmount := new(big.Int).Sub(daoAfter, daoBefore)
+	// each share is rounded down per message, with the remainder going to the dao
+	proposerPercentage, err := ctx.GetProposerPercentageOfFees()
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeOwnerPercentage, err := ctx.GetFeeOwnerPercentageOfFees()
+	if err != nil {
+		t.Fatal(err)
+	}
+	numberOfTransactions := big.NewInt(int64(len(transactions)))
+	expectedProposerAmount := new(big.Int).Mul(minimumFee, big.NewInt(int64(proposerPercentage)))
+	expectedProposerAmount.Quo(expectedProposerAmount, big.NewInt(100)).Mul(expectedProposerAmount, numberOfTransactions).Add(expectedProposerAmount, tips)
+	if proposerAmount.Cmp(expectedProposerAmount) != 0 {
+		t.Fatalf("unexpected proposer share; expected %v got %v", expectedProposerAmount, proposerAmount)
+	}
+	expectedFeeOwnerAmount := new(big.Int).Mul(minimumFee, big.NewInt(int64(feeOwnerPercentage)))
+	expectedFeeOwnerAmount.Quo(expectedFeeOwnerAmount, big.NewInt(100)).Mul(expectedFeeOwnerAmount, numberOfTransactions)
+	if feeOwnerAmount.Cmp(expectedFeeOwnerAmount) != 0 {
+		t.Fatalf("unexpected fee owner share; expected %v got %v", expectedFeeOwnerAmount, feeOwnerAmount)
+	}
+	distributed := new(big.Int).Add(proposerAmount, daoAmount)
+	distributed.Add(distributed, feeOwnerAmount)
+	if distributed.Cmp(feesCollected) != 0 {
+		t.Fatalf("the shares don't add up to the fees collected; expected %v got %v", feesCollected, distributed)
+	}
+	feePoolAmount, err := ctx.GetPoolAmount(types.FeePoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if feePoolAmount.Sign() != 0 {
+		t.Fatalf("the fee pool was not emptied, got %v", feePoolAmount)
+	}
+}
+
 func TestUtilityContext_BeginBlock(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	tx, _, _, _ := NewTestingTransaction(t, ctx)
@@ -94,7 +188,7 @@ func TestUtilityContext_BeginBlock(t *testing.T) {
 func TestUtilityContext_BeginUnstakingMaxPausedActors(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	actor := GetAllTestingValidators(t, ctx)[0]
-	err := ctx.Context.SetValidatorMaxPausedBlocks(0)
+	err := ctx.UpdateParam(types.ValidatorMaxPausedBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -170,14 +264,14 @@ func TestUtilityContext_GetAppHash(t *testing.T) {
 func TestUtilityContext_UnstakeActorsThatAreReady(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	ctx.SetPoolAmount(types.ValidatorStakePoolName, big.NewInt(math.MaxInt64))
-	if err := ctx.Context.SetValidatorUnstakingBlocks(0); err != nil {
+	if err := ctx.UpdateParam(types.ValidatorUnstakingBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingValidators(t, ctx)[0]
 	if actor.Status != types.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetValidatorMaxPausedBlocks(0)
+	err := ctx.UpdateParam(types.ValidatorMaxPausedBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -194,3 +288,224 @@ func TestUtilityContext_UnstakeActorsThatAreReady(t *testing.T) {
 		t.Fatal("actor still exists after unstake that are ready() call")
 	}
 }
+
+func TestUtilityContext_ApplyBlockIndexesTransactions(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	tx, _, _, signer := NewTestingTransaction(t, ctx)
+	failedTx, _, _, failedSigner := NewTestingTransaction(t, ctx)
+	proposer := GetAllTestingValidators(t, ctx)[0]
+	// drain the signer of the failed transaction so it can no longer pay the fee
+	if err := ctx.SetAccountAmount(failedSigner.Address(), big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	txBz, err := tx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	failedTxBz, err := failedTx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.ApplyBlock(0, proposer.Address, [][]byte{txBz, failedTxBz}, nil); err != nil {
+		t.Fatal(err)
+	}
+	result, err := ctx.GetTransactionByHash(types.TransactionHash(txBz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Code != 0 || !bytes.Equal(result.Signer, signer.Address()) || result.Index != 0 {
+		t.Fatalf("unexpected transaction result %v", result)
+	}
+	msg, err := tx.Message()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(result.Recipient, msg.(*types.MessageSend).ToAddress) {
+		t.Fatalf("unexpected recipient; expected %v got %v", msg.(*types.MessageSend).ToAddress, result.Recipient)
+	}
+	failedResult, err := ctx.GetTransactionByHash(types.TransactionHash(failedTxBz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failedResult.Code != uint32(sharedTypes.CodeInsufficientAmountError) || failedResult.Index != 1 {
+		t.Fatalf("unexpected failed transaction result %v", failedResult)
+	}
+	results, totalCount, err := ctx.GetTransactionsByHeight(0, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalCount != 2 || len(results) != 2 {
+		t.Fatalf("unexpected number of transactions at height; expected %d got %d", 2, totalCount)
+	}
+	if _, err := ctx.ApplyBlock(0, proposer.Address, [][]byte{txBz}, nil); err == nil {
+		t.Fatal("expected an error when applying an already committed transaction")
+	}
+}
+
+func TestUtilityContext_ApplyBlockFailedMessage(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	tx, _, _, signer := NewTestingTransaction(t, ctx)
+	proposer := GetAllTestingValidators(t, ctx)[0]
+	fee, err := ctx.GetMessageSendFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the signer can pay the fee but not the amount sent, so only the message fails
+	if err := ctx.SetAccountAmount(signer.Address(), fee); err != nil {
+		t.Fatal(err)
+	}
+	txBz, err := tx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.ApplyBlock(0, proposer.Address, [][]byte{txBz}, nil); err != nil {
+		t.Fatal(err)
+	}
+	result, err := ctx.GetTransactionByHash(types.TransactionHash(txBz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Code != uint32(sharedTypes.CodeInsufficientAmountError) {
+		t.Fatalf("unexpected transaction result %v", result)
+	}
+	amountAfter, err := ctx.GetAccountAmount(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amountAfter.Sign() != 0 {
+		t.Fatalf("the fee of the failed transaction wasn't charged, the signer has %v left", amountAfter)
+	}
+	sequence, err := ctx.GetAccountSequence(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sequence != 1 {
+		t.Fatalf("unexpected sequence after the failed transaction; expected 1 got %d", sequence)
+	}
+}
+
+func TestUtilityContext_HandleBlockReward(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	reward := big.NewInt(1000003)
+	if err := ctx.UpdateParam(types.BlockRewardAmountParamName, wrapperspb.String(sharedTypes.BigIntToString(reward))); err != nil {
+		t.Fatal(err)
+	}
+	proposer := GetAllTestingValidators(t, ctx)[0]
+	// the expected reward of every address, by stake within the validators and the service nodes
+	expected := map[string]*big.Int{string(proposer.Address): sharedTypes.PercentageOf(reward, 10)}
+	addExpectedShares := func(percentage int, outputs [][]byte, stakedTokens []string) {
+		stakes := make([]*big.Int, len(stakedTokens))
+		for i, tokens := range stakedTokens {
+			stake, err := sharedTypes.StringToBigInt(tokens)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stakes[i] = stake
+		}
+		shares, _ := sharedTypes.ProRata(sharedTypes.PercentageOf(reward, percentage), stakes)
+		for i, share := range shares {
+			if _, ok := expected[string(outputs[i])]; !ok {
+				expected[string(outputs[i])] = big.NewInt(0)
+			}
+			expected[string(outputs[i])].Add(expected[string(outputs[i])], share)
+		}
+	}
+	var outputs [][]byte
+	var stakedTokens []string
+	for _, validator := range GetAllTestingValidators(t, ctx) {
+		outputs, stakedTokens = append(outputs, validator.Output), append(stakedTokens, validator.StakedTokens)
+	}
+	addExpectedShares(40, outputs, stakedTokens)
+	outputs, stakedTokens = nil, nil
+	for _, serviceNode := range GetAllTestingServiceNodes(t, ctx) {
+		outputs, stakedTokens = append(outputs, serviceNode.Output), append(stakedTokens, serviceNode.StakedTokens)
+	}
+	addExpectedShares(40, outputs, stakedTokens)
+	balancesBefore := make(map[string]*big.Int)
+	for address := range expected {
+		balance, err := ctx.GetAccountAmount([]byte(address))
+		if err != nil {
+			t.Fatal(err)
+		}
+		balancesBefore[address] = balance
+	}
+	daoBefore, err := ctx.GetPoolAmount(types.DAOPoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	supplyBefore, err := ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleBlockReward(proposer.Address); err != nil {
+		t.Fatal(err)
+	}
+	expectedDAO := new(big.Int).Set(reward)
+	for address, amount := range expected {
+		balance, err := ctx.GetAccountAmount([]byte(address))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if difference := balance.Sub(balance, balancesBefore[address]); difference.Cmp(amount) != 0 {
+			t.Fatalf("unexpected reward of %x: expected %v got %v", address, amount, difference)
+		}
+		expectedDAO.Sub(expectedDAO, amount)
+	}
+	// the DAO receives its 10% and the rounding remainders
+	daoAfter, err := ctx.GetPoolAmount(types.DAOPoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if difference := daoAfter.Sub(daoAfter, daoBefore); difference.Cmp(expectedDAO) != 0 {
+		t.Fatalf("unexpected DAO reward: expected %v got %v", expectedDAO, difference)
+	}
+	supplyAfter, err := ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if minted := supplyAfter.Sub(supplyAfter, supplyBefore); minted.Cmp(reward) != 0 {
+		t.Fatalf("unexpected amount minted: expected %v got %v", reward, minted)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_HandleBlockRewardJailedServiceNode(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	if err := ctx.UpdateParam(types.BlockRewardAmountParamName, wrapperspb.String("1000000")); err != nil {
+		t.Fatal(err)
+	}
+	jailed := GetAllTestingServiceNodes(t, ctx)[0]
+	if err := ctx.JailServiceNode(jailed.Address, GetAllTestingFishermen(t, ctx)[0].Address); err != nil {
+		t.Fatal(err)
+	}
+	balanceBefore, err := ctx.GetAccountAmount(jailed.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleBlockReward(GetAllTestingValidators(t, ctx)[0].Address); err != nil {
+		t.Fatal(err)
+	}
+	balanceAfter, err := ctx.GetAccountAmount(jailed.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balanceAfter.Cmp(balanceBefore) != 0 {
+		t.Fatalf("the jailed service node was rewarded: %v before, %v after", balanceBefore, balanceAfter)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_UpdateParamInvalidRewardSplit(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	// 10 + 60 + 40 is more than the whole block reward
+	if err := ctx.UpdateParam(types.BlockRewardValidatorsPercentageParamName, wrapperspb.Int32(60)); err == nil || err.Code() != sharedTypes.CodeInvalidRewardSplitError {
+		t.Fatalf("expected an invalid reward split error, got %v", err)
+	}
+	validatorsPercentage, err := ctx.GetBlockRewardValidatorsPercentage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if validatorsPercentage != 40 {
+		t.Fatalf("the rejected change was applied: %d", validatorsPercentage)
+	}
+}
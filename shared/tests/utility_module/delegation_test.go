@@ -0,0 +1,312 @@
+package utility_module
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUtilityContext_HandleMessageDelegate(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	delegator := GetAllTestingAccounts(t, ctx)[0].Address
+	validator := GetAllTestingValidators(t, ctx)[0]
+	_, votingPowerBefore, err := ctx.GetValidatorVotingPower()
+	if err != nil {
+		t.Fatal(err)
+	}
+	amount := big.NewInt(100)
+	delegateTestingTokens(t, ctx, delegator, validator.Address, amount)
+	delegation, err := ctx.GetDelegation(validator.Address, delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the first delegation gets one share per token
+	if delegation == nil || delegation.Shares != types.BigIntToString(amount) {
+		t.Fatalf("unexpected delegation: %v", delegation)
+	}
+	validator = GetAllTestingValidators(t, ctx)[0]
+	if validator.DelegatedTokens != types.BigIntToString(amount) || validator.DelegatorShares != types.BigIntToString(amount) {
+		t.Fatalf("unexpected delegated tokens %s and shares %s", validator.DelegatedTokens, validator.DelegatorShares)
+	}
+	// the delegated tokens count toward the voting power
+	_, votingPowerAfter, err := ctx.GetValidatorVotingPower()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if votingPowerAfter.Sub(votingPowerAfter, votingPowerBefore).Cmp(amount) != 0 {
+		t.Fatalf("the voting power didn't grow by the delegated amount")
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_HandleMessageUndelegate(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	if err := ctx.UpdateParam(typesUtil.ValidatorUnstakingBlocksParamName, wrapperspb.Int32(0)); err != nil {
+		t.Fatal(err)
+	}
+	delegator := GetAllTestingAccounts(t, ctx)[0].Address
+	validator := GetAllTestingValidators(t, ctx)[0]
+	amount := big.NewInt(100)
+	delegateTestingTokens(t, ctx, delegator, validator.Address, amount)
+	msg := &typesUtil.MessageUndelegate{
+		Delegator: delegator,
+		Validator: validator.Address,
+		Shares:    types.BigIntToString(big.NewInt(101)),
+		Signer:    delegator,
+	}
+	if err := ctx.HandleMessageUndelegate(msg); err == nil || err.Code() != types.CodeInsufficientSharesError {
+		t.Fatalf("expected an insufficient shares error, got %v", err)
+	}
+	msg.Shares = types.BigIntToString(amount)
+	if err := ctx.HandleMessageUndelegate(msg); err != nil {
+		t.Fatal(err)
+	}
+	delegation, err := ctx.GetDelegation(validator.Address, delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delegation != nil {
+		t.Fatalf("the delegation wasn't deleted: %v", delegation)
+	}
+	unbondingStakes, err := ctx.GetActorUnbondingStakes(delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unbondingStakes) != 1 || unbondingStakes[0].Amount != types.BigIntToString(amount) {
+		t.Fatalf("unexpected unbonding stakes: %v", unbondingStakes)
+	}
+	requireTokenInvariants(t, ctx)
+	delegatorBefore, err := ctx.GetAccountAmount(delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ReleaseUnbondingStakes(); err != nil {
+		t.Fatal(err)
+	}
+	delegatorAfter, err := ctx.GetAccountAmount(delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released := delegatorAfter.Sub(delegatorAfter, delegatorBefore); released.Cmp(amount) != 0 {
+		t.Fatalf("unexpected amount released: expected %v got %v", amount, released)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_HandleMessageUndelegateNonPositiveShares(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	delegator := GetAllTestingAccounts(t, ctx)[0].Address
+	validators := GetAllTestingValidators(t, ctx)
+	delegateTestingTokens(t, ctx, delegator, validators[0].Address, big.NewInt(100))
+	for _, shares := range []string{"-100", "0"} {
+		undelegate := &typesUtil.MessageUndelegate{
+			Delegator: delegator,
+			Validator: validators[0].Address,
+			Shares:    shares,
+			Signer:    delegator,
+		}
+		if err := ctx.HandleMessageUndelegate(undelegate); err == nil || err.Code() != types.CodeInvalidAmountError {
+			t.Fatalf("expected an invalid amount error for %s shares, got %v", shares, err)
+		}
+		redelegate := &typesUtil.MessageRedelegate{
+			Delegator:            delegator,
+			SourceValidator:      validators[0].Address,
+			DestinationValidator: validators[1].Address,
+			Shares:               shares,
+			Signer:               delegator,
+		}
+		if err := ctx.HandleMessageRedelegate(redelegate); err == nil || err.Code() != types.CodeInvalidAmountError {
+			t.Fatalf("expected an invalid amount error for %s shares, got %v", shares, err)
+		}
+	}
+	delegation, err := ctx.GetDelegation(validators[0].Address, delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delegation == nil || delegation.Shares != "100" {
+		t.Fatalf("unexpected delegation: %v", delegation)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_HandleMessageDoubleSignUnbondingDelegation(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	reporter := GetAllTestingValidators(t, ctx)[0]
+	byzValPrivateKey, _ := crypto.GeneratePrivateKey()
+	byzValAddress := byzValPrivateKey.Address()
+	if err := ctx.MintToAccount(byzValAddress, defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleMessageStakeValidator(&typesUtil.MessageStakeValidator{
+		PublicKey:     byzValPrivateKey.PublicKey().Bytes(),
+		Amount:        defaultAmountString,
+		ServiceUrl:    defaultServiceUrl,
+		OutputAddress: byzValAddress,
+		Signer:        byzValAddress,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// the delegator undelegates after the double sign, before the evidence is submitted
+	delegator := GetAllTestingAccounts(t, ctx)[0].Address
+	amount := big.NewInt(1000)
+	delegateTestingTokens(t, ctx, delegator, byzValAddress, amount)
+	if err := ctx.HandleMessageUndelegate(&typesUtil.MessageUndelegate{
+		Delegator: delegator,
+		Validator: byzValAddress,
+		Shares:    types.BigIntToString(amount),
+		Signer:    delegator,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleMessageDoubleSign(NewTestingDoubleSignMessage(t, byzValPrivateKey, reporter.Address)); err != nil {
+		t.Fatal(err)
+	}
+	burnPercentage, err := ctx.GetDoubleSignBurnPercentage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unbondingStakes, err := ctx.GetActorUnbondingStakes(delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := new(big.Int).Sub(amount, types.PercentageOf(amount, burnPercentage))
+	if len(unbondingStakes) != 1 || unbondingStakes[0].Amount != types.BigIntToString(expected) {
+		t.Fatalf("expected the unbonding delegation to be slashed to %v, got %v", expected, unbondingStakes)
+	}
+	// evidence of a double sign after the undelegation doesn't reach it
+	burned, err := ctx.SlashUnbondingStakes(byzValAddress, 1, burnPercentage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if burned.Sign() != 0 {
+		t.Fatalf("the unbonding delegation was slashed for a later infraction: %v", burned)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_HandleMessageRedelegate(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	delegator := GetAllTestingAccounts(t, ctx)[0].Address
+	validators := GetAllTestingValidators(t, ctx)
+	source, destination := validators[0], validators[1]
+	amount := big.NewInt(100)
+	delegateTestingTokens(t, ctx, delegator, source.Address, amount)
+	msg := &typesUtil.MessageRedelegate{
+		Delegator:            delegator,
+		SourceValidator:      source.Address,
+		DestinationValidator: destination.Address,
+		Shares:               types.BigIntToString(big.NewInt(40)),
+		Signer:               delegator,
+	}
+	if err := ctx.HandleMessageRedelegate(msg); err != nil {
+		t.Fatal(err)
+	}
+	for _, validator := range GetAllTestingValidators(t, ctx) {
+		expected := ""
+		switch {
+		case bytes.Equal(validator.Address, source.Address):
+			expected = "60"
+		case bytes.Equal(validator.Address, destination.Address):
+			expected = "40"
+		default:
+			continue
+		}
+		if validator.DelegatedTokens != expected {
+			t.Fatalf("unexpected delegated tokens of %s: expected %s got %s", hex.EncodeToString(validator.Address), expected, validator.DelegatedTokens)
+		}
+	}
+	delegations, err := ctx.GetDelegatorDelegations(delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(delegations) != 2 {
+		t.Fatalf("expected a delegation to each validator, got %v", delegations)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_RewardDelegators(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	delegator := GetAllTestingAccounts(t, ctx)[0].Address
+	validator := GetAllTestingValidators(t, ctx)[0]
+	if err := ctx.SetValidatorCommission(validator.Address, 10); err != nil {
+		t.Fatal(err)
+	}
+	// delegate as much as the validator stakes, so the delegators hold half of the voting power
+	stake, err := types.StringToBigInt(validator.StakedTokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delegateTestingTokens(t, ctx, delegator, validator.Address, stake)
+	proposerBefore, err := ctx.GetAccountAmount(validator.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetPoolAmount(typesUtil.FeePoolName, big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleProposalRewards(validator.Address); err != nil {
+		t.Fatal(err)
+	}
+	// the delegators earn half of the fees less the 10% commission
+	toDelegators := big.NewInt(450)
+	proposerAfter, err := ctx.GetAccountAmount(validator.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if earned := proposerAfter.Sub(proposerAfter, proposerBefore); earned.Cmp(big.NewInt(550)) != 0 {
+		t.Fatalf("unexpected proposer reward: expected 550 got %v", earned)
+	}
+	validator = GetAllTestingValidators(t, ctx)[0]
+	if expected := new(big.Int).Add(stake, toDelegators); validator.DelegatedTokens != types.BigIntToString(expected) {
+		t.Fatalf("unexpected delegated tokens: expected %v got %s", expected, validator.DelegatedTokens)
+	}
+	// the shares are unchanged, so each one is worth more
+	if validator.DelegatorShares != types.BigIntToString(stake) {
+		t.Fatalf("unexpected delegator shares: expected %v got %s", stake, validator.DelegatorShares)
+	}
+}
+
+func TestUtilityContext_BurnValidatorSlashesDelegators(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	delegator := GetAllTestingAccounts(t, ctx)[0].Address
+	validator := GetAllTestingValidators(t, ctx)[0]
+	amount := big.NewInt(1000)
+	delegateTestingTokens(t, ctx, delegator, validator.Address, amount)
+	if _, err := ctx.BurnValidator(validator.Address, 10); err != nil {
+		t.Fatal(err)
+	}
+	validator = GetAllTestingValidators(t, ctx)[0]
+	if validator.DelegatedTokens != "900" || validator.DelegatorShares != "1000" {
+		t.Fatalf("unexpected delegated tokens %s and shares %s", validator.DelegatedTokens, validator.DelegatorShares)
+	}
+	requireTokenInvariants(t, ctx)
+	// a new delegation gets more shares per token after the slash
+	delegateTestingTokens(t, ctx, delegator, validator.Address, big.NewInt(90))
+	delegation, err := ctx.GetDelegation(validator.Address, delegator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delegation.Shares != "1100" {
+		t.Fatalf("unexpected delegation shares: expected 1100 got %s", delegation.Shares)
+	}
+}
+
+func delegateTestingTokens(t *testing.T, ctx utility.UtilityContext, delegator, validator []byte, amount *big.Int) {
+	msg := &typesUtil.MessageDelegate{
+		Delegator: delegator,
+		Validator: validator,
+		Amount:    types.BigIntToString(amount),
+		Signer:    delegator,
+	}
+	if err := ctx.HandleMessageDelegate(msg); err != nil {
+		t.Fatal(err)
+	}
+}
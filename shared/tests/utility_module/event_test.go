@@ -0,0 +1,171 @@
+package utility_module
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUtilityContext_ApplyBlockEvents(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	tx, _, amount, signer := NewTestingTransaction(t, ctx)
+	failedTx, _, _, failedSigner := NewTestingTransaction(t, ctx)
+	proposer := GetAllTestingValidators(t, ctx)[0]
+	// drain the signer of the failed transaction so it can no longer pay the fee
+	if err := ctx.SetAccountAmount(failedSigner.Address(), big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	txBz, err := tx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	failedTxBz, err := failedTx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.ApplyBlock(0, proposer.Address, [][]byte{txBz, failedTxBz}, nil); err != nil {
+		t.Fatal(err)
+	}
+	result, err := ctx.GetTransactionByHash(typesUtil.TransactionHash(txBz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Events) == 0 {
+		t.Fatal("no events recorded for the transaction")
+	}
+	send := result.Events[0]
+	if send.Type != typesUtil.EventType_EVENT_TYPE_SEND || !bytes.Equal(send.Address, signer.Address()) || send.Amount != types.BigIntToString(amount) {
+		t.Fatalf("unexpected send event %v", send)
+	}
+	fees := 0
+	for _, event := range result.Events {
+		if event.TransactionHash != typesUtil.TransactionHash(txBz) {
+			t.Fatalf("unexpected transaction hash of event %v", event)
+		}
+		if event.Type == typesUtil.EventType_EVENT_TYPE_FEE {
+			fees++
+		}
+	}
+	if fees == 0 {
+		t.Fatal("no fee events recorded for the transaction")
+	}
+	// the events of a failed transaction are reverted with its state
+	failedResult, err := ctx.GetTransactionByHash(typesUtil.TransactionHash(failedTxBz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failedResult.Events) != 0 {
+		t.Fatalf("unexpected events of a failed transaction %v", failedResult.Events)
+	}
+	blockEvents, err := ctx.GetBlockEvents(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blockEvents == nil || len(blockEvents.Events) <= len(result.Events) {
+		t.Fatalf("expected the events of the transaction and of the block lifecycle, got %v", blockEvents)
+	}
+	rewarded := false
+	for _, event := range blockEvents.Events {
+		if event.Height != 0 {
+			t.Fatalf("unexpected height of event %v", event)
+		}
+		if event.Type == typesUtil.EventType_EVENT_TYPE_REWARD && bytes.Equal(event.Address, proposer.Address) {
+			rewarded = true
+		}
+	}
+	if !rewarded {
+		t.Fatal("no reward event recorded for the proposer")
+	}
+}
+
+func TestUtilityContext_RevertLastSavePointEvents(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	from := GetAllTestingAccounts(t, ctx)[0].Address
+	to, _ := crypto.GenerateAddress()
+	msg := &typesUtil.MessageSend{
+		FromAddress: from,
+		ToAddress:   to,
+		Amount:      defaultSendAmountString,
+	}
+	if err := ctx.HandleMessageSend(msg); err != nil {
+		t.Fatal(err)
+	}
+	if len(ctx.Context.Events) != 1 {
+		t.Fatalf("unexpected number of events; expected %d got %d", 1, len(ctx.Context.Events))
+	}
+	if err := ctx.NewSavePoint([]byte("savepoint")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleMessageSend(msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.RevertLastSavePoint(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ctx.Context.Events) != 1 {
+		t.Fatalf("unexpected number of events after reverting; expected %d got %d", 1, len(ctx.Context.Events))
+	}
+}
+
+func TestUtilityContext_GetStatusHistory(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	if err := ctx.UpdateParam(typesUtil.ServiceNodeMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
+		t.Fatal(err)
+	}
+	fisherman := GetAllTestingFishermen(t, ctx)[0]
+	sn := GetAllTestingServiceNodes(t, ctx)[0]
+	if err := ctx.HandleMessagePauseServiceNode(&typesUtil.MessagePauseServiceNode{Address: sn.Address}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.StoreBlockEvents(); err != nil {
+		t.Fatal(err)
+	}
+	ctx.LatestHeight = 2
+	ctx.Context.Events = nil
+	if err := ctx.HandleMessageUnpauseServiceNode(&typesUtil.MessageUnpauseServiceNode{Address: sn.Address}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleMessageFishermanPauseServiceNode(&typesUtil.MessageFishermanPauseServiceNode{
+		Address:  sn.Address,
+		Reporter: fisherman.Address,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.StoreBlockEvents(); err != nil {
+		t.Fatal(err)
+	}
+	history, err := ctx.GetStatusHistory(typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, sn.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		eventType typesUtil.EventType
+		height    int64
+		cause     typesUtil.StatusCause
+	}{
+		{typesUtil.EventType_EVENT_TYPE_PAUSE, 1, typesUtil.StatusCause_STATUS_CAUSE_SELF},
+		{typesUtil.EventType_EVENT_TYPE_UNPAUSE, 2, typesUtil.StatusCause_STATUS_CAUSE_SELF},
+		{typesUtil.EventType_EVENT_TYPE_PAUSE, 2, typesUtil.StatusCause_STATUS_CAUSE_FISHERMAN},
+	}
+	if len(history) != len(expected) {
+		t.Fatalf("unexpected length of the status history, expected %d got %d: %v", len(expected), len(history), history)
+	}
+	for i, event := range history {
+		if event.Type != expected[i].eventType || event.Height != expected[i].height || event.StatusCause != expected[i].cause {
+			t.Fatalf("unexpected status event %d: %v", i, event)
+		}
+	}
+	// the history is kept by actor type
+	history, err = ctx.GetStatusHistory(typesUtil.ActorType_ACTOR_TYPE_APP, sn.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 0 {
+		t.Fatalf("unexpected status history of an app at the address of a service node: %v", history)
+	}
+}
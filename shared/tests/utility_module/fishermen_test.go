@@ -14,6 +14,7 @@ import (
 	"github.com/pokt-network/pocket/shared/types/genesis"
 	"github.com/pokt-network/pocket/utility"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestUtilityContext_HandleMessageStakeFisherman(t *testing.T) {
@@ -135,7 +136,7 @@ func TestUtilityContext_HandleMessagePauseFisherman(t *testing.T) {
 
 func TestUtilityContext_HandleMessageUnpauseFisherman(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
-	if err := ctx.Context.SetFishermanMinimumPauseBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.FishermanMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingFishermen(t, ctx)[0]
@@ -165,7 +166,7 @@ func TestUtilityContext_HandleMessageUnpauseFisherman(t *testing.T) {
 
 func TestUtilityContext_HandleMessageUnstakeFisherman(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
-	if err := ctx.Context.SetFishermanMinimumPauseBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.FishermanMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingFishermen(t, ctx)[0]
@@ -185,7 +186,7 @@ func TestUtilityContext_HandleMessageUnstakeFisherman(t *testing.T) {
 func TestUtilityContext_BeginUnstakingMaxPausedFishermen(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	actor := GetAllTestingFishermen(t, ctx)[0]
-	err := ctx.Context.SetFishermanMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.FishermanMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -418,7 +419,7 @@ func TestUtilityContext_UnstakeFishermenPausedBefore(t *testing.T) {
 	if actor.Status != typesUtil.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetFishermanMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.FishermanMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -444,14 +445,14 @@ func TestUtilityContext_UnstakeFishermenPausedBefore(t *testing.T) {
 func TestUtilityContext_UnstakeFishermenThatAreReady(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	ctx.SetPoolAmount(typesUtil.FishermanStakePoolName, big.NewInt(math.MaxInt64))
-	if err := ctx.Context.SetFishermanUnstakingBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.FishermanUnstakingBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingFishermen(t, ctx)[0]
 	if actor.Status != typesUtil.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetFishermanMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.FishermanMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -539,5 +540,100 @@ func TestUtilityContext_HandleMessageProveTestScore(t *testing.T) {
 }
 
 func TestUtilityContext_HandleMessageTestScore(t *testing.T) {
-	// Not Implemented Yet TODO
+	ctx := NewTestingUtilityContext(t, 1)
+	actor := GetAllTestingFishermen(t, ctx)[0]
+	sn := GetAllTestingServiceNodes(t, ctx)[0]
+	msg := &typesUtil.MessageTestScore{
+		SessionHeader: &typesUtil.SessionHeader{
+			AppPublicKey:       GetAllTestingApps(t, ctx)[0].PublicKey,
+			Chain:              defaultTestingChains[0],
+			SessionBlockHeight: 0,
+		},
+		NumberOfSamples: 4,
+		NullIndicies:    []uint32{0},
+		Address:         sn.Address,
+		Reporter:        actor.Address,
+	}
+	if err := ctx.HandleMessageTestScore(msg); err != nil {
+		t.Fatal(err)
+	}
+	sn = GetAllTestingServiceNodes(t, ctx)[0]
+	if sn.QosScore != 75 || sn.QosSamples != 1 {
+		t.Fatalf("unexpected qos score %d of %d samples", sn.QosScore, sn.QosSamples)
+	}
+	// a service node is tested once per session
+	if err := ctx.HandleMessageTestScore(msg); err == nil || err.Code() != types.CodeTestScoreAlreadyReportedError {
+		t.Fatalf("expected a test score already reported error, got %v", err)
+	}
+	// a fisherman staked after the session began isn't the fisherman of the session
+	pubKey, _ := crypto.GeneratePublicKey()
+	if err := ctx.SetAccountAmount(pubKey.Address(), defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.HandleMessageStakeFisherman(&typesUtil.MessageStakeFisherman{
+		PublicKey:     pubKey.Bytes(),
+		Chains:        defaultTestingChains,
+		Amount:        defaultAmountString,
+		OutputAddress: pubKey.Address(),
+		Signer:        pubKey.Address(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	other := pubKey.Address()
+	msg.Reporter = other
+	msg.Address = GetAllTestingServiceNodes(t, ctx)[1].Address
+	if err := ctx.HandleMessageTestScore(msg); err == nil || err.Code() != types.CodeNotSessionFishermanError {
+		t.Fatalf("expected a not session fisherman error, got %v", err)
+	}
+	msg.Reporter = actor.Address
+	msg.Address = other
+	if err := ctx.HandleMessageTestScore(msg); err == nil || err.Code() != types.CodeNotInSessionError {
+		t.Fatalf("expected a not in session error, got %v", err)
+	}
+}
+
+func TestUtilityContext_ApplyTransactionTestScoreWithoutSamples(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	signer, err := crypto.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.MintToAccount(signer.Address(), defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	fee, err := ctx.GetMessageTestScoreFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &typesUtil.MessageTestScore{
+		SessionHeader: &typesUtil.SessionHeader{
+			AppPublicKey:       GetAllTestingApps(t, ctx)[0].PublicKey,
+			Chain:              defaultTestingChains[0],
+			SessionBlockHeight: 0,
+		},
+		Address:  GetAllTestingServiceNodes(t, ctx)[0].Address,
+		Reporter: GetAllTestingFishermen(t, ctx)[0].Address,
+	}
+	// the message is validated before it is handled, so a score without samples is rejected rather than divided by zero
+	tx := newTestingMessageTransaction(t, signer, 0, fee, msg)
+	if err := ctx.ApplyTransaction(tx); err == nil || err.Code() != types.CodeEmptyTestScoreError {
+		t.Fatalf("expected an empty test score error, got %v", err)
+	}
+}
+
+func TestUtilityContext_GetMessageTestScoreSignerCandidates(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	actor := GetAllTestingFishermen(t, ctx)[0]
+	candidates, err := ctx.GetMessageTestScoreSignerCandidates(&typesUtil.MessageTestScore{
+		Reporter: actor.Address,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(candidates[0], actor.Output) {
+		t.Fatal("output address is not a signer candidate")
+	}
+	if !bytes.Equal(candidates[1], actor.Address) {
+		t.Fatal("operator address is not a signer candidate")
+	}
 }
@@ -2,12 +2,15 @@ package utility_module
 
 import (
 	"bytes"
+	"math"
 	"testing"
 
+	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
 	"github.com/pokt-network/pocket/shared/types/genesis"
 	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -752,6 +755,105 @@ func TestUtilityContext_HandleMessageChangeParameter(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_HandleMessageChangeParameterScheduled(t *testing.T) {
+	cdc := types.GetCodec()
+	ctx := NewTestingUtilityContext(t, 1)
+	defaultParams := DefaultTestingParams(t)
+	newParamValue := defaultParams.BlocksPerSession + 1
+	any, err := cdc.ToAny(wrapperspb.Int32(newParamValue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &typesUtil.MessageChangeParameter{
+		Owner:            typesGenesis.DefaultParamsOwner.Address(),
+		ParameterKey:     typesUtil.BlocksPerSessionParamName,
+		ParameterValue:   any,
+		ActivationHeight: 1,
+	}
+	if err := ctx.HandleMessageChangeParameter(msg); err == nil || err.Code() != types.CodeInvalidActivationHeightError {
+		t.Fatalf("expected an invalid activation height error, got %v", err)
+	}
+	msg.ActivationHeight = 3
+	if err := ctx.HandleMessageChangeParameter(msg); err != nil {
+		t.Fatal(err)
+	}
+	// the change waits in the queue until the BeginBlock of its activation height
+	tests := []struct {
+		height   int64
+		expected int32
+	}{
+		{2, defaultParams.BlocksPerSession},
+		{3, newParamValue},
+	}
+	for _, test := range tests {
+		ctx.LatestHeight = test.height
+		if err := ctx.BeginBlock(nil); err != nil {
+			t.Fatal(err)
+		}
+		gotParam, err := ctx.GetBlocksPerSession()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int32(gotParam) != test.expected {
+			t.Fatalf("unexpected param value at height %d: expected %v got %v", test.height, test.expected, gotParam)
+		}
+	}
+	pending, err := ctx.GetAllPendingParamChanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("unexpected pending param changes after activation %v", pending)
+	}
+}
+
+func TestUtilityContext_HandleMessageChangeParameterScheduledParamLimit(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	newScheduledChange := func(paramName string, value int32) *typesUtil.MessageChangeParameter {
+		any, err := types.GetCodec().ToAny(wrapperspb.Int32(value))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &typesUtil.MessageChangeParameter{
+			Owner:            typesGenesis.DefaultParamsOwner.Address(),
+			ParameterKey:     paramName,
+			ParameterValue:   any,
+			ActivationHeight: 3,
+		}
+	}
+	// 10 + 60 + 40 is more than the whole block reward
+	msg := newScheduledChange(typesUtil.BlockRewardValidatorsPercentageParamName, 60)
+	if err := ctx.HandleMessageChangeParameter(msg); err == nil || err.Code() != types.CodeInvalidRewardSplitError {
+		t.Fatalf("expected an invalid reward split error, got %v", err)
+	}
+	// 10 + 50 + 40 is valid when scheduled, but not after the proposer percentage changes before the activation
+	msg = newScheduledChange(typesUtil.BlockRewardValidatorsPercentageParamName, 50)
+	if err := ctx.HandleMessageChangeParameter(msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.UpdateParam(typesUtil.BlockRewardProposerPercentageParamName, wrapperspb.Int32(20)); err != nil {
+		t.Fatal(err)
+	}
+	ctx.LatestHeight = 3
+	if err := ctx.BeginBlock(nil); err != nil {
+		t.Fatal(err)
+	}
+	validatorsPercentage, err := ctx.GetBlockRewardValidatorsPercentage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if validatorsPercentage != 40 {
+		t.Fatalf("the invalid scheduled change was applied: %d", validatorsPercentage)
+	}
+	pending, err := ctx.GetAllPendingParamChanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("unexpected pending param changes after activation %v", pending)
+	}
+}
+
 func TestUtilityContext_GetParamOwner(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -1621,3 +1723,69 @@ func TestUtilityContext_GetParamOwner(t *testing.T) {
 		t.Fatalf("unexpected param value: expected %v got %v", defaultParam, gotParam)
 	}
 }
+
+func TestUtilityContext_UpdateParamRegistry(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	for _, param := range typesGenesis.ParamRegistry {
+		gotParam, err := ctx.GetParam(param.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(gotParam, param.Get(defaultParams)) {
+			t.Fatalf("unexpected param value for %s: expected %v got %v", param.Key, param.Get(defaultParams), gotParam)
+		}
+		owner, er := ctx.GetParamOwner(param.Key)
+		if er != nil {
+			t.Fatal(er)
+		}
+		if !bytes.Equal(owner, typesGenesis.DefaultParamsOwner.Address()) {
+			t.Fatalf("unexpected owner for %s: expected %v got %v", param.Key, typesGenesis.DefaultParamsOwner.Address(), owner)
+		}
+	}
+	newOwner, er := crypto.GeneratePrivateKey()
+	if er != nil {
+		t.Fatal(er)
+	}
+	for _, param := range typesGenesis.ParamRegistry {
+		var valid, invalid, wrongType proto.Message
+		switch param.Default.(type) {
+		case *wrapperspb.Int32Value:
+			// 1 is within the bounds of every int32 param and keeps the percentage splits within 100
+			valid, invalid, wrongType = wrapperspb.Int32(1), wrapperspb.Int32(math.MinInt32), wrapperspb.String("100")
+			if param.Key == typesUtil.AppStabilityAdjustmentParamName {
+				invalid = nil // every int32 is in bounds
+			}
+		case *wrapperspb.StringValue:
+			valid, invalid, wrongType = wrapperspb.String("100"), wrapperspb.String("-100"), wrapperspb.Int32(100)
+		case *wrapperspb.BytesValue:
+			valid, invalid, wrongType = wrapperspb.Bytes(newOwner.Address()), wrapperspb.Bytes([]byte("short")), wrapperspb.Int32(100)
+		case *typesGenesis.RelayChains:
+			valid = &typesGenesis.RelayChains{Value: []*typesGenesis.RelayChain{{Id: "0001", Enabled: true}, {Id: "0002"}}}
+			invalid, wrongType = &typesGenesis.RelayChains{}, wrapperspb.Int32(100)
+		default:
+			t.Fatalf("no test values for %s of type %T", param.Key, param.Default)
+		}
+		if err := ctx.UpdateParam(param.Key, wrongType); err == nil {
+			t.Fatalf("expected the update of %s with a %T to fail", param.Key, wrongType)
+		}
+		if invalid != nil {
+			if err := ctx.UpdateParam(param.Key, invalid); err == nil {
+				t.Fatalf("expected the update of %s to %v to fail", param.Key, invalid)
+			}
+		}
+		if err := ctx.UpdateParam(param.Key, valid); err != nil {
+			t.Fatal(err)
+		}
+		gotParam, err := ctx.GetParam(param.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(gotParam, valid) {
+			t.Fatalf("unexpected param value for %s after update: expected %v got %v", param.Key, valid, gotParam)
+		}
+	}
+	if err := ctx.UpdateParam("UnknownParam", wrapperspb.Int32(0)); err == nil || err.Code() != types.CodeUnknownParamError {
+		t.Fatalf("expected an unknown param error, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package utility_module
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+func TestUtilityContext_RecheckMempool(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	committedTx, _, _, _ := NewTestingTransaction(t, ctx)
+	validTx, _, _, _ := NewTestingTransaction(t, ctx)
+	invalidTx, _, _, invalidSigner := NewTestingTransaction(t, ctx)
+	transactions := make([][]byte, 0)
+	for _, tx := range []*typesUtil.Transaction{committedTx, validTx, invalidTx} {
+		txBz, err := tx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ctx.CheckTransaction(txBz); err != nil {
+			t.Fatal(err)
+		}
+		transactions = append(transactions, txBz)
+	}
+	// drain the signer of the invalid transaction so it can no longer pay the fee
+	if err := ctx.SetAccountAmount(invalidSigner.Address(), big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	counters, err := ctx.RecheckMempool(transactions[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counters.CommittedRemoved != 1 {
+		t.Fatalf("unexpected committed removed count; expected %d got %d", 1, counters.CommittedRemoved)
+	}
+	if counters.Rechecked != 2 {
+		t.Fatalf("unexpected rechecked count; expected %d got %d", 2, counters.Rechecked)
+	}
+	if counters.Evicted != 1 {
+		t.Fatalf("unexpected evicted count; expected %d got %d", 1, counters.Evicted)
+	}
+	if counters.Remaining != 1 || ctx.Mempool.Size() != 1 {
+		t.Fatalf("unexpected remaining count; expected %d got %d", 1, counters.Remaining)
+	}
+	remaining := ctx.Mempool.GetTransactions()
+	if !bytes.Equal(remaining[0], transactions[1]) {
+		t.Fatal("the valid transaction was not the one left in the mempool")
+	}
+	if len(ctx.Context.SavePoints) != 0 {
+		t.Fatalf("unexpected number of save points after the recheck; expected %d got %d", 0, len(ctx.Context.SavePoints))
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pokt-network/pocket/shared/types"
 	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
 	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
 	"github.com/syndtr/goleveldb/leveldb/comparer"
 	"github.com/syndtr/goleveldb/leveldb/memdb"
 )
@@ -31,7 +32,7 @@ var (
 )
 
 func NewTestingMempool(_ *testing.T) types.Mempool {
-	return types.NewMempool(1000000, 1000)
+	return types.NewPriorityMempool(1000000, 1000, typesUtil.TransactionFeePriority)
 }
 
 func NewTestingUtilityContext(t *testing.T, height int64) utility.UtilityContext {
@@ -46,15 +47,25 @@ func NewTestingUtilityContext(t *testing.T, height int64) utility.UtilityContext
 	if err != nil {
 		t.Fatal(err)
 	}
-	return utility.UtilityContext{
-		LatestHeight: height,
-		Mempool:      mempool,
+	ctx := utility.UtilityContext{
+		LatestHeight:    height,
+		Mempool:         mempool,
+		CheckInvariants: true,
 		Context: &utility.Context{
 			PersistenceContext: persistenceContext,
 			SavePointsM:        make(map[string]struct{}),
 			SavePoints:         make([][]byte, 0),
 		},
 	}
+	// the edit stake tests move the actors to the edited chains
+	chains := &typesGenesis.RelayChains{}
+	for _, chain := range append(defaultTestingChains, defaultTestingChainsEdited...) {
+		chains.Value = append(chains.Value, &typesGenesis.RelayChain{Id: chain, Enabled: true})
+	}
+	if err := ctx.UpdateParam(typesUtil.RelayChainsParamName, chains); err != nil {
+		t.Fatal(err)
+	}
+	return ctx
 }
 
 func genesisJson() string {
@@ -0,0 +1,173 @@
+package utility_module
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/types"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUtilityContext_HandleMessageSubmitProposal(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	proposer := GetAllTestingAccounts(t, ctx)[0]
+	minDeposit, err := ctx.GetGovMinimumDeposit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := newTestingSubmitProposalMessage(t, ctx, proposer.Address, typesUtil.MissedBlocksBurnPercentageParamName, wrapperspb.Int32(2))
+	if err := ctx.HandleMessageSubmitProposal(msg); err != nil {
+		t.Fatal(err)
+	}
+	proposal, err := ctx.GetProposal(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	votingPeriod, err := ctx.GetGovVotingPeriodBlocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposal.Status != typesUtil.ProposalStatus_PROPOSAL_STATUS_VOTING || proposal.VotingEndHeight != votingPeriod {
+		t.Fatalf("unexpected proposal %v", proposal)
+	}
+	depositPoolAmount, err := ctx.GetPoolAmount(typesUtil.GovDepositPoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if depositPoolAmount.Cmp(minDeposit) != 0 {
+		t.Fatalf("unexpected deposit pool amount: expected %v got %v", minDeposit, depositPoolAmount)
+	}
+	msg.Deposit = types.BigIntToString(minDeposit.Sub(minDeposit, defaultSendAmount))
+	if err := ctx.HandleMessageSubmitProposal(msg); err == nil || err.Code() != types.CodeInsufficientDepositError {
+		t.Fatalf("expected an insufficient deposit error, got %v", err)
+	}
+	msg = newTestingSubmitProposalMessage(t, ctx, proposer.Address, typesUtil.MissedBlocksBurnPercentageParamName, wrapperspb.Int32(101))
+	if err := ctx.HandleMessageSubmitProposal(msg); err == nil || err.Code() != types.CodeParamOutOfBoundsError {
+		t.Fatalf("expected a param out of bounds error, got %v", err)
+	}
+}
+
+func TestUtilityContext_TallyProposals(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	if err := ctx.UpdateParam(typesUtil.GovVotingPeriodBlocksParamName, wrapperspb.Int32(1)); err != nil {
+		t.Fatal(err)
+	}
+	proposer := GetAllTestingAccounts(t, ctx)[0]
+	passing := newTestingSubmitProposalMessage(t, ctx, proposer.Address, typesUtil.MissedBlocksBurnPercentageParamName, wrapperspb.Int32(2))
+	noQuorum := newTestingSubmitProposalMessage(t, ctx, proposer.Address, typesUtil.MissedBlocksBurnPercentageParamName, wrapperspb.Int32(3))
+	for _, msg := range []*typesUtil.MessageSubmitProposal{passing, noQuorum} {
+		if err := ctx.HandleMessageSubmitProposal(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	accountAmountAfterDeposits, err := ctx.GetAccountAmount(proposer.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	daoAmountBefore, err := ctx.GetPoolAmount(typesUtil.DAOPoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every validator votes yes on the first proposal; the second one gets no votes
+	for _, validator := range GetAllTestingValidators(t, ctx) {
+		msg := &typesUtil.MessageVoteProposal{
+			Voter:      validator.Address,
+			ProposalId: 0,
+			Option:     typesUtil.VoteOption_VOTE_OPTION_YES,
+		}
+		if err := ctx.HandleMessageVoteProposal(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx.LatestHeight = 1
+	if err := ctx.TallyProposals(); err != nil {
+		t.Fatal(err)
+	}
+	requireProposalStatus(t, ctx, 0, typesUtil.ProposalStatus_PROPOSAL_STATUS_PASSED)
+	requireProposalStatus(t, ctx, 1, typesUtil.ProposalStatus_PROPOSAL_STATUS_REJECTED)
+	burnPercentage, err := ctx.GetMissedBlocksBurnPercentage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if burnPercentage != 2 {
+		t.Fatalf("the passed param change was not applied: expected 2 got %d", burnPercentage)
+	}
+	// the deposit of the passed proposal is refunded and the one without quorum goes to the dao
+	deposit, err := types.StringToBigInt(passing.Deposit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountAmount, err := ctx.GetAccountAmount(proposer.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accountAmount.Sub(accountAmount, accountAmountAfterDeposits).Cmp(deposit) != 0 {
+		t.Fatalf("unexpected refund: expected %v got %v", deposit, accountAmount)
+	}
+	daoAmount, err := ctx.GetPoolAmount(typesUtil.DAOPoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if daoAmount.Sub(daoAmount, daoAmountBefore).Cmp(deposit) != 0 {
+		t.Fatalf("unexpected dao amount: expected an increase of %v got %v", deposit, daoAmount)
+	}
+	vote := &typesUtil.MessageVoteProposal{
+		Voter:      GetAllTestingValidators(t, ctx)[0].Address,
+		ProposalId: 0,
+		Option:     typesUtil.VoteOption_VOTE_OPTION_NO,
+	}
+	if err := ctx.HandleMessageVoteProposal(vote); err == nil || err.Code() != types.CodeVotingPeriodClosedError {
+		t.Fatalf("expected a voting period closed error, got %v", err)
+	}
+}
+
+func TestUtilityContext_GetMessageVoteProposalSignerCandidates(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	validator := GetAllTestingValidators(t, ctx)[0]
+	candidates, err := ctx.GetMessageVoteProposalSignerCandidates(&typesUtil.MessageVoteProposal{Voter: validator.Address})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 2 {
+		t.Fatalf("wrong number of candidates, expected 2 got %d", len(candidates))
+	}
+	account := GetAllTestingAccounts(t, ctx)[0]
+	if _, err := ctx.GetMessageVoteProposalSignerCandidates(&typesUtil.MessageVoteProposal{Voter: account.Address}); err == nil {
+		t.Fatal("expected an error for a voter that is not a validator")
+	}
+}
+
+func newTestingSubmitProposalMessage(t *testing.T, ctx utility.UtilityContext, proposer []byte, paramKey string, value *wrapperspb.Int32Value) *typesUtil.MessageSubmitProposal {
+	any, err := types.GetCodec().ToAny(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minDeposit, err := ctx.GetGovMinimumDeposit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &typesUtil.MessageSubmitProposal{
+		Proposer: proposer,
+		Deposit:  types.BigIntToString(minDeposit),
+		Content: &typesUtil.ProposalContent{
+			Title: "change " + paramKey,
+			Action: &typesUtil.ProposalContent_ParamChange{
+				ParamChange: &typesUtil.ProposalParamChange{
+					ParameterKey:   paramKey,
+					ParameterValue: any,
+				},
+			},
+		},
+	}
+}
+
+func requireProposalStatus(t *testing.T, ctx utility.UtilityContext, proposalID uint64, expected typesUtil.ProposalStatus) {
+	proposal, err := ctx.GetProposal(proposalID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposal.Status != expected {
+		t.Fatalf("unexpected status of proposal %d: expected %v got %v", proposalID, expected, proposal.Status)
+	}
+}
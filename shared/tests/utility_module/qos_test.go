@@ -0,0 +1,81 @@
+package utility_module
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUtilityContext_HandleServiceNodeTestScore(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	if err := ctx.UpdateParam(typesUtil.ServiceNodeQoSWindowParamName, wrapperspb.Int32(2)); err != nil {
+		t.Fatal(err)
+	}
+	reporter := GetAllTestingFishermen(t, ctx)[0].Address
+	serviceNodes := GetAllTestingServiceNodes(t, ctx)
+	good, bad := serviceNodes[0].Address, serviceNodes[1].Address
+	stake, err := types.StringToBigInt(serviceNodes[1].StakedTokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporterAmount, err := ctx.GetAccountAmount(reporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	totalSupply, err := ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a window averaging the minimum score doesn't jail the service node
+	for _, score := range []int{100, 0} {
+		if err := ctx.HandleServiceNodeTestScore(good, reporter, score); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sn := GetAllTestingServiceNodes(t, ctx)[0]
+	if sn.QosScore != 50 || sn.QosSamples != 2 || sn.JailedUntilHeight != 0 {
+		t.Fatalf("unexpected qos of the good service node: score %d of %d samples, jailed until %d", sn.QosScore, sn.QosSamples, sn.JailedUntilHeight)
+	}
+	// a single bad score doesn't fill the window
+	if err := ctx.HandleServiceNodeTestScore(bad, reporter, 0); err != nil {
+		t.Fatal(err)
+	}
+	if sn := GetAllTestingServiceNodes(t, ctx)[1]; sn.JailedUntilHeight != 0 {
+		t.Fatalf("the service node is jailed before its window is full, until %d", sn.JailedUntilHeight)
+	}
+	if err := ctx.HandleServiceNodeTestScore(bad, reporter, 0); err != nil {
+		t.Fatal(err)
+	}
+	params := DefaultTestingParams(t)
+	sn = GetAllTestingServiceNodes(t, ctx)[1]
+	if expected := 1 + int64(params.ServiceNodeJailBlocks); sn.JailedUntilHeight != expected || sn.QosSamples != 0 {
+		t.Fatalf("unexpected jail of the bad service node: jailed until %d, expected %d, with %d samples", sn.JailedUntilHeight, expected, sn.QosSamples)
+	}
+	slashed := types.PercentageOf(stake, int(params.ServiceNodeSlashPercentage))
+	if expected := types.BigIntToString(big.NewInt(0).Sub(stake, slashed)); sn.StakedTokens != expected {
+		t.Fatalf("unexpected stake after the slash: expected %s got %s", expected, sn.StakedTokens)
+	}
+	bounty := types.PercentageOf(slashed, int(params.FishermanBountyPercentage))
+	amount, err := ctx.GetAccountAmount(reporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := big.NewInt(0).Add(reporterAmount, bounty); amount.Cmp(expected) != 0 {
+		t.Fatalf("unexpected amount of the reporter after the bounty: expected %v got %v", expected, amount)
+	}
+	// the bounty is paid out of the slash, so only the rest of the slash is burned
+	totalSupplyAfter, err := ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := big.NewInt(0).Sub(totalSupply, big.NewInt(0).Sub(slashed, bounty)); totalSupplyAfter.Cmp(expected) != 0 {
+		t.Fatalf("unexpected total supply after the slash: expected %v got %v", expected, totalSupplyAfter)
+	}
+	// a jailed service node isn't tested until it is released
+	if err := ctx.HandleServiceNodeTestScore(bad, reporter, 0); err == nil || err.Code() != types.CodeServiceNodeJailedError {
+		t.Fatalf("expected a jailed service node error, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package utility_module
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+func TestUtilityContext_HandleMessageStakeServiceNodeRelayChains(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	registry := &typesGenesis.RelayChains{Value: []*typesGenesis.RelayChain{
+		{Id: "0001", Description: "enabled", Enabled: true},
+		{Id: "0002", Description: "disabled", Enabled: false},
+	}}
+	if err := ctx.UpdateParam(typesUtil.RelayChainsParamName, registry); err != nil {
+		t.Fatal(err)
+	}
+	pubKey, _ := crypto.GeneratePublicKey()
+	out, _ := crypto.GenerateAddress()
+	if err := ctx.SetAccountAmount(out, defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	msg := &typesUtil.MessageStakeServiceNode{
+		PublicKey:     pubKey.Bytes(),
+		Chains:        []string{"0001", "0003"},
+		Amount:        defaultAmountString,
+		ServiceUrl:    defaultServiceUrl,
+		OutputAddress: out,
+		Signer:        out,
+	}
+	if err := ctx.HandleMessageStakeServiceNode(msg); err == nil || err.Code() != types.CodeUnknownRelayChainError {
+		t.Fatalf("expected an unknown relay chain error, got %v", err)
+	}
+	msg.Chains = []string{"0001", "0002"}
+	if err := ctx.HandleMessageStakeServiceNode(msg); err == nil || err.Code() != types.CodeDisabledRelayChainError {
+		t.Fatalf("expected a disabled relay chain error, got %v", err)
+	}
+	msg.Chains = []string{"0001"}
+	if err := ctx.HandleMessageStakeServiceNode(msg); err != nil {
+		t.Fatal(err)
+	}
+	// an actor staked for an enabled chain can't move to a disabled one
+	edit := &typesUtil.MessageEditStakeServiceNode{
+		Address:     pubKey.Address(),
+		Chains:      []string{"0002"},
+		AmountToAdd: zeroAmountString,
+		ServiceUrl:  defaultServiceUrl,
+		Signer:      out,
+	}
+	if err := ctx.HandleMessageEditStakeServiceNode(edit); err == nil || err.Code() != types.CodeDisabledRelayChainError {
+		t.Fatalf("expected a disabled relay chain error, got %v", err)
+	}
+}
+
+func TestUtilityContext_GetRelayChainActorCounts(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	counts, err := ctx.GetRelayChainActorCounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := defaultTestingChains[0]
+	count, ok := counts[chain]
+	if !ok {
+		t.Fatalf("no count for chain %s", chain)
+	}
+	if count.Apps != len(GetAllTestingApps(t, ctx)) || count.ServiceNodes != len(GetAllTestingServiceNodes(t, ctx)) || count.Fishermen != len(GetAllTestingFishermen(t, ctx)) {
+		t.Fatalf("unexpected actor counts for chain %s: %+v", chain, count)
+	}
+	// a registered chain no one stakes for is counted as well
+	edited := defaultTestingChainsEdited[0]
+	if count, ok := counts[edited]; !ok || *count != (utility.RelayChainActorCount{}) {
+		t.Fatalf("unexpected actor counts for chain %s: %+v", edited, count)
+	}
+}
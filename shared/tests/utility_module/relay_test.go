@@ -0,0 +1,143 @@
+package utility_module
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/types"
+	"github.com/pokt-network/pocket/shared/types/genesis"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+func TestUtilityContext_GetSessionRelayBudget(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	app := setTestingAppMaxRelays(t, ctx, "100")
+	session, err := ctx.GetSession(app.PublicKey, defaultTestingChains[0], 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	budget, err := ctx.GetSessionRelayBudget(session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the max relays are split between the chains of the app and the service nodes of the session
+	if expected := uint64(100 / len(app.Chains) / len(session.ServiceNodes)); budget != expected {
+		t.Fatalf("unexpected relay budget, expected %d got %d", expected, budget)
+	}
+	// an app that isn't staked for the chain has no budget
+	session.SessionHeader.Chain = defaultTestingChainsEdited[0]
+	budget, err = ctx.GetSessionRelayBudget(session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if budget != 0 {
+		t.Fatalf("unexpected relay budget on a chain the app isn't staked for: %d", budget)
+	}
+}
+
+func TestUtilityContext_HandleMessageClaim(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	app := setTestingAppMaxRelays(t, ctx, "10")
+	serviceNodes := GetAllTestingServiceNodes(t, ctx)
+	// 10 relays between 5 service nodes
+	budget := uint64(10 / len(serviceNodes))
+	msg := &typesUtil.MessageClaim{
+		SessionHeader: &typesUtil.SessionHeader{
+			AppPublicKey:       app.PublicKey,
+			Chain:              defaultTestingChains[0],
+			SessionBlockHeight: 0,
+		},
+		Address: serviceNodes[0].Address,
+		Relays:  budget,
+	}
+	if err := ctx.HandleMessageClaim(msg); err != nil {
+		t.Fatal(err)
+	}
+	session, err := ctx.GetSession(app.PublicKey, defaultTestingChains[0], 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claimed, err := ctx.GetClaimedRelays(session.SessionKey, serviceNodes[0].Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claimed != budget {
+		t.Fatalf("unexpected claimed relays, expected %d got %d", budget, claimed)
+	}
+	// the budget is used up
+	msg.Relays = 1
+	if err := ctx.HandleMessageClaim(msg); err == nil || err.Code() != types.CodeRelayBudgetExceededError {
+		t.Fatalf("expected a relay budget exceeded error, got %v", err)
+	}
+	// another service node of the session has its own budget
+	msg.Address = serviceNodes[1].Address
+	if err := ctx.HandleMessageClaim(msg); err != nil {
+		t.Fatal(err)
+	}
+	msg.Address = GetAllTestingFishermen(t, ctx)[0].Address
+	if err := ctx.HandleMessageClaim(msg); err == nil || err.Code() != types.CodeNotInSessionError {
+		t.Fatalf("expected a not in session error, got %v", err)
+	}
+	// a session that hasn't begun can't be claimed
+	msg.Address = serviceNodes[0].Address
+	msg.SessionHeader.SessionBlockHeight = 4
+	if err := ctx.HandleMessageClaim(msg); err == nil || err.Code() != types.CodeInvalidSessionHeightError {
+		t.Fatalf("expected an invalid session height error, got %v", err)
+	}
+}
+
+func TestUtilityContext_MeterRelay(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	app := setTestingAppMaxRelays(t, ctx, "10")
+	servicer := GetAllTestingServiceNodes(t, ctx)[0].Address
+	meter := utility.NewRelayMeter()
+	var session *typesUtil.Session
+	for i := 0; i < 2; i++ {
+		s, err := ctx.MeterRelay(meter, servicer, app.PublicKey, defaultTestingChains[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		session = s
+	}
+	// the servicer refuses the relays past its budget
+	if _, err := ctx.MeterRelay(meter, servicer, app.PublicKey, defaultTestingChains[0]); err == nil || err.Code() != types.CodeRelayBudgetExceededError {
+		t.Fatalf("expected a relay budget exceeded error, got %v", err)
+	}
+	if relays := meter.Relays(session.SessionKey); relays != 2 {
+		t.Fatalf("unexpected metered relays, expected %d got %d", 2, relays)
+	}
+	meter.Delete(session.SessionKey)
+	if relays := meter.Relays(session.SessionKey); relays != 0 {
+		t.Fatalf("unexpected metered relays after deleting the session: %d", relays)
+	}
+	if _, err := ctx.MeterRelay(meter, servicer, app.PublicKey, defaultTestingChains[0]); err != nil {
+		t.Fatal(err)
+	}
+	// the relays of the last session are kept for its claim, and the older sessions are pruned
+	ctx.LatestHeight = 4
+	if err := ctx.PruneRelayMeter(meter); err != nil {
+		t.Fatal(err)
+	}
+	if relays := meter.Relays(session.SessionKey); relays != 1 {
+		t.Fatalf("unexpected metered relays of the last session: %d", relays)
+	}
+	ctx.LatestHeight = 8
+	if err := ctx.PruneRelayMeter(meter); err != nil {
+		t.Fatal(err)
+	}
+	if relays := meter.Relays(session.SessionKey); relays != 0 {
+		t.Fatalf("unexpected metered relays after pruning the session: %d", relays)
+	}
+}
+
+func setTestingAppMaxRelays(t *testing.T, ctx utility.UtilityContext, maxRelays string) *genesis.App {
+	app := GetAllTestingApps(t, ctx)[0]
+	stake, err := types.StringToBigInt(app.StakedTokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetAppStakedTokensAndMaxRelays(app.Address, stake, maxRelays); err != nil {
+		t.Fatal(err)
+	}
+	return GetAllTestingApps(t, ctx)[0]
+}
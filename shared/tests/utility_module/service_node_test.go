@@ -14,6 +14,7 @@ import (
 	"github.com/pokt-network/pocket/shared/types/genesis"
 	"github.com/pokt-network/pocket/utility"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestUtilityContext_HandleMessageStakeServiceNode(t *testing.T) {
@@ -158,7 +159,7 @@ func TestUtilityContext_HandleMessagePauseServiceNode(t *testing.T) {
 
 func TestUtilityContext_HandleMessageUnpauseServiceNode(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
-	if err := ctx.Context.SetServiceNodeMinimumPauseBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.ServiceNodeMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingServiceNodes(t, ctx)[0]
@@ -188,7 +189,7 @@ func TestUtilityContext_HandleMessageUnpauseServiceNode(t *testing.T) {
 
 func TestUtilityContext_HandleMessageUnstakeServiceNode(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
-	if err := ctx.Context.SetServiceNodeMinimumPauseBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.ServiceNodeMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingServiceNodes(t, ctx)[0]
@@ -208,7 +209,7 @@ func TestUtilityContext_HandleMessageUnstakeServiceNode(t *testing.T) {
 func TestUtilityContext_BeginUnstakingMaxPausedServiceNodes(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	actor := GetAllTestingServiceNodes(t, ctx)[0]
-	err := ctx.Context.SetServiceNodeMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.ServiceNodeMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -442,7 +443,7 @@ func TestUtilityContext_UnstakeServiceNodesPausedBefore(t *testing.T) {
 	if actor.Status != typesUtil.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetServiceNodeMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.ServiceNodeMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -468,14 +469,14 @@ func TestUtilityContext_UnstakeServiceNodesPausedBefore(t *testing.T) {
 func TestUtilityContext_UnstakeServiceNodesThatAreReady(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	ctx.SetPoolAmount(typesUtil.ServiceNodeStakePoolName, big.NewInt(math.MaxInt64))
-	if err := ctx.Context.SetServiceNodeUnstakingBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.ServiceNodeUnstakingBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingServiceNodes(t, ctx)[0]
 	if actor.Status != typesUtil.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetServiceNodeMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.ServiceNodeMaxPauseBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,146 @@
+package utility_module
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/types/genesis"
+	"github.com/pokt-network/pocket/utility"
+)
+
+func TestUtilityContext_GetSession(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	app := GetAllTestingApps(t, ctx)[0]
+	fisherman := GetAllTestingFishermen(t, ctx)[0]
+	serviceNodes := GetAllTestingServiceNodes(t, ctx)
+	jailed, paused := serviceNodes[0].Address, serviceNodes[1].Address
+	if err := ctx.JailServiceNode(jailed, fisherman.Address); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetServiceNodePauseHeight(paused, 1); err != nil {
+		t.Fatal(err)
+	}
+	session, err := ctx.GetSession(app.PublicKey, defaultTestingChains[0], 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.SessionHeader.SessionBlockHeight != 0 {
+		t.Fatalf("unexpected session height, expected 0 got %d", session.SessionHeader.SessionBlockHeight)
+	}
+	if len(session.ServiceNodes) != len(serviceNodes)-2 {
+		t.Fatalf("unexpected number of session service nodes, expected %d got %d", len(serviceNodes)-2, len(session.ServiceNodes))
+	}
+	for _, address := range session.ServiceNodes {
+		if bytes.Equal(address, jailed) || bytes.Equal(address, paused) {
+			t.Fatalf("the jailed or paused service node %x is in the session", address)
+		}
+	}
+	if !bytes.Equal(session.Fishermen, fisherman.Address) {
+		t.Fatalf("unexpected session fisherman, expected %x got %x", fisherman.Address, session.Fishermen)
+	}
+	// every height of the session results in the same session
+	other, err := ctx.GetSession(app.PublicKey, defaultTestingChains[0], 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(session.SessionKey, other.SessionKey) || len(session.ServiceNodes) != len(other.ServiceNodes) {
+		t.Fatal("heights of the same session resulted in different sessions")
+	}
+	for i := range session.ServiceNodes {
+		if !bytes.Equal(session.ServiceNodes[i], other.ServiceNodes[i]) {
+			t.Fatal("heights of the same session resulted in different service nodes")
+		}
+	}
+}
+
+func TestUtilityContext_GetSessionAtSessionHeight(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	app := GetAllTestingApps(t, ctx)[0]
+	serviceNodes := GetAllTestingServiceNodes(t, ctx)
+	// jailing at height 1 doesn't change the session that began at height 0
+	if err := ctx.JailServiceNode(serviceNodes[0].Address, GetAllTestingFishermen(t, ctx)[0].Address); err != nil {
+		t.Fatal(err)
+	}
+	session, err := ctx.GetSession(app.PublicKey, defaultTestingChains[0], 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.SessionHeader.SessionBlockHeight != 0 || len(session.ServiceNodes) != len(serviceNodes) {
+		t.Fatalf("unexpected session %v: expected the %d service nodes staked at height 0", session, len(serviceNodes))
+	}
+}
+
+func TestSelectSessionServiceNodes_Deterministic(t *testing.T) {
+	candidates := testingSessionCandidates()
+	reversed := make([]*genesis.ServiceNode, len(candidates))
+	for i, candidate := range candidates {
+		reversed[len(candidates)-1-i] = candidate
+	}
+	sessionKey := utility.GetSessionKey([]byte("app"), defaultTestingChains[0], 0)
+	selected, err := utility.SelectSessionServiceNodes(sessionKey, candidates, 20, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := utility.SelectSessionServiceNodes(sessionKey, reversed, 20, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 3 || len(other) != 3 {
+		t.Fatalf("unexpected number of selected service nodes: %d and %d", len(selected), len(other))
+	}
+	for i := range selected {
+		if !bytes.Equal(selected[i].Address, other[i].Address) {
+			t.Fatalf("the order of the candidates changed the selection at %d: %x vs %x", i, selected[i].Address, other[i].Address)
+		}
+		for j := 0; j < i; j++ {
+			if bytes.Equal(selected[i].Address, selected[j].Address) {
+				t.Fatalf("the service node %x was selected twice", selected[i].Address)
+			}
+		}
+	}
+	// asking for more service nodes than there are candidates selects every candidate
+	all, err := utility.SelectSessionServiceNodes(sessionKey, candidates, 20, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != len(candidates) {
+		t.Fatalf("unexpected number of selected service nodes, expected %d got %d", len(candidates), len(all))
+	}
+}
+
+func TestSelectSessionServiceNodes_Simulation(t *testing.T) {
+	// the weights are stake x max(score, floor): 100x100, 100x50, 200x20 (floored) and 100x20 (no score yet)
+	candidates := testingSessionCandidates()
+	floor, numOfSessions := 20, 20000
+	expectedWeights := []float64{10000, 5000, 4000, 2000}
+	totalWeight := 0.0
+	for _, weight := range expectedWeights {
+		totalWeight += weight
+	}
+	counts := make(map[string]int)
+	for i := 0; i < numOfSessions; i++ {
+		sessionKey := utility.GetSessionKey([]byte("app"), defaultTestingChains[0], int64(i))
+		selected, err := utility.SelectSessionServiceNodes(sessionKey, candidates, floor, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[string(selected[0].Address)]++
+	}
+	for i, candidate := range candidates {
+		expected := expectedWeights[i] / totalWeight
+		actual := float64(counts[string(candidate.Address)]) / float64(numOfSessions)
+		if math.Abs(expected-actual) > 0.02 {
+			t.Fatalf("unexpected selection frequency of service node %d: expected %.3f got %.3f", i, expected, actual)
+		}
+	}
+}
+
+func testingSessionCandidates() []*genesis.ServiceNode {
+	return []*genesis.ServiceNode{
+		{Address: []byte("a"), StakedTokens: "100", QosScore: 100, QosSamples: 10},
+		{Address: []byte("b"), StakedTokens: "100", QosScore: 50, QosSamples: 10},
+		{Address: []byte("c"), StakedTokens: "200", QosScore: 0, QosSamples: 10},
+		{Address: []byte("d"), StakedTokens: "100"},
+	}
+}
@@ -0,0 +1,104 @@
+package utility_module
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/types"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+func TestUtilityContext_CheckTokenInvariantsGenesis(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	report := requireTokenInvariants(t, ctx)
+	// the genesis supply is everything in the accounts and pools
+	expected := big.NewInt(0)
+	for _, account := range GetAllTestingAccounts(t, ctx) {
+		amount, err := types.StringToBigInt(account.Amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected.Add(expected, amount)
+	}
+	for _, pool := range GetAllTestingPools(t, ctx) {
+		amount, err := types.StringToBigInt(pool.Account.Amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected.Add(expected, amount)
+	}
+	if report.TotalSupply.Cmp(expected) != 0 {
+		t.Fatalf("unexpected genesis total supply: expected %v got %v", expected, report.TotalSupply)
+	}
+}
+
+func TestUtilityContext_MintAndBurn(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	totalSupplyBefore, err := ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	minted := big.NewInt(1000)
+	if err := ctx.MintToAccount(GetAllTestingAccounts(t, ctx)[0].Address, minted); err != nil {
+		t.Fatal(err)
+	}
+	validator := GetAllTestingValidators(t, ctx)[0]
+	burned, err := ctx.BurnValidator(validator.Address, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	totalSupplyAfter, err := ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := new(big.Int).Add(totalSupplyBefore, minted)
+	expected.Sub(expected, burned)
+	if totalSupplyAfter.Cmp(expected) != 0 {
+		t.Fatalf("unexpected total supply: expected %v got %v", expected, totalSupplyAfter)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_CheckTokenInvariantsViolations(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	// tokens created without a mint
+	account := GetAllTestingAccounts(t, ctx)[0]
+	amount, err := ctx.GetAccountAmount(account.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetAccountAmount(account.Address, amount.Add(amount, big.NewInt(1))); err != nil {
+		t.Fatal(err)
+	}
+	// a stake that isn't backed by the stake pool
+	validator := GetAllTestingValidators(t, ctx)[0]
+	stake, err := types.StringToBigInt(validator.StakedTokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetValidatorStakedTokens(validator.Address, stake.Add(stake, big.NewInt(1))); err != nil {
+		t.Fatal(err)
+	}
+	report, err := ctx.CheckTokenInvariants()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Holds() || len(report.Violations) != 2 {
+		t.Fatalf("expected 2 violations, got report:\n%s", report)
+	}
+	if _, ok := report.Stakes[typesUtil.ValidatorStakePoolName]; !ok {
+		t.Fatalf("the report is missing the validator stakes:\n%s", report)
+	}
+}
+
+func requireTokenInvariants(t *testing.T, ctx utility.UtilityContext) *utility.TokenInvariantReport {
+	report, err := ctx.CheckTokenInvariants()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Holds() {
+		t.Fatalf("the token invariants don't hold:\n%s", report)
+	}
+	return report
+}
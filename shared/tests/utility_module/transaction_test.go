@@ -10,6 +10,7 @@ import (
 	"github.com/pokt-network/pocket/shared/types"
 	"github.com/pokt-network/pocket/utility"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestUtilityContext_AnteHandleMessage(t *testing.T) {
@@ -32,6 +33,64 @@ func TestUtilityContext_AnteHandleMessage(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_AnteHandleMessageFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	tx, startingBalance, _, signer := NewTestingTransaction(t, ctx)
+	minimumFee, err := ctx.GetMessageSendFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setTestingTransactionFee(t, tx, signer, new(big.Int).Sub(minimumFee, big.NewInt(1)))
+	if _, err := ctx.AnteHandleMessage(tx); err == nil || err.Code() != types.CodeInsufficientFeeError {
+		t.Fatalf("expected an insufficient fee error, got %v", err)
+	}
+	tip := big.NewInt(100)
+	fee := new(big.Int).Add(minimumFee, tip)
+	setTestingTransactionFee(t, tx, signer, fee)
+	if _, err := ctx.AnteHandleMessage(tx); err != nil {
+		t.Fatal(err)
+	}
+	amount, err := ctx.GetAccountAmount(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := new(big.Int).Sub(startingBalance, fee); amount.Cmp(expected) != 0 {
+		t.Fatalf("the stated fee was not charged; expected balance %v got %v", expected, amount)
+	}
+	// the tip goes to the proposer on top of its share of the minimum fee
+	proposerPercentage, err := ctx.GetProposerPercentageOfFees()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedFeePoolAmount := new(big.Int).Mul(minimumFee, big.NewInt(int64(proposerPercentage)))
+	expectedFeePoolAmount.Quo(expectedFeePoolAmount, big.NewInt(100)).Add(expectedFeePoolAmount, tip)
+	feePoolAmount, err := ctx.GetPoolAmount(typesUtil.FeePoolName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if feePoolAmount.Cmp(expectedFeePoolAmount) != 0 {
+		t.Fatalf("unexpected fee pool amount; expected %v got %v", expectedFeePoolAmount, feePoolAmount)
+	}
+}
+
+func TestUtilityContext_UpdateParamInvalidFeePercentages(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	if err := ctx.UpdateParam(typesUtil.ProposerPercentageOfFeesParamName, wrapperspb.Int32(60)); err != nil {
+		t.Fatal(err)
+	}
+	// 60 + 50 is more than the whole fee
+	if err := ctx.UpdateParam(typesUtil.FeeOwnerPercentageOfFeesParamName, wrapperspb.Int32(50)); err == nil || err.Code() != types.CodeInvalidFeePercentagesError {
+		t.Fatalf("expected an invalid fee percentages error, got %v", err)
+	}
+	feeOwnerPercentage, err := ctx.GetFeeOwnerPercentageOfFees()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if feeOwnerPercentage != 10 {
+		t.Fatalf("the rejected change was applied: %d", feeOwnerPercentage)
+	}
+}
+
 func TestUtilityContext_ApplyTransaction(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	tx, startingBalance, amount, signer := NewTestingTransaction(t, ctx)
@@ -51,6 +110,206 @@ func TestUtilityContext_ApplyTransaction(t *testing.T) {
 	if amount.Cmp(expectedAfterBalance) != 0 {
 		t.Fatalf("unexpected after balance; expected %v got %v", expectedAfterBalance, amount)
 	}
+	// the save point of the message is released once the message succeeds
+	if len(ctx.Context.SavePoints) != 0 {
+		t.Fatalf("unexpected number of save points; expected %d got %d", 0, len(ctx.Context.SavePoints))
+	}
+}
+
+func TestUtilityContext_ApplyTransactionBatch(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	signer, err := crypto.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.MintToAccount(signer.Address(), defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	recipient := GetAllTestingAccounts(t, ctx)[1].Address
+	recipientBefore, err := ctx.GetAccountAmount(recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendFee, err := ctx.GetMessageSendFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := NewTestingSendMessage(t, signer.Address(), recipient, defaultSendAmountString)
+	// the fee of the batch is the sum of the fees of its messages
+	tx := newTestingBatchTransaction(t, signer, 0, sendFee, &send, &send)
+	if err := ctx.ApplyTransaction(tx); err == nil || err.Code() != types.CodeInsufficientFeeError {
+		t.Fatalf("expected an insufficient fee error, got %v", err)
+	}
+	batchFee := new(big.Int).Mul(sendFee, big.NewInt(2))
+	tx = newTestingBatchTransaction(t, signer, 0, batchFee, &send, &send)
+	if err := ctx.ApplyTransaction(tx); err != nil {
+		t.Fatal(err)
+	}
+	sent := new(big.Int).Mul(defaultSendAmount, big.NewInt(2))
+	expectedBalance := new(big.Int).Sub(defaultAmount, sent)
+	expectedBalance.Sub(expectedBalance, batchFee)
+	balance, err := ctx.GetAccountAmount(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Cmp(expectedBalance) != 0 {
+		t.Fatalf("unexpected balance after the batch; expected %v got %v", expectedBalance, balance)
+	}
+	recipientAfter, err := ctx.GetAccountAmount(recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received := new(big.Int).Sub(recipientAfter, recipientBefore); received.Cmp(sent) != 0 {
+		t.Fatalf("unexpected amount received; expected %v got %v", sent, received)
+	}
+	// the second message can't be funded, so the first one is reverted as well
+	overdraft := NewTestingSendMessage(t, signer.Address(), recipient, types.BigIntToString(defaultAmount))
+	tx = newTestingBatchTransaction(t, signer, 1, batchFee, &send, &overdraft)
+	if err := ctx.ApplyTransaction(tx); err == nil || err.Code() != types.CodeInsufficientAmountError {
+		t.Fatalf("expected an insufficient amount error, got %v", err)
+	}
+	recipientAfterFailure, err := ctx.GetAccountAmount(recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recipientAfterFailure.Cmp(recipientAfter) != 0 {
+		t.Fatalf("the failed batch was partially applied; expected %v got %v", recipientAfter, recipientAfterFailure)
+	}
+	// the signer must be a valid signer of every message
+	other := NewTestingSendMessage(t, recipient, signer.Address(), defaultSendAmountString)
+	tx = newTestingBatchTransaction(t, signer, 2, batchFee, &send, &other)
+	if err := ctx.ApplyTransaction(tx); err == nil || err.Code() != types.CodeInvalidSignerError {
+		t.Fatalf("expected an invalid signer error, got %v", err)
+	}
+}
+
+func TestUtilityContext_ApplyTransactionBatchStakeAndEditStake(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	signer, err := crypto.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.MintToAccount(signer.Address(), new(big.Int).Mul(defaultAmount, big.NewInt(2))); err != nil {
+		t.Fatal(err)
+	}
+	stake := &typesUtil.MessageStakeServiceNode{
+		PublicKey:     signer.PublicKey().Bytes(),
+		Chains:        defaultTestingChains,
+		Amount:        defaultAmountString,
+		ServiceUrl:    defaultServiceUrl,
+		OutputAddress: signer.Address(),
+	}
+	editStake := &typesUtil.MessageEditStakeServiceNode{
+		Address:     signer.Address(),
+		Chains:      defaultTestingChainsEdited,
+		AmountToAdd: types.BigIntToString(big.NewInt(1)),
+		ServiceUrl:  defaultServiceUrl,
+	}
+	batchFee := big.NewInt(0)
+	for _, msg := range []typesUtil.Message{stake, editStake} {
+		fee, err := ctx.GetFee(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		batchFee.Add(batchFee, fee)
+	}
+	// the signer of the edit stake is only known once the stake before it is applied
+	tx := newTestingBatchTransaction(t, signer, 0, batchFee, stake, editStake)
+	if err := ctx.ApplyTransaction(tx); err != nil {
+		t.Fatal(err)
+	}
+	serviceNode, err := ctx.GetServiceNode(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedStake := types.BigIntToString(new(big.Int).Add(defaultAmount, big.NewInt(1)))
+	if serviceNode.StakedTokens != expectedStake || serviceNode.Chains[0] != defaultTestingChainsEdited[0] {
+		t.Fatalf("unexpected service node after the batch: staked %s for %v", serviceNode.StakedTokens, serviceNode.Chains)
+	}
+}
+
+func TestUtilityContext_ApplyTransactionMultisig(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	cdc := types.GetCodec()
+	signers := make([]crypto.PrivateKey, 3)
+	publicKeys := make([]crypto.PublicKey, 3)
+	for i := range signers {
+		signer, err := crypto.GeneratePrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		signers[i], publicKeys[i] = signer, signer.PublicKey()
+	}
+	multisig, er := crypto.NewMultisigPublicKey(2, publicKeys)
+	if er != nil {
+		t.Fatal(er)
+	}
+	// the multisig owns the param, so only its keys may change it
+	if err := ctx.UpdateParam(typesUtil.MissedBlocksBurnPercentageOwner, wrapperspb.Bytes(multisig.Address())); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.MintToAccount(multisig.Address(), defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	value, err := cdc.ToAny(wrapperspb.Int32(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := cdc.ToAny(&typesUtil.MessageChangeParameter{
+		Owner:          multisig.Address(),
+		ParameterKey:   typesUtil.MissedBlocksBurnPercentageParamName,
+		ParameterValue: value,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee, err := ctx.GetMessageChangeParameterFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &typesUtil.Transaction{
+		Msg:   msg,
+		Fee:   types.BigIntToString(fee),
+		Nonce: defaultNonceString,
+	}
+	tx.SetMultisig(multisig)
+	for _, signer := range signers[:2] {
+		if err := tx.SignMultisig(signer); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tx.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ApplyTransaction(tx); err != nil {
+		t.Fatal(err)
+	}
+	burnPercentage, err := ctx.GetMissedBlocksBurnPercentage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if burnPercentage != 2 {
+		t.Fatalf("the multisig didn't change the param; expected 2 got %d", burnPercentage)
+	}
+	// a single key of the multisig can't sign for it, nor for itself
+	tx.Sequence = 1
+	tx.SetMultisig(multisig)
+	if err := tx.SignMultisig(signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.ValidateBasic(); err == nil || err.Code() != types.CodeSignatureVerificationFailedError {
+		t.Fatalf("expected a signature verification error, got %v", err)
+	}
+	if err := ctx.MintToAccount(signers[0].Address(), defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	tx.Sequence = 0
+	if err := tx.Sign(signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ApplyTransaction(tx); err == nil || err.Code() != types.CodeInvalidSignerError {
+		t.Fatalf("expected an invalid signer error, got %v", err)
+	}
 }
 
 func TestUtilityContext_CheckTransaction(t *testing.T) {
@@ -75,6 +334,38 @@ func TestUtilityContext_CheckTransaction(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_CheckTransactionFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	minimumFee, err := ctx.GetMessageSendFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, _, _, signer := NewTestingTransaction(t, ctx)
+	setTestingTransactionFee(t, tx, signer, new(big.Int).Sub(minimumFee, big.NewInt(1)))
+	txBz, err := tx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.CheckTransaction(txBz); err == nil || err.(types.Error).Code() != types.CodeInsufficientFeeError {
+		t.Fatalf("expected an insufficient fee error, got %v", err)
+	}
+	// a signer that can't pay the fee is rejected before its transaction enters the mempool
+	unfunded, err := crypto.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	txBz, err = NewTestingSendTransaction(t, ctx, unfunded, 0).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.CheckTransaction(txBz); err == nil || err.(types.Error).Code() != types.CodeInsufficientAmountError {
+		t.Fatalf("expected an insufficient amount error, got %v", err)
+	}
+	if ctx.Mempool.Size() != 0 {
+		t.Fatalf("unexpected mempool size; expected %d got %d", 0, ctx.Mempool.Size())
+	}
+}
+
 func TestUtilityContext_GetSignerCandidates(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	accs := GetAllTestingAccounts(t, ctx)
@@ -116,6 +407,41 @@ func TestUtilityContext_GetTransactionsForProposal(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_GetTransactionsForProposalOrderedByFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	proposer := GetAllTestingValidators(t, ctx)[0]
+	minimumFee, err := ctx.GetMessageSendFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// transactions checked in order of increasing fee are proposed in order of decreasing fee
+	expected := make([][]byte, 3)
+	for i := 0; i < len(expected); i++ {
+		tx, _, _, signer := NewTestingTransaction(t, ctx)
+		setTestingTransactionFee(t, tx, signer, new(big.Int).Add(minimumFee, big.NewInt(int64(i))))
+		txBz, err := tx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ctx.CheckTransaction(txBz); err != nil {
+			t.Fatal(err)
+		}
+		expected[len(expected)-1-i] = txBz
+	}
+	txs, er := ctx.GetTransactionsForProposal(proposer.Address, 10000, nil)
+	if er != nil {
+		t.Fatal(er)
+	}
+	if len(txs) != len(expected) {
+		t.Fatalf("incorrect txs amount returned; expected %v got %v", len(expected), len(txs))
+	}
+	for i := range expected {
+		if !bytes.Equal(txs[i], expected[i]) {
+			t.Fatalf("unexpected transaction at position %d; expected tx: %s, got %s", i, hex.EncodeToString(expected[i]), hex.EncodeToString(txs[i]))
+		}
+	}
+}
+
 func TestUtilityContext_HandleMessage(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	accs := GetAllTestingAccounts(t, ctx)
@@ -150,37 +476,213 @@ func TestUtilityContext_HandleMessage(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_SimulateTransaction(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	tx, startingBalance, _, signer := NewTestingTransaction(t, ctx)
+	txBz, err := tx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	simulation, err := ctx.SimulateTransaction(txBz, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if simulation.Error != nil || simulationResultCode(t, simulation) != 0 {
+		t.Fatalf("unexpected simulation failure: %v", simulation.Error)
+	}
+	feeBig, err := ctx.GetMessageSendFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if simulation.Fee.Cmp(feeBig) != 0 {
+		t.Fatalf("unexpected fee; expected %v got %v", feeBig, simulation.Fee)
+	}
+	// the sender, the recipient and the fee pool are written
+	if len(simulation.StateChanges) < 3 {
+		t.Fatalf("unexpected number of state changes; expected at least %d got %d", 3, len(simulation.StateChanges))
+	}
+	amount, err := ctx.GetAccountAmount(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount.Cmp(startingBalance) != 0 {
+		t.Fatalf("the simulation changed the state; expected balance %v got %v", startingBalance, amount)
+	}
+	// an unsigned transaction can only be simulated when skipping the signature check
+	tx.Signature.Signature = nil
+	txBz, err = tx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.SimulateTransaction(txBz, false); err == nil || err.Code() != types.CodeEmptySignatureError {
+		t.Fatalf("expected an empty signature error, got %v", err)
+	}
+	if simulation, err = ctx.SimulateTransaction(txBz, true); err != nil || simulation.Error != nil {
+		t.Fatalf("unexpected simulation failure: %v %v", err, simulation)
+	}
+	// a transaction failing the ante handler is reported in the result without changing the state
+	if err := ctx.SetAccountAmount(signer.Address(), big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	if simulation, err = ctx.SimulateTransaction(txBz, true); err != nil {
+		t.Fatal(err)
+	}
+	if simulation.Error == nil || simulationResultCode(t, simulation) != uint32(types.CodeInsufficientAmountError) || len(simulation.StateChanges) != 0 {
+		t.Fatalf("expected an insufficient amount failure, got %v", simulation)
+	}
+	// while a transaction whose message fails still pays its fee and uses up its sequence
+	if err := ctx.SetAccountAmount(signer.Address(), feeBig); err != nil {
+		t.Fatal(err)
+	}
+	if simulation, err = ctx.SimulateTransaction(txBz, true); err != nil {
+		t.Fatal(err)
+	}
+	if simulation.Error == nil || simulationResultCode(t, simulation) != uint32(types.CodeInsufficientAmountError) {
+		t.Fatalf("expected an insufficient amount failure, got %v", simulation)
+	}
+	// the signer's balance and sequence, and the pools the fee is paid to
+	if len(simulation.StateChanges) < 3 {
+		t.Fatalf("unexpected number of state changes; expected at least %d got %d", 3, len(simulation.StateChanges))
+	}
+}
+
+func simulationResultCode(t *testing.T, simulation *types.SimulationResult) uint32 {
+	result, err := types.GetCodec().FromAny(simulation.Result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result.(*typesUtil.TransactionResult).Code
+}
+
+func TestUtilityContext_AnteHandleMessageSequence(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	tx, _, _, signer := NewTestingTransaction(t, ctx)
+	if _, err := ctx.AnteHandleMessage(tx); err != nil {
+		t.Fatal(err)
+	}
+	sequence, err := ctx.GetAccountSequence(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sequence != 1 {
+		t.Fatalf("unexpected sequence; expected %d got %d", 1, sequence)
+	}
+	if _, err := ctx.AnteHandleMessage(tx); err == nil || err.Code() != types.CodeSequenceTooLowError {
+		t.Fatalf("expected a sequence too low error on replay, got %v", err)
+	}
+	futureTx := NewTestingSendTransaction(t, ctx, signer, 2)
+	if _, err := ctx.AnteHandleMessage(futureTx); err == nil || err.Code() != types.CodeSequenceTooHighError {
+		t.Fatalf("expected a sequence too high error, got %v", err)
+	}
+}
+
+func TestUtilityContext_GetTransactionsForProposalOutOfSequence(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	first, _, _, signer := NewTestingTransaction(t, ctx)
+	second := NewTestingSendTransaction(t, ctx, signer, 1)
+	future := NewTestingSendTransaction(t, ctx, signer, 5)
+	transactions := make([][]byte, 0)
+	// the mempool receives the transactions out of order
+	for _, tx := range []*typesUtil.Transaction{second, future, first} {
+		txBz, err := tx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ctx.CheckTransaction(txBz); err != nil {
+			t.Fatal(err)
+		}
+		transactions = append(transactions, txBz)
+	}
+	proposer := GetAllTestingValidators(t, ctx)[0]
+	txs, er := ctx.GetTransactionsForProposal(proposer.Address, 10000, nil)
+	if er != nil {
+		t.Fatal(er)
+	}
+	if len(txs) != 2 {
+		t.Fatalf("incorrect txs amount returned; expected %v got %v", 2, len(txs))
+	}
+	if !bytes.Equal(txs[0], transactions[2]) || !bytes.Equal(txs[1], transactions[0]) {
+		t.Fatal("the transactions of the signer were not proposed in sequence")
+	}
+	futureHash := typesUtil.TransactionHash(transactions[1])
+	if !ctx.Mempool.Contains(futureHash) {
+		t.Fatal("the transaction ahead of the sequence was not held in the mempool")
+	}
+	// a transaction with an already used sequence is rejected by the mempool
+	if err := ctx.ApplyTransaction(first); err != nil {
+		t.Fatal(err)
+	}
+	replay := NewTestingSendTransaction(t, ctx, signer, 0)
+	replay.Nonce = "replay"
+	replayBz, err := replay.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.CheckTransaction(replayBz); err == nil || err.(types.Error).Code() != types.CodeSequenceTooLowError {
+		t.Fatalf("expected a sequence too low error, got %v", err)
+	}
+}
+
 func NewTestingTransaction(t *testing.T, ctx utility.UtilityContext) (transaction *typesUtil.Transaction, startingAmount, amountSent *big.Int, signer crypto.PrivateKey) {
 	var err error
-	cdc := types.GetCodec()
-	recipient := GetAllTestingAccounts(t, ctx)[1]
 	signer, err = crypto.GeneratePrivateKey()
 	if err != nil {
 		t.Fatal(err)
 	}
 	startingAmount = defaultAmount
-	signerAddr := signer.Address()
-	if err = ctx.SetAccountAmount(signerAddr, defaultAmount); err != nil {
+	if err = ctx.MintToAccount(signer.Address(), defaultAmount); err != nil {
 		t.Fatal(err)
 	}
 	amountSent = defaultSendAmount
-	msg := NewTestingSendMessage(t, signerAddr, recipient.Address, defaultSendAmountString)
-	any, err := cdc.ToAny(&msg)
-	if err != nil {
+	transaction = NewTestingSendTransaction(t, ctx, signer, 0)
+	return
+}
+
+func setTestingTransactionFee(t *testing.T, tx *typesUtil.Transaction, signer crypto.PrivateKey, fee *big.Int) {
+	tx.Fee = types.BigIntToString(fee)
+	if err := tx.Sign(signer); err != nil {
 		t.Fatal(err)
 	}
-	feeBig, err := ctx.GetMessageSendFee()
+}
+
+func newTestingBatchTransaction(t *testing.T, signer crypto.PrivateKey, sequence uint64, fee *big.Int, msgs ...typesUtil.Message) *typesUtil.Transaction {
+	cdc := types.GetCodec()
+	batch := &typesUtil.MessageBatch{}
+	for _, msg := range msgs {
+		any, err := cdc.ToAny(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch.Msgs = append(batch.Msgs, any)
+	}
+	return newTestingMessageTransaction(t, signer, sequence, fee, batch)
+}
+
+// newTestingMessageTransaction returns a transaction of `msg` signed by the signer with the given sequence and fee
+func newTestingMessageTransaction(t *testing.T, signer crypto.PrivateKey, sequence uint64, fee *big.Int, msg typesUtil.Message) *typesUtil.Transaction {
+	any, err := types.GetCodec().ToAny(msg)
 	if err != nil {
 		t.Fatal(err)
 	}
-	fee := types.BigIntToString(feeBig)
-	transaction = &typesUtil.Transaction{
-		Msg:   any,
-		Fee:   fee,
-		Nonce: defaultNonceString,
+	transaction := &typesUtil.Transaction{
+		Msg:      any,
+		Fee:      types.BigIntToString(fee),
+		Nonce:    defaultNonceString,
+		Sequence: sequence,
 	}
 	if err = transaction.Sign(signer); err != nil {
 		t.Fatal(err)
 	}
-	return
+	return transaction
+}
+
+// NewTestingSendTransaction returns a transaction sending `defaultSendAmount` from the signer with the given sequence
+func NewTestingSendTransaction(t *testing.T, ctx utility.UtilityContext, signer crypto.PrivateKey, sequence uint64) *typesUtil.Transaction {
+	recipient := GetAllTestingAccounts(t, ctx)[1]
+	msg := NewTestingSendMessage(t, signer.Address(), recipient.Address, defaultSendAmountString)
+	fee, err := ctx.GetMessageSendFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newTestingMessageTransaction(t, signer, sequence, fee, &msg)
 }
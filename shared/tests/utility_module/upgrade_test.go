@@ -0,0 +1,69 @@
+package utility_module
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/types"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+func TestUtilityContext_HandleMessageUpgrade(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	msg := &typesUtil.MessageUpgrade{
+		Version: "99.0.0",
+		Height:  1,
+	}
+	if err := ctx.HandleMessageUpgrade(msg); err == nil || err.Code() != types.CodeInvalidActivationHeightError {
+		t.Fatalf("expected an invalid activation height error, got %v", err)
+	}
+	msg.Height = 3
+	if err := ctx.HandleMessageUpgrade(msg); err != nil {
+		t.Fatal(err)
+	}
+	// the node keeps applying blocks until the upgrade height
+	ctx.LatestHeight = 2
+	if err := ctx.CheckUpgrade(); err != nil {
+		t.Fatal(err)
+	}
+	ctx.LatestHeight = 3
+	if err := ctx.CheckUpgrade(); err == nil || err.Code() != types.CodeUpgradeRequiredError {
+		t.Fatalf("expected an upgrade required error, got %v", err)
+	}
+	// a node running the upgraded version carries on
+	msg.Version = utility.ProtocolVersion
+	msg.Height = 4
+	if err := ctx.HandleMessageUpgrade(msg); err != nil {
+		t.Fatal(err)
+	}
+	ctx.LatestHeight = 4
+	if err := ctx.CheckUpgrade(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUtilityContext_GetMessageUpgradeSignerCandidates(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	candidates, err := ctx.GetMessageUpgradeSignerCandidates(&typesUtil.MessageUpgrade{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := DefaultTestingParams(t).UpgradeOwner
+	if len(candidates) != 1 || !bytes.Equal(candidates[0], owner) {
+		t.Fatalf("unexpected signer candidates; expected %v got %v", owner, candidates)
+	}
+}
+
+func TestUtilityContext_ApplyBlockUpgradeRequired(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	if err := ctx.HandleMessageUpgrade(&typesUtil.MessageUpgrade{Version: "99.0.0", Height: 3}); err != nil {
+		t.Fatal(err)
+	}
+	proposer := GetAllTestingValidators(t, ctx)[0]
+	// the block at the upgrade height is refused with an error consensus halts the node on, rather than exiting
+	_, err := ctx.ApplyBlock(3, proposer.Address, nil, nil)
+	if utilityErr, ok := err.(types.Error); !ok || utilityErr.Code() != types.CodeUpgradeRequiredError {
+		t.Fatalf("expected an upgrade required error, got %v", err)
+	}
+}
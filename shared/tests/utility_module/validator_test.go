@@ -2,9 +2,11 @@ package utility_module
 
 import (
 	"bytes"
+	"encoding/hex"
 	"math/big"
 	"testing"
 
+	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/persistence/pre_persistence"
 
 	"github.com/pokt-network/pocket/shared/crypto"
@@ -12,6 +14,7 @@ import (
 	"github.com/pokt-network/pocket/shared/types/genesis"
 	"github.com/pokt-network/pocket/utility"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestUtilityContext_HandleMessageStakeValidator(t *testing.T) {
@@ -130,7 +133,7 @@ func TestUtilityContext_HandleMessagePauseValidator(t *testing.T) {
 
 func TestUtilityContext_HandleMessageUnpauseValidator(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
-	if err := ctx.Context.SetValidatorMinimumPauseBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.ValidatorMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingValidators(t, ctx)[0]
@@ -160,7 +163,7 @@ func TestUtilityContext_HandleMessageUnpauseValidator(t *testing.T) {
 
 func TestUtilityContext_HandleMessageUnstakeValidator(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
-	if err := ctx.Context.SetValidatorMinimumPauseBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.ValidatorMinimumPauseBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingValidators(t, ctx)[0]
@@ -177,10 +180,75 @@ func TestUtilityContext_HandleMessageUnstakeValidator(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_HandleMessagePartialUnstakeValidator(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	if err := ctx.UpdateParam(typesUtil.ValidatorUnstakingBlocksParamName, wrapperspb.Int32(0)); err != nil {
+		t.Fatal(err)
+	}
+	actor := GetAllTestingValidators(t, ctx)[0]
+	stakedTokens, err := types.StringToBigInt(actor.StakedTokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minStake, err := ctx.GetValidatorMinimumStake()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the remaining stake may not drop below the minimum
+	amount := new(big.Int).Sub(stakedTokens, minStake)
+	msg := &typesUtil.MessagePartialUnstakeValidator{
+		Address: actor.Address,
+		Amount:  types.BigIntToString(new(big.Int).Add(amount, big.NewInt(1))),
+		Signer:  actor.Address,
+	}
+	if err := ctx.HandleMessagePartialUnstakeValidator(msg); err == nil || err.Code() != types.CodeMinimumStakeError {
+		t.Fatalf("expected a minimum stake error, got %v", err)
+	}
+	msg.Amount = types.BigIntToString(amount)
+	if err := ctx.HandleMessagePartialUnstakeValidator(msg); err != nil {
+		t.Fatal(err)
+	}
+	actor = GetAllTestingValidators(t, ctx)[0]
+	if actor.Status != typesUtil.StakedStatus || actor.StakedTokens != types.BigIntToString(minStake) {
+		t.Fatalf("unexpected actor after the partial unstake: status %d, staked tokens %s", actor.Status, actor.StakedTokens)
+	}
+	unbondingStakes, err := ctx.GetActorUnbondingStakes(actor.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unbondingStakes) != 1 || unbondingStakes[0].Amount != msg.Amount {
+		t.Fatalf("unexpected unbonding stakes: %v", unbondingStakes)
+	}
+	requireTokenInvariants(t, ctx)
+	// the unstaking period is over right away, so the unbonding stake is released to the output address
+	outputBefore, err := ctx.GetAccountAmount(actor.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ReleaseUnbondingStakes(); err != nil {
+		t.Fatal(err)
+	}
+	outputAfter, err := ctx.GetAccountAmount(actor.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released := outputAfter.Sub(outputAfter, outputBefore); released.Cmp(amount) != 0 {
+		t.Fatalf("unexpected amount released: expected %v got %v", amount, released)
+	}
+	unbondingStakes, err = ctx.GetActorUnbondingStakes(actor.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unbondingStakes) != 0 {
+		t.Fatalf("the unbonding stakes weren't released: %v", unbondingStakes)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
 func TestUtilityContext_BeginUnstakingMaxPausedValidators(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	actor := GetAllTestingValidators(t, ctx)[0]
-	err := ctx.Context.SetValidatorMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.ValidatorMaxPausedBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -412,7 +480,7 @@ func TestUtilityContext_UnstakeValidatorsPausedBefore(t *testing.T) {
 	if actor.Status != typesUtil.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetValidatorMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.ValidatorMaxPausedBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -438,14 +506,14 @@ func TestUtilityContext_UnstakeValidatorsPausedBefore(t *testing.T) {
 func TestUtilityContext_UnstakeValidatorsThatAreReady(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 1)
 	ctx.SetPoolAmount(typesUtil.ValidatorStakePoolName, big.NewInt(100000000000000000))
-	if err := ctx.Context.SetValidatorUnstakingBlocks(0); err != nil {
+	if err := ctx.UpdateParam(typesUtil.ValidatorUnstakingBlocksParamName, wrapperspb.Int32(0)); err != nil {
 		t.Fatal(err)
 	}
 	actor := GetAllTestingValidators(t, ctx)[0]
 	if actor.Status != typesUtil.StakedStatus {
 		t.Fatal("wrong starting status")
 	}
-	err := ctx.Context.SetValidatorMaxPausedBlocks(0)
+	err := ctx.UpdateParam(typesUtil.ValidatorMaxPausedBlocksParamName, wrapperspb.Int32(0))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -499,7 +567,7 @@ func TestUtilityContext_BurnValidator(t *testing.T) {
 	tokensTrunc, _ := tokensFloat.Int(nil)
 	afterTokensBig := big.NewInt(0).Sub(tokens, tokensTrunc)
 	afterTokens := types.BigIntToString(afterTokensBig)
-	if err := ctx.BurnValidator(actor.Address, 10); err != nil {
+	if _, err := ctx.BurnValidator(actor.Address, 10); err != nil {
 		t.Fatal(err)
 	}
 	actor = GetAllTestingValidators(t, ctx)[0]
@@ -508,6 +576,34 @@ func TestUtilityContext_BurnValidator(t *testing.T) {
 	}
 }
 
+func TestUtilityContext_BurnValidatorBelowMinimumStake(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	actor := GetAllTestingValidators(t, ctx)[0]
+	tokens, err := types.StringToBigInt(actor.StakedTokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minStake := types.PercentageOf(tokens, 50)
+	if err := ctx.UpdateParam(typesUtil.ValidatorMinimumStakeParamName, wrapperspb.String(types.BigIntToString(minStake))); err != nil {
+		t.Fatal(err)
+	}
+	// the validator keeps its stake above the minimum, however small the minimum is compared to the amount burned
+	if _, err := ctx.BurnValidator(actor.Address, 10); err != nil {
+		t.Fatal(err)
+	}
+	actor = GetAllTestingValidators(t, ctx)[0]
+	if actor.Status != typesUtil.StakedStatus {
+		t.Fatal("the validator started unstaking with a stake above the minimum")
+	}
+	if _, err := ctx.BurnValidator(actor.Address, 50); err != nil {
+		t.Fatal(err)
+	}
+	actor = GetAllTestingValidators(t, ctx)[0]
+	if actor.Status != typesUtil.UnstakingStatus {
+		t.Fatal("the validator didn't start unstaking with a stake below the minimum")
+	}
+}
+
 func TestUtilityContext_GetMessageDoubleSignSignerCandidates(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	actor := GetAllTestingValidators(t, ctx)[0]
@@ -526,48 +622,128 @@ func TestUtilityContext_GetMessageDoubleSignSignerCandidates(t *testing.T) {
 func TestUtilityContext_HandleMessageDoubleSign(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	ctx.SetPoolAmount(typesUtil.ValidatorStakePoolName, big.NewInt(100000000000000))
-	actors := GetAllTestingValidators(t, ctx)
-	reporter := actors[0]
-	byzVal := actors[1]
-	voteA := typesUtil.Vote{
-		PublicKey: byzVal.PublicKey,
-		Height:    0,
-		Round:     0,
-		Type:      0,
-		BlockHash: crypto.SHA3Hash([]byte("voteA")),
-	}
-	voteB := voteA
-	voteB.BlockHash = crypto.SHA3Hash([]byte("voteB"))
-	msg := &typesUtil.MessageDoubleSign{
-		VoteA:           &voteA,
-		VoteB:           &voteB,
-		ReporterAddress: reporter.Address,
+	reporter := GetAllTestingValidators(t, ctx)[0]
+	byzValPrivateKey, _ := crypto.GeneratePrivateKey()
+	byzValAddress := byzValPrivateKey.Address()
+	if err := ctx.InsertValidator(byzValAddress, byzValPrivateKey.PublicKey().Bytes(), byzValAddress, defaultServiceUrl, defaultAmountString); err != nil {
+		t.Fatal(err)
 	}
-	if err := ctx.HandleMessageDoubleSign(msg); err != nil {
+	msg := NewTestingDoubleSignMessage(t, byzValPrivateKey, reporter.Address)
+	reporterBalanceBefore, err := ctx.GetAccountAmount(reporter.Address)
+	if err != nil {
 		t.Fatal(err)
 	}
-	stakedTokensAfterBig, err := ctx.GetValidatorStakedTokens(byzVal.Address)
+	totalSupply, err := ctx.GetTotalSupply()
 	if err != nil {
 		t.Fatal(err)
 	}
-	stakedTokensAfter := types.BigIntToString(stakedTokensAfterBig)
-	burnPercentage, err := ctx.GetDoubleSignBurnPercentage()
+	if err := ctx.HandleMessageDoubleSign(msg); err != nil {
+		t.Fatal(err)
+	}
+	stakedTokensAfterBig, err := ctx.GetValidatorStakedTokens(byzValAddress)
 	if err != nil {
 		t.Fatal(err)
 	}
-	stakedTokensBeforeBig, err := types.StringToBigInt(byzVal.StakedTokens)
+	stakedTokensAfter := types.BigIntToString(stakedTokensAfterBig)
+	burnPercentage, err := ctx.GetDoubleSignBurnPercentage()
 	if err != nil {
 		t.Fatal(err)
 	}
-	stakedTokensBeforeFloat := big.NewFloat(0).SetInt(stakedTokensBeforeBig)
+	stakedTokensBeforeFloat := big.NewFloat(0).SetInt(defaultAmount)
 	stakedTokensBeforeFloat.Mul(stakedTokensBeforeFloat, big.NewFloat(float64(burnPercentage)))
 	stakedTokensBeforeFloat.Quo(stakedTokensBeforeFloat, big.NewFloat(100))
 	trunactedDiffTokens, _ := stakedTokensBeforeFloat.Int(nil)
-	stakedTokensExpectedAfterBig := big.NewInt(0).Sub(stakedTokensBeforeBig, trunactedDiffTokens)
+	stakedTokensExpectedAfterBig := big.NewInt(0).Sub(defaultAmount, trunactedDiffTokens)
 	stakedTokensExpectedAfter := types.BigIntToString(stakedTokensExpectedAfterBig)
 	if stakedTokensAfter != stakedTokensExpectedAfter {
 		t.Fatalf("unexpected token amount after double sign handling: expected %v got %v", stakedTokensExpectedAfter, stakedTokensAfter)
 	}
+	rewardPercentage, err := ctx.GetDoubleSignReporterRewardPercentage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedReward := big.NewInt(0).Mul(trunactedDiffTokens, big.NewInt(int64(rewardPercentage)))
+	expectedReward.Quo(expectedReward, big.NewInt(100))
+	reporterBalanceAfter, err := ctx.GetAccountAmount(reporter.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reward := big.NewInt(0).Sub(reporterBalanceAfter, reporterBalanceBefore); reward.Cmp(expectedReward) != 0 {
+		t.Fatalf("unexpected reporter reward: expected %v got %v", expectedReward, reward)
+	}
+	// the reward is paid out of the burned stake rather than minted
+	totalSupplyAfter, err := ctx.GetTotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := big.NewInt(0).Sub(totalSupply, big.NewInt(0).Sub(trunactedDiffTokens, expectedReward)); totalSupplyAfter.Cmp(expected) != 0 {
+		t.Fatalf("unexpected total supply after the double sign: expected %v got %v", expected, totalSupplyAfter)
+	}
+	// the same double sign can't be reported twice
+	if err := ctx.HandleMessageDoubleSign(msg); err == nil || err.Code() != types.CodeDuplicateEvidenceError {
+		t.Fatalf("expected a duplicate evidence error, got %v", err)
+	}
+}
+
+func TestUtilityContext_HandleMessageDoubleSignInvalidEvidence(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	reporter := GetAllTestingValidators(t, ctx)[0]
+	// a key that was never staked can't be slashed
+	notValidatorPrivateKey, _ := crypto.GeneratePrivateKey()
+	msg := NewTestingDoubleSignMessage(t, notValidatorPrivateKey, reporter.Address)
+	if err := ctx.HandleMessageDoubleSign(msg); err == nil || err.Code() != types.CodeNotValidatorAtHeightError {
+		t.Fatalf("expected a not validator at height error, got %v", err)
+	}
+	// a vote signed by someone else than the validator is rejected
+	byzVal := GetAllTestingValidators(t, ctx)[1]
+	msg.VoteA.PublicKey = byzVal.PublicKey
+	msg.VoteB.PublicKey = byzVal.PublicKey
+	if err := ctx.HandleMessageDoubleSign(msg); err == nil || err.Code() != types.CodeSignatureVerificationFailedError {
+		t.Fatalf("expected a signature verification failed error, got %v", err)
+	}
+	// the votes of another chain are no evidence on this one
+	byzValPrivateKey, _ := crypto.GeneratePrivateKey()
+	if err := ctx.InsertValidator(byzValPrivateKey.Address(), byzValPrivateKey.PublicKey().Bytes(), byzValPrivateKey.Address(), defaultServiceUrl, defaultAmountString); err != nil {
+		t.Fatal(err)
+	}
+	msg = &typesUtil.MessageDoubleSign{
+		VoteA:           newTestingVote(t, byzValPrivateKey, "voteA", "otherchain"),
+		VoteB:           newTestingVote(t, byzValPrivateKey, "voteB", "otherchain"),
+		ReporterAddress: reporter.Address,
+	}
+	if err := ctx.HandleMessageDoubleSign(msg); err == nil || err.Code() != types.CodeWrongChainIdError {
+		t.Fatalf("expected a wrong chain id error, got %v", err)
+	}
+}
+
+func NewTestingDoubleSignMessage(t *testing.T, signer crypto.PrivateKey, reporter []byte) *typesUtil.MessageDoubleSign {
+	voteA := newTestingVote(t, signer, "voteA", genesis.DefaultChainId)
+	voteB := newTestingVote(t, signer, "voteB", genesis.DefaultChainId)
+	return &typesUtil.MessageDoubleSign{
+		VoteA:           voteA,
+		VoteB:           voteB,
+		ReporterAddress: reporter,
+	}
+}
+
+// newTestingVote returns a prepare vote of `signer` at height 0 for the block named `name` of the chain `chainId`
+func newTestingVote(t *testing.T, signer crypto.PrivateKey, name, chainId string) *typesUtil.Vote {
+	vote := &typesUtil.Vote{
+		Height: 0,
+		Round:  0,
+		Type:   uint32(typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE),
+		Block: &types.Block{
+			BlockHeader: &types.BlockHeader{
+				Height:    0,
+				Hash:      hex.EncodeToString(crypto.SHA3Hash([]byte(name))),
+				NetworkId: chainId,
+			},
+		},
+	}
+	if err := vote.Sign(signer); err != nil {
+		t.Fatal(err)
+	}
+	return vote
 }
 
 func TestUtilityContext_GetValidatorMissedBlocks(t *testing.T) {
@@ -674,19 +850,8 @@ func TestUtilityContext_HandleProposalRewards(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	proposerCutPercentage, err := ctx.GetProposerPercentageOfFees()
-	if err != nil {
-		t.Fatal(err)
-	}
-	daoCutPercentage := 100 - proposerCutPercentage
-	if daoCutPercentage < 0 {
-		t.Fatal("dao cut percentage negative")
-	}
-	feesAndRewardsCollectedFloat := new(big.Float).SetInt(feeAndRewardsCollected)
-	feesAndRewardsCollectedFloat.Mul(feesAndRewardsCollectedFloat, big.NewFloat(float64(proposerCutPercentage)))
-	feesAndRewardsCollectedFloat.Quo(feesAndRewardsCollectedFloat, big.NewFloat(100))
-	amountToProposer, _ := feesAndRewardsCollectedFloat.Int(nil)
-	expectedResultBig := actorTokensBeforeBig.Add(actorTokensBeforeBig, amountToProposer)
+	// the fee pool only holds the proposer's share of the fees, so all of it goes to the proposer
+	expectedResultBig := actorTokensBeforeBig.Add(actorTokensBeforeBig, feeAndRewardsCollected)
 	expectedResult := types.BigIntToString(expectedResultBig)
 	if err := ctx.HandleProposalRewards(actor.Address); err != nil {
 		t.Fatal(err)
@@ -0,0 +1,185 @@
+package utility_module
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUtilityContext_HandleMessageCreateVestingAccount(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	from := GetAllTestingAccounts(t, ctx)[0].Address
+	to, _ := crypto.GenerateAddress()
+	amount := big.NewInt(1000)
+	createTestingVestingAccount(t, ctx, from, to, amount)
+	balance, err := ctx.GetAccountBalance(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Amount.Cmp(amount) != 0 || balance.Unvested.Cmp(amount) != 0 || balance.Spendable.Sign() != 0 {
+		t.Fatalf("unexpected balance before the cliff: %+v", balance)
+	}
+	// the unvested tokens can't be sent
+	other, _ := crypto.GenerateAddress()
+	msg := &typesUtil.MessageSend{
+		FromAddress: to,
+		ToAddress:   other,
+		Amount:      "1",
+	}
+	if err := ctx.HandleMessageSend(msg); err == nil || err.Code() != types.CodeInsufficientAmountError {
+		t.Fatalf("expected an insufficient amount error, got %v", err)
+	}
+	// a second schedule can't be created for the same account
+	vestingMsg := &typesUtil.MessageCreateVestingAccount{
+		FromAddress:   from,
+		ToAddress:     to,
+		Amount:        "1",
+		CliffBlocks:   0,
+		VestingBlocks: 1,
+	}
+	if err := ctx.HandleMessageCreateVestingAccount(vestingMsg); err == nil || err.Code() != types.CodeVestingExistsError {
+		t.Fatalf("expected a vesting exists error, got %v", err)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_ApplyTransactionCreateVestingAccountNegativeAmount(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	signer, err := crypto.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.MintToAccount(signer.Address(), defaultAmount); err != nil {
+		t.Fatal(err)
+	}
+	victim := GetAllTestingAccounts(t, ctx)[0].Address
+	victimBefore, err := ctx.GetAccountAmount(victim)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee, err := ctx.GetMessageCreateVestingAccountFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &typesUtil.MessageCreateVestingAccount{
+		FromAddress:   signer.Address(),
+		ToAddress:     victim,
+		Amount:        "-1000",
+		CliffBlocks:   0,
+		VestingBlocks: 1,
+	}
+	tx := newTestingMessageTransaction(t, signer, 0, fee, msg)
+	if err := ctx.ApplyTransaction(tx); err == nil || err.Code() != types.CodeInvalidAmountError {
+		t.Fatalf("expected an invalid amount error, got %v", err)
+	}
+	// the handler rejects it as well
+	if err := ctx.HandleMessageCreateVestingAccount(msg); err == nil || err.Code() != types.CodeInvalidAmountError {
+		t.Fatalf("expected an invalid amount error, got %v", err)
+	}
+	victimAfter, err := ctx.GetAccountAmount(victim)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if victimAfter.Cmp(victimBefore) != 0 {
+		t.Fatalf("the recipient was debited; expected %v got %v", victimBefore, victimAfter)
+	}
+	vesting, err := ctx.GetAccountVesting(victim)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vesting != nil {
+		t.Fatalf("unexpected vesting schedule %v", vesting)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_GetAccountBalanceVesting(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	from := GetAllTestingAccounts(t, ctx)[0].Address
+	to, _ := crypto.GenerateAddress()
+	amount := big.NewInt(1000)
+	createTestingVestingAccount(t, ctx, from, to, amount)
+	tests := []struct {
+		height   int64
+		unvested int64
+	}{
+		{10, 1000}, // before the cliff
+		{11, 900},  // at the cliff, the tokens vested since the start are released
+		{51, 500},
+		{101, 0}, // at the end of the schedule
+		{200, 0},
+	}
+	for _, test := range tests {
+		ctx.LatestHeight = test.height
+		balance, err := ctx.GetAccountBalance(to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.Unvested.Int64() != test.unvested {
+			t.Fatalf("unexpected unvested amount at height %d: expected %d got %v", test.height, test.unvested, balance.Unvested)
+		}
+		if vested := 1000 - test.unvested; balance.Vested.Int64() != vested || balance.Spendable.Int64() != vested {
+			t.Fatalf("unexpected balance at height %d: %+v", test.height, balance)
+		}
+	}
+}
+
+func TestUtilityContext_HandleMessageStakeAppLockedTokens(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	from := GetAllTestingAccounts(t, ctx)[0].Address
+	to, _ := crypto.GenerateAddress()
+	createTestingVestingAccount(t, ctx, from, to, defaultAmount)
+	pubKey, _ := crypto.GeneratePublicKey()
+	other, _ := crypto.GenerateAddress()
+	msg := &typesUtil.MessageStakeApp{
+		PublicKey:     pubKey.Bytes(),
+		Chains:        defaultTestingChains,
+		Amount:        defaultAmountString,
+		OutputAddress: other,
+		Signer:        to,
+	}
+	// staking unvested tokens to another output would unlock them once unstaked
+	if err := ctx.HandleMessageStakeApp(msg); err == nil || err.Code() != types.CodeLockedStakeOutputError {
+		t.Fatalf("expected a locked stake output error, got %v", err)
+	}
+	msg.OutputAddress = to
+	if err := ctx.HandleMessageStakeApp(msg); err != nil {
+		t.Fatal(err)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func TestUtilityContext_HandleMessageSubmitProposalLockedTokens(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	from := GetAllTestingAccounts(t, ctx)[0].Address
+	to, _ := crypto.GenerateAddress()
+	minDeposit, err := ctx.GetGovMinimumDeposit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	createTestingVestingAccount(t, ctx, from, to, minDeposit)
+	// unvested tokens can't be escrowed as a proposal deposit
+	msg := newTestingSubmitProposalMessage(t, ctx, to, typesUtil.MissedBlocksBurnPercentageParamName, wrapperspb.Int32(2))
+	if err := ctx.HandleMessageSubmitProposal(msg); err == nil || err.Code() != types.CodeInsufficientAmountError {
+		t.Fatalf("expected an insufficient amount error, got %v", err)
+	}
+	requireTokenInvariants(t, ctx)
+}
+
+func createTestingVestingAccount(t *testing.T, ctx utility.UtilityContext, from, to []byte, amount *big.Int) {
+	msg := &typesUtil.MessageCreateVestingAccount{
+		FromAddress:   from,
+		ToAddress:     to,
+		Amount:        types.BigIntToString(amount),
+		CliffBlocks:   10,
+		VestingBlocks: 100,
+	}
+	if err := ctx.HandleMessageCreateVestingAccount(msg); err != nil {
+		t.Fatal(err)
+	}
+}
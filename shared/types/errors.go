@@ -109,6 +109,90 @@ const (
 	CodePayloadTooBigError         Code = 123
 	CodeSocketIOStartFailedError   Code = 124
 
+	CodeInvalidPaginationError        Code = 125
+	CodeStoreTransactionError         Code = 126
+	CodeGetTransactionError           Code = 127
+	CodeParamOutOfBoundsError         Code = 128
+	CodeProposalNotFoundError         Code = 129
+	CodeGetProposalError              Code = 130
+	CodeSetProposalError              Code = 131
+	CodeInsufficientDepositError      Code = 132
+	CodeVotingPeriodClosedError       Code = 133
+	CodeEmptyProposalContentError     Code = 134
+	CodeEmptyProposalTitleError       Code = 135
+	CodeUnknownVoteOptionError        Code = 136
+	CodeGetStateChangesError          Code = 137
+	CodeNotValidatorAtHeightError     Code = 138
+	CodeDuplicateEvidenceError        Code = 139
+	CodeGetEvidenceError              Code = 140
+	CodeSetEvidenceError              Code = 141
+	CodeSequenceTooLowError           Code = 142
+	CodeSequenceTooHighError          Code = 143
+	CodeGetSequenceError              Code = 144
+	CodeSetSequenceError              Code = 145
+	CodeInsufficientFeeError          Code = 146
+	CodeInvalidFeePercentagesError    Code = 147
+	CodeGetTotalSupplyError           Code = 148
+	CodeSetTotalSupplyError           Code = 149
+	CodeInvalidRewardSplitError       Code = 150
+	CodeGetStakedTokensError          Code = 151
+	CodeSetStakedTokensError          Code = 152
+	CodeGetUnbondingStakeError        Code = 153
+	CodeSetUnbondingStakeError        Code = 154
+	CodeDeleteUnbondingStakeError     Code = 155
+	CodeInvalidCommissionError        Code = 156
+	CodeGetDelegationError            Code = 157
+	CodeSetDelegationError            Code = 158
+	CodeInsufficientSharesError       Code = 159
+	CodeDelegationsSlashedError       Code = 160
+	CodeSetCommissionError            Code = 161
+	CodeSelfRedelegationError         Code = 162
+	CodeEmptyBatchError               Code = 163
+	CodeNestedBatchError              Code = 164
+	CodeNewMultisigPublicKeyError     Code = 165
+	CodeInvalidVestingError           Code = 166
+	CodeVestingExistsError            Code = 167
+	CodeLockedStakeOutputError        Code = 168
+	CodeGetVestingError               Code = 169
+	CodeSetVestingError               Code = 170
+	CodeUnknownRelayChainError        Code = 171
+	CodeDisabledRelayChainError       Code = 172
+	CodeDuplicateRelayChainError      Code = 173
+	CodeStoreBlockEventsError         Code = 174
+	CodeGetBlockEventsError           Code = 175
+	CodeInvalidActivationHeightError  Code = 176
+	CodeEmptyUpgradeVersionError      Code = 177
+	CodeSetPendingParamChangeError    Code = 178
+	CodeGetPendingParamChangesError   Code = 179
+	CodeDeletePendingParamChangeError Code = 180
+	CodeSetUpgradeError               Code = 181
+	CodeGetUpgradeError               Code = 182
+	CodeUpgradeRequiredError          Code = 183
+	CodeHaltHeightError               Code = 184
+	CodeEmptyTestScoreError           Code = 185
+	CodeInvalidNullIndexError         Code = 186
+	CodeServiceNodeJailedError        Code = 187
+	CodeSetQoSScoreError              Code = 188
+	CodeSetJailedUntilHeightError     Code = 189
+	CodeZeroRelaysError               Code = 190
+	CodeInvalidSessionHeightError     Code = 191
+	CodeNotInSessionError             Code = 192
+	CodeRelayBudgetExceededError      Code = 193
+	CodeGetClaimedRelaysError         Code = 194
+	CodeSetClaimedRelaysError         Code = 195
+	CodeStoreStatusEventError         Code = 196
+	CodeGetStatusEventsError          Code = 197
+	CodeNotSessionFishermanError      Code = 198
+	CodeTestScoreAlreadyReportedError Code = 199
+	CodeGetTestScoreReportedError     Code = 200
+	CodeSetTestScoreReportedError     Code = 201
+	CodeMempoolFullError              Code = 202
+	CodeTransactionTooLargeError      Code = 203
+	CodeInvalidVoteBlockError         Code = 204
+	CodeWrongChainIdError             Code = 205
+	CodeGetChainIdError               Code = 206
+	CodeReleaseSavePointError         Code = 207
+
 	GetValidatorStakedTokensError     = "an error occurred getting the validator staked tokens"
 	SetValidatorStakedTokensError     = "an error occurred setting the validator staked tokens"
 	EqualVotesError                   = "the votes are identical and not equivocating"
@@ -201,6 +285,90 @@ const (
 	UnexpectedSocketError      = "socket error: Unexpected peer error."
 	PayloadTooBigError         = "socket error: payload size is too big. "
 	SocketIOStartFailedError   = "socket error: failed to start socket reading/writing (io)"
+
+	InvalidPaginationError        = "the page and per page values must be greater than zero"
+	StoreTransactionError         = "an error occurred storing the transaction result"
+	GetTransactionError           = "an error occurred getting the transaction result"
+	ParamOutOfBoundsError         = "the param value is out of bounds"
+	ProposalNotFoundError         = "the proposal was not found"
+	GetProposalError              = "an error occurred getting the proposal"
+	SetProposalError              = "an error occurred setting the proposal"
+	InsufficientDepositError      = "the deposit is below the minimum"
+	VotingPeriodClosedError       = "the proposal is not open for voting"
+	EmptyProposalContentError     = "the proposal content is empty"
+	EmptyProposalTitleError       = "the proposal title is empty"
+	UnknownVoteOptionError        = "the vote option is unknown"
+	GetStateChangesError          = "an error occurred getting the state changes"
+	NotValidatorAtHeightError     = "the signer was not a staked validator at the height of the evidence"
+	DuplicateEvidenceError        = "the double sign evidence was already submitted"
+	GetEvidenceError              = "an error occurred getting the evidence"
+	SetEvidenceError              = "an error occurred setting the evidence"
+	SequenceTooLowError           = "the sequence was already used by the signer"
+	SequenceTooHighError          = "the sequence is ahead of the signer"
+	GetSequenceError              = "an error occurred getting the account sequence"
+	SetSequenceError              = "an error occurred setting the account sequence"
+	InsufficientFeeError          = "the fee is below the minimum for the message"
+	InvalidFeePercentagesError    = "the proposer and fee owner percentages of fees add up to more than 100"
+	GetTotalSupplyError           = "an error occurred getting the total supply"
+	SetTotalSupplyError           = "an error occurred setting the total supply"
+	InvalidRewardSplitError       = "the proposer, validators and service nodes percentages of the block reward add up to more than 100"
+	GetStakedTokensError          = "an error occurred getting the staked tokens"
+	SetStakedTokensError          = "an error occurred setting the staked tokens"
+	GetUnbondingStakeError        = "an error occurred getting the unbonding stake"
+	SetUnbondingStakeError        = "an error occurred setting the unbonding stake"
+	DeleteUnbondingStakeError     = "an error occurred deleting the unbonding stake"
+	InvalidCommissionError        = "the commission must be a percentage between 0 and 100"
+	GetDelegationError            = "an error occurred getting the delegation"
+	SetDelegationError            = "an error occurred setting the delegation"
+	InsufficientSharesError       = "the delegation has fewer shares than requested"
+	DelegationsSlashedError       = "the delegated tokens of the validator were slashed to zero"
+	SetCommissionError            = "an error occurred setting the commission"
+	SelfRedelegationError         = "the source and destination validators of a redelegation are the same"
+	EmptyBatchError               = "the batch has no messages"
+	NestedBatchError              = "a batch may not contain another batch"
+	NewMultisigPublicKeyError     = "an error occurred creating the multisig public key"
+	InvalidVestingError           = "the vesting schedule is not valid"
+	VestingExistsError            = "the account already has a vesting schedule"
+	LockedStakeOutputError        = "locked tokens may only be staked with the vesting account as the output address"
+	GetVestingError               = "an error occurred getting the vesting schedule"
+	SetVestingError               = "an error occurred setting the vesting schedule"
+	UnknownRelayChainError        = "the relay chain is not in the registry"
+	DisabledRelayChainError       = "the relay chain is disabled"
+	DuplicateRelayChainError      = "the relay chain is registered twice"
+	StoreBlockEventsError         = "an error occurred storing the block events"
+	GetBlockEventsError           = "an error occurred getting the block events"
+	InvalidActivationHeightError  = "the activation height must be after the latest height"
+	EmptyUpgradeVersionError      = "the upgrade version is empty"
+	SetPendingParamChangeError    = "an error occurred scheduling the param change"
+	GetPendingParamChangesError   = "an error occurred getting the pending param changes"
+	DeletePendingParamChangeError = "an error occurred deleting the pending param change"
+	SetUpgradeError               = "an error occurred setting the upgrade"
+	GetUpgradeError               = "an error occurred getting the upgrade"
+	UpgradeRequiredError          = "the node must be upgraded to keep applying blocks"
+	HaltHeightError               = "the node halted at the halt height of its config"
+	EmptyTestScoreError           = "the test score has no samples"
+	InvalidNullIndexError         = "the null index is repeated or out of the samples"
+	ServiceNodeJailedError        = "the service node is jailed"
+	SetQoSScoreError              = "an error occurred setting the qos score"
+	SetJailedUntilHeightError     = "an error occurred setting the jailed until height"
+	ZeroRelaysError               = "the claim has no relays"
+	InvalidSessionHeightError     = "the height is not the start of a session that has begun"
+	NotInSessionError             = "the service node is not in the session"
+	RelayBudgetExceededError      = "the relays exceed the relay budget of the service node in the session"
+	GetClaimedRelaysError         = "an error occurred getting the claimed relays"
+	SetClaimedRelaysError         = "an error occurred setting the claimed relays"
+	StoreStatusEventError         = "an error occurred storing the status event"
+	GetStatusEventsError          = "an error occurred getting the status events"
+	NotSessionFishermanError      = "the reporter is not the fisherman of the session"
+	TestScoreAlreadyReportedError = "the service node was already tested in the session"
+	GetTestScoreReportedError     = "an error occurred getting whether the test score was reported"
+	SetTestScoreReportedError     = "an error occurred setting the test score as reported"
+	MempoolFullError              = "the mempool is full and the transaction is of a lower priority than every transaction in it"
+	TransactionTooLargeError      = "the transaction is larger than the mempool"
+	InvalidVoteBlockError         = "the vote has no block or the block is of another height"
+	WrongChainIdError             = "the vote is for a block of another chain"
+	GetChainIdError               = "an error occurred getting the chain id"
+	ReleaseSavePointError         = "an error occurred releasing the save point"
 )
 
 func ErrUnknownParam(paramName string) Error {
@@ -567,3 +735,335 @@ func ErrPayloadTooBig(bodyLength, acceptedLength uint) error {
 func ErrSocketIOStartFailed(socketType string) error {
 	return NewError(CodeSocketIOStartFailedError, fmt.Sprintf("%s: (%s socket)", SocketIOStartFailedError, socketType))
 }
+
+func ErrInvalidPagination(page, perPage int) Error {
+	return NewError(CodeInvalidPaginationError, fmt.Sprintf("%s: page %d, per page %d", InvalidPaginationError, page, perPage))
+}
+
+func ErrStoreTransaction(err error) Error {
+	return NewError(CodeStoreTransactionError, fmt.Sprintf("%s: %s", StoreTransactionError, err.Error()))
+}
+
+func ErrGetTransaction(err error) Error {
+	return NewError(CodeGetTransactionError, fmt.Sprintf("%s: %s", GetTransactionError, err.Error()))
+}
+
+func ErrParamOutOfBounds(paramName string, value, min, max int64) Error {
+	return NewError(CodeParamOutOfBoundsError, fmt.Sprintf("%s: %s = %d, expected between %d and %d", ParamOutOfBoundsError, paramName, value, min, max))
+}
+
+func ErrProposalNotFound(proposalID uint64) Error {
+	return NewError(CodeProposalNotFoundError, fmt.Sprintf("%s: %d", ProposalNotFoundError, proposalID))
+}
+
+func ErrGetProposal(err error) Error {
+	return NewError(CodeGetProposalError, fmt.Sprintf("%s: %s", GetProposalError, err.Error()))
+}
+
+func ErrSetProposal(err error) Error {
+	return NewError(CodeSetProposalError, fmt.Sprintf("%s: %s", SetProposalError, err.Error()))
+}
+
+func ErrInsufficientDeposit(deposit, minimum string) Error {
+	return NewError(CodeInsufficientDepositError, fmt.Sprintf("%s: got %s, expected at least %s", InsufficientDepositError, deposit, minimum))
+}
+
+func ErrVotingPeriodClosed(proposalID uint64) Error {
+	return NewError(CodeVotingPeriodClosedError, fmt.Sprintf("%s: %d", VotingPeriodClosedError, proposalID))
+}
+
+func ErrEmptyProposalContent() Error {
+	return NewError(CodeEmptyProposalContentError, fmt.Sprintf("%s", EmptyProposalContentError))
+}
+
+func ErrEmptyProposalTitle() Error {
+	return NewError(CodeEmptyProposalTitleError, fmt.Sprintf("%s", EmptyProposalTitleError))
+}
+
+func ErrUnknownVoteOption(option int32) Error {
+	return NewError(CodeUnknownVoteOptionError, fmt.Sprintf("%s: %d", UnknownVoteOptionError, option))
+}
+
+func ErrGetStateChanges(err error) Error {
+	return NewError(CodeGetStateChangesError, fmt.Sprintf("%s: %s", GetStateChangesError, err.Error()))
+}
+
+func ErrNotValidatorAtHeight(address []byte, height int64) Error {
+	return NewError(CodeNotValidatorAtHeightError, fmt.Sprintf("%s: %s at %d", NotValidatorAtHeightError, hex.EncodeToString(address), height))
+}
+
+func ErrDuplicateEvidence() Error {
+	return NewError(CodeDuplicateEvidenceError, DuplicateEvidenceError)
+}
+
+func ErrGetEvidence(err error) Error {
+	return NewError(CodeGetEvidenceError, fmt.Sprintf("%s: %s", GetEvidenceError, err.Error()))
+}
+
+func ErrSetEvidence(err error) Error {
+	return NewError(CodeSetEvidenceError, fmt.Sprintf("%s: %s", SetEvidenceError, err.Error()))
+}
+
+func ErrSequenceTooLow(expected, got uint64) Error {
+	return NewError(CodeSequenceTooLowError, fmt.Sprintf("%s: expected %d, got %d", SequenceTooLowError, expected, got))
+}
+
+func ErrSequenceTooHigh(expected, got uint64) Error {
+	return NewError(CodeSequenceTooHighError, fmt.Sprintf("%s: expected %d, got %d", SequenceTooHighError, expected, got))
+}
+
+func ErrGetSequence(err error) Error {
+	return NewError(CodeGetSequenceError, fmt.Sprintf("%s: %s", GetSequenceError, err.Error()))
+}
+
+func ErrSetSequence(err error) Error {
+	return NewError(CodeSetSequenceError, fmt.Sprintf("%s: %s", SetSequenceError, err.Error()))
+}
+
+func ErrInsufficientFee(fee, minimum string) Error {
+	return NewError(CodeInsufficientFeeError, fmt.Sprintf("%s: got %s, expected at least %s", InsufficientFeeError, fee, minimum))
+}
+
+func ErrInvalidFeePercentages(proposerPercentage, feeOwnerPercentage int) Error {
+	return NewError(CodeInvalidFeePercentagesError, fmt.Sprintf("%s: %d + %d", InvalidFeePercentagesError, proposerPercentage, feeOwnerPercentage))
+}
+
+func ErrGetTotalSupply(err error) Error {
+	return NewError(CodeGetTotalSupplyError, fmt.Sprintf("%s: %s", GetTotalSupplyError, err.Error()))
+}
+
+func ErrSetTotalSupply(err error) Error {
+	return NewError(CodeSetTotalSupplyError, fmt.Sprintf("%s: %s", SetTotalSupplyError, err.Error()))
+}
+
+func ErrInvalidRewardSplit(proposerPercentage, validatorsPercentage, serviceNodesPercentage int) Error {
+	return NewError(CodeInvalidRewardSplitError, fmt.Sprintf("%s: %d + %d + %d", InvalidRewardSplitError, proposerPercentage, validatorsPercentage, serviceNodesPercentage))
+}
+
+func ErrGetStakedTokens(err error) Error {
+	return NewError(CodeGetStakedTokensError, fmt.Sprintf("%s: %s", GetStakedTokensError, err.Error()))
+}
+
+func ErrSetStakedTokens(err error) Error {
+	return NewError(CodeSetStakedTokensError, fmt.Sprintf("%s: %s", SetStakedTokensError, err.Error()))
+}
+
+func ErrGetUnbondingStake(err error) Error {
+	return NewError(CodeGetUnbondingStakeError, fmt.Sprintf("%s: %s", GetUnbondingStakeError, err.Error()))
+}
+
+func ErrSetUnbondingStake(err error) Error {
+	return NewError(CodeSetUnbondingStakeError, fmt.Sprintf("%s: %s", SetUnbondingStakeError, err.Error()))
+}
+
+func ErrDeleteUnbondingStake(err error) Error {
+	return NewError(CodeDeleteUnbondingStakeError, fmt.Sprintf("%s: %s", DeleteUnbondingStakeError, err.Error()))
+}
+
+func ErrInvalidCommission(commission int32) Error {
+	return NewError(CodeInvalidCommissionError, fmt.Sprintf("%s: %d", InvalidCommissionError, commission))
+}
+
+func ErrGetDelegation(err error) Error {
+	return NewError(CodeGetDelegationError, fmt.Sprintf("%s: %s", GetDelegationError, err.Error()))
+}
+
+func ErrSetDelegation(err error) Error {
+	return NewError(CodeSetDelegationError, fmt.Sprintf("%s: %s", SetDelegationError, err.Error()))
+}
+
+func ErrInsufficientShares(shares, available string) Error {
+	return NewError(CodeInsufficientSharesError, fmt.Sprintf("%s: %s requested, %s available", InsufficientSharesError, shares, available))
+}
+
+func ErrDelegationsSlashed() Error {
+	return NewError(CodeDelegationsSlashedError, DelegationsSlashedError)
+}
+
+func ErrSetCommission(err error) Error {
+	return NewError(CodeSetCommissionError, fmt.Sprintf("%s: %s", SetCommissionError, err.Error()))
+}
+
+func ErrSelfRedelegation() Error {
+	return NewError(CodeSelfRedelegationError, SelfRedelegationError)
+}
+
+func ErrEmptyBatch() Error {
+	return NewError(CodeEmptyBatchError, EmptyBatchError)
+}
+
+func ErrNestedBatch() Error {
+	return NewError(CodeNestedBatchError, NestedBatchError)
+}
+
+func ErrNewMultisigPublicKey(err error) Error {
+	return NewError(CodeNewMultisigPublicKeyError, fmt.Sprintf("%s: %s", NewMultisigPublicKeyError, err.Error()))
+}
+
+func ErrInvalidVesting(reason string) Error {
+	return NewError(CodeInvalidVestingError, fmt.Sprintf("%s: %s", InvalidVestingError, reason))
+}
+
+func ErrVestingExists() Error {
+	return NewError(CodeVestingExistsError, VestingExistsError)
+}
+
+func ErrLockedStakeOutput() Error {
+	return NewError(CodeLockedStakeOutputError, LockedStakeOutputError)
+}
+
+func ErrGetVesting(err error) Error {
+	return NewError(CodeGetVestingError, fmt.Sprintf("%s: %s", GetVestingError, err.Error()))
+}
+
+func ErrSetVesting(err error) Error {
+	return NewError(CodeSetVestingError, fmt.Sprintf("%s: %s", SetVestingError, err.Error()))
+}
+
+func ErrUnknownRelayChain(chain string) Error {
+	return NewError(CodeUnknownRelayChainError, fmt.Sprintf("%s: %s", UnknownRelayChainError, chain))
+}
+
+func ErrDisabledRelayChain(chain string) Error {
+	return NewError(CodeDisabledRelayChainError, fmt.Sprintf("%s: %s", DisabledRelayChainError, chain))
+}
+
+func ErrDuplicateRelayChain(chain string) Error {
+	return NewError(CodeDuplicateRelayChainError, fmt.Sprintf("%s: %s", DuplicateRelayChainError, chain))
+}
+
+func ErrStoreBlockEvents(err error) Error {
+	return NewError(CodeStoreBlockEventsError, fmt.Sprintf("%s: %s", StoreBlockEventsError, err.Error()))
+}
+
+func ErrGetBlockEvents(err error) Error {
+	return NewError(CodeGetBlockEventsError, fmt.Sprintf("%s: %s", GetBlockEventsError, err.Error()))
+}
+
+func ErrInvalidActivationHeight(height int64) Error {
+	return NewError(CodeInvalidActivationHeightError, fmt.Sprintf("%s: %d", InvalidActivationHeightError, height))
+}
+
+func ErrEmptyUpgradeVersion() Error {
+	return NewError(CodeEmptyUpgradeVersionError, EmptyUpgradeVersionError)
+}
+
+func ErrSetPendingParamChange(err error) Error {
+	return NewError(CodeSetPendingParamChangeError, fmt.Sprintf("%s: %s", SetPendingParamChangeError, err.Error()))
+}
+
+func ErrGetPendingParamChanges(err error) Error {
+	return NewError(CodeGetPendingParamChangesError, fmt.Sprintf("%s: %s", GetPendingParamChangesError, err.Error()))
+}
+
+func ErrDeletePendingParamChange(err error) Error {
+	return NewError(CodeDeletePendingParamChangeError, fmt.Sprintf("%s: %s", DeletePendingParamChangeError, err.Error()))
+}
+
+func ErrSetUpgrade(err error) Error {
+	return NewError(CodeSetUpgradeError, fmt.Sprintf("%s: %s", SetUpgradeError, err.Error()))
+}
+
+func ErrGetUpgrade(err error) Error {
+	return NewError(CodeGetUpgradeError, fmt.Sprintf("%s: %s", GetUpgradeError, err.Error()))
+}
+
+func ErrUpgradeRequired(version string, height int64) Error {
+	return NewError(CodeUpgradeRequiredError, fmt.Sprintf("%s: version %s is active from height %d", UpgradeRequiredError, version, height))
+}
+
+func ErrHaltHeight(height int64) Error {
+	return NewError(CodeHaltHeightError, fmt.Sprintf("%s: %d", HaltHeightError, height))
+}
+
+func ErrEmptyTestScore() Error {
+	return NewError(CodeEmptyTestScoreError, fmt.Sprintf("%s", EmptyTestScoreError))
+}
+
+func ErrInvalidNullIndex(index uint32) Error {
+	return NewError(CodeInvalidNullIndexError, fmt.Sprintf("%s: %d", InvalidNullIndexError, index))
+}
+
+func ErrServiceNodeJailed(address []byte, jailedUntilHeight int64) Error {
+	return NewError(CodeServiceNodeJailedError, fmt.Sprintf("%s: %s until height %d", ServiceNodeJailedError, hex.EncodeToString(address), jailedUntilHeight))
+}
+
+func ErrSetQoSScore(err error) Error {
+	return NewError(CodeSetQoSScoreError, fmt.Sprintf("%s: %s", SetQoSScoreError, err.Error()))
+}
+
+func ErrSetJailedUntilHeight(err error) Error {
+	return NewError(CodeSetJailedUntilHeightError, fmt.Sprintf("%s: %s", SetJailedUntilHeightError, err.Error()))
+}
+
+func ErrZeroRelays() Error {
+	return NewError(CodeZeroRelaysError, fmt.Sprintf("%s", ZeroRelaysError))
+}
+
+func ErrInvalidSessionHeight(height int64) Error {
+	return NewError(CodeInvalidSessionHeightError, fmt.Sprintf("%s: %d", InvalidSessionHeightError, height))
+}
+
+func ErrNotInSession(address []byte) Error {
+	return NewError(CodeNotInSessionError, fmt.Sprintf("%s: %s", NotInSessionError, hex.EncodeToString(address)))
+}
+
+func ErrRelayBudgetExceeded(relays, budget uint64) Error {
+	return NewError(CodeRelayBudgetExceededError, fmt.Sprintf("%s: %d relays over a budget of %d", RelayBudgetExceededError, relays, budget))
+}
+
+func ErrGetClaimedRelays(err error) Error {
+	return NewError(CodeGetClaimedRelaysError, fmt.Sprintf("%s: %s", GetClaimedRelaysError, err.Error()))
+}
+
+func ErrSetClaimedRelays(err error) Error {
+	return NewError(CodeSetClaimedRelaysError, fmt.Sprintf("%s: %s", SetClaimedRelaysError, err.Error()))
+}
+
+func ErrStoreStatusEvent(err error) Error {
+	return NewError(CodeStoreStatusEventError, fmt.Sprintf("%s: %s", StoreStatusEventError, err.Error()))
+}
+
+func ErrGetStatusEvents(err error) Error {
+	return NewError(CodeGetStatusEventsError, fmt.Sprintf("%s: %s", GetStatusEventsError, err.Error()))
+}
+
+func ErrNotSessionFisherman(address []byte) Error {
+	return NewError(CodeNotSessionFishermanError, fmt.Sprintf("%s: %s", NotSessionFishermanError, hex.EncodeToString(address)))
+}
+
+func ErrTestScoreAlreadyReported(address []byte) Error {
+	return NewError(CodeTestScoreAlreadyReportedError, fmt.Sprintf("%s: %s", TestScoreAlreadyReportedError, hex.EncodeToString(address)))
+}
+
+func ErrGetTestScoreReported(err error) Error {
+	return NewError(CodeGetTestScoreReportedError, fmt.Sprintf("%s: %s", GetTestScoreReportedError, err.Error()))
+}
+
+func ErrSetTestScoreReported(err error) Error {
+	return NewError(CodeSetTestScoreReportedError, fmt.Sprintf("%s: %s", SetTestScoreReportedError, err.Error()))
+}
+
+func ErrMempoolFull() Error {
+	return NewError(CodeMempoolFullError, MempoolFullError)
+}
+
+func ErrTransactionTooLarge(size, maxSize int) Error {
+	return NewError(CodeTransactionTooLargeError, fmt.Sprintf("%s: size %d, max %d", TransactionTooLargeError, size, maxSize))
+}
+
+func ErrInvalidVoteBlock() Error {
+	return NewError(CodeInvalidVoteBlockError, InvalidVoteBlockError)
+}
+
+func ErrWrongChainId(chainId, expected string) Error {
+	return NewError(CodeWrongChainIdError, fmt.Sprintf("%s: got %s, expected %s", WrongChainIdError, chainId, expected))
+}
+
+func ErrGetChainId(err error) Error {
+	return NewError(CodeGetChainIdError, fmt.Sprintf("%s: %s", GetChainIdError, err.Error()))
+}
+
+func ErrReleaseSavePoint(err error) Error {
+	return NewError(CodeReleaseSavePointError, fmt.Sprintf("%s: %s", ReleaseSavePointError, err.Error()))
+}
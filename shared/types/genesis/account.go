@@ -21,9 +21,52 @@ func (a *Account) ValidateBasic() types.Error {
 	if _, ok := amount.SetString(a.Amount, 10); !ok {
 		return types.ErrInvalidAmount()
 	}
+	if a.Vesting == nil {
+		return nil
+	}
+	if err := a.Vesting.ValidateBasic(); err != nil {
+		return err
+	}
+	if locked, _ := types.StringToBigInt(a.Vesting.LockedAmount); locked.Cmp(amount) > 0 {
+		return types.ErrInvalidVesting("the locked amount exceeds the amount of the account")
+	}
+	return nil
+}
+
+func (v *VestingSchedule) ValidateBasic() types.Error {
+	locked, err := types.StringToBigInt(v.LockedAmount)
+	if err != nil {
+		return err
+	}
+	if locked.Sign() <= 0 {
+		return types.ErrInvalidAmount()
+	}
+	if v.StartHeight < 0 || v.CliffHeight < v.StartHeight || v.EndHeight < v.CliffHeight {
+		return types.ErrInvalidVesting("expected 0 <= start height <= cliff height <= end height")
+	}
+	if v.EndHeight == v.StartHeight {
+		return types.ErrInvalidVesting("the end height must be after the start height")
+	}
 	return nil
 }
 
+// Unvested returns the part of the locked amount that is still locked at `height`. Nothing vests before the cliff;
+// from then on the amount vested grows linearly from the start height to the end height, rounded down
+func (v *VestingSchedule) Unvested(height int64) (*big.Int, types.Error) {
+	locked, err := types.StringToBigInt(v.LockedAmount)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case height < v.CliffHeight:
+		return locked, nil
+	case height >= v.EndHeight:
+		return big.NewInt(0), nil
+	}
+	vested := types.MulDiv(locked, height-v.StartHeight, v.EndHeight-v.StartHeight)
+	return locked.Sub(locked, vested), nil
+}
+
 func (a *Account) SetAddress(address crypto.Address) types.Error {
 	if a == nil {
 		return types.ErrEmptyAccount()
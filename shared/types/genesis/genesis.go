@@ -16,6 +16,8 @@ type Genesis struct {
 	GenesisTime time.Time                         `json:"genesis_time"`
 	AppHash     string                            `json:"app_hash"`
 	Validators  []*ValidatorJsonCompatibleWrapper `json:"validators"`
+	// GenesisState is the whole state to start from, as exported by `export-genesis`
+	GenesisState *GenesisState `json:"genesis_state,omitempty"`
 }
 
 // TODO: This is a temporary hack that can load Genesis from a single string
@@ -50,13 +52,19 @@ func PocketGenesisFromJSON(jsonBlob []byte) (*Genesis, error) {
 	return &genesis, nil
 }
 
+// ToJSON encodes the genesis deterministically: the same genesis always results in the same bytes
+func (genesis *Genesis) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(genesis, "", "  ")
+}
+
 func (genesis *Genesis) Validate() error {
 	if genesis.GenesisTime.IsZero() {
 		return fmt.Errorf("GenesisTime cannot be zero")
 	}
 
 	// TODO: validate each account.
-	if len(genesis.Validators) == 0 && (genesis.GenesisStateConfig == nil || genesis.GenesisStateConfig.NumValidators == 0) {
+	if len(genesis.Validators) == 0 && (genesis.GenesisStateConfig == nil || genesis.GenesisStateConfig.NumValidators == 0) &&
+		(genesis.GenesisState == nil || len(genesis.GenesisState.Validators) == 0) {
 		return fmt.Errorf("genesis must contain at least one validator")
 	}
 
@@ -68,6 +76,10 @@ func (genesis *Genesis) Validate() error {
 		return fmt.Errorf("Genesis app hash cannot be zero")
 	}
 
+	if genesis.GenesisState != nil && genesis.GenesisState.ChainId == "" {
+		return fmt.Errorf("genesis state chain id cannot be empty")
+	}
+
 	for _, validator := range genesis.Validators {
 		if err := validator.ValidateBasic(); err != nil {
 			return fmt.Errorf("validator in genesis is invalid: %w", err)
@@ -20,6 +20,8 @@ const ( // Names for each 'pool' (specialized accounts)
 	FishermanStakePoolName   = "FISHERMAN_STAKE_POOL"
 	DAOPoolName              = "DAO_POOL"
 	FeePoolName              = "FEE_POOL"
+	GovDepositPoolName       = "GOV_DEPOSIT_POOL"
+	UnbondingPoolName        = "UNBONDING_POOL"
 )
 
 var (
@@ -32,6 +34,8 @@ var (
 	DefaultServiceNodeStakePool, _ = crypto.NewPrivateKey("b4e4426ed014d5ee89949e6f60c406c328e4fce466cd25f4697a41046b34313097a8cc38033822da010422851062ae6b21b8e29d4c34193b7d8fa0f37b6593b6")
 	DefaultValidatorStakePool, _   = crypto.NewPrivateKey("e0b8b7cdb33f11a8d70eb05070e53b02fe74f4499aed7b159bd2dd256e356d67664b5b682e40ee218e5feea05c2a1bb595ec15f3850c92b571cdf950b4d9ba23")
 	DefaultAppStakePool, _         = crypto.NewPrivateKey("429627bac8dc322f0aeeb2b8f25b329899b7ebb9605d603b5fb74557b13357e50834e9575c19d9d7d664ec460a98abb2435ece93440eb482c87d5b7259a8d271")
+	DefaultGovDepositPool, _       = crypto.NewPrivateKey("33a3098976975395c48d1f75453fd3ea8b53eea20a25bbcd7a39ec2c02bd58424392d9dc9282628e8fbf73539d80825246080d3b837f368237bdff6473ef5af9")
+	DefaultUnbondingPool, _        = crypto.NewPrivateKey("216597f57b425a74685d823028ade9c8f5439f3c50d0aa92e0c72d4987c36efa4818b2a2fea566735903da75888f7e6403edb36440a93358075cc98c631d28b0")
 )
 
 var ( // TODO these are needed placeholders to pass validation checks. Until we have a real genesis implementation & testing environment, this will suffice
@@ -41,6 +45,7 @@ var ( // TODO these are needed placeholders to pass validation checks. Until we
 	DefaultStake          = types.BigIntToString(DefaultStakeBig)
 	DefaultAccountBalance = DefaultStake
 	DefaultStakeStatus    = int32(2)
+	DefaultChainId        = "pocket-localnet"
 )
 
 // TODO(team): NewGenesisStateConfigs is ONLY used for development purposes and disregards the
@@ -57,12 +62,17 @@ type NewGenesisStateConfigs struct {
 	// in the short-term.
 	ValidatorUrlFormat string `json:"validator_url_format"`
 	SeedStart          uint32 `json:"keys_seed_start"`
+
+	ChainId string `json:"chain_id"` // DefaultChainId if empty
 }
 
 // NewGenesisState IMPORTANT NOTE: Not using numOfValidators param, as Validators are now read from the test_state json file
 func NewGenesisState(genesisConfig *NewGenesisStateConfigs) (state *GenesisState, validatorKeys, appKeys, serviceNodeKeys, fishKeys []crypto.PrivateKey, err error) {
 	// create the genesis state object
-	state = &GenesisState{}
+	state = &GenesisState{ChainId: genesisConfig.ChainId}
+	if state.ChainId == "" {
+		state.ChainId = DefaultChainId
+	}
 	validatorKeys = make([]crypto.PrivateKey, genesisConfig.NumValidators)
 	appKeys = make([]crypto.PrivateKey, genesisConfig.NumAppplications)
 	fishKeys = make([]crypto.PrivateKey, genesisConfig.NumFisherman)
@@ -169,31 +179,31 @@ func NewGenesisState(genesisConfig *NewGenesisStateConfigs) (state *GenesisState
 	}
 	// populate the state with default parameters
 	state.Params = DefaultParams()
-	// create appropriate 'stake' pools for each actor type
+	// create appropriate 'stake' pools for each actor type, holding the stake of the genesis actors
 	valStakePool, err := NewPool(ValidatorStakePoolName, &Account{
 		Address: DefaultValidatorStakePool.Address(),
-		Amount:  types.BigIntToString(&big.Int{}),
+		Amount:  totalDefaultStake(len(validatorKeys)),
 	})
 	if err != nil {
 		return
 	}
 	appStakePool, err := NewPool(AppStakePoolName, &Account{
 		Address: DefaultAppStakePool.Address(),
-		Amount:  types.BigIntToString(&big.Int{}),
+		Amount:  totalDefaultStake(len(appKeys)),
 	})
 	if err != nil {
 		return
 	}
 	fishStakePool, err := NewPool(FishermanStakePoolName, &Account{
 		Address: DefaultFishermanStakePool.Address(),
-		Amount:  types.BigIntToString(&big.Int{}),
+		Amount:  totalDefaultStake(len(fishKeys)),
 	})
 	if err != nil {
 		return
 	}
 	serNodeStakePool, err := NewPool(ServiceNodeStakePoolName, &Account{
 		Address: DefaultServiceNodeStakePool.Address(),
-		Amount:  types.BigIntToString(&big.Int{}),
+		Amount:  totalDefaultStake(len(serviceNodeKeys)),
 	})
 	if err != nil {
 		return
@@ -214,6 +224,22 @@ func NewGenesisState(genesisConfig *NewGenesisStateConfigs) (state *GenesisState
 	if err != nil {
 		return
 	}
+	// create a pool escrowing the deposits of governance proposals until they are tallied
+	govDeposit, err := NewPool(GovDepositPoolName, &Account{
+		Address: DefaultGovDepositPool.Address(),
+		Amount:  types.BigIntToString(&big.Int{}),
+	})
+	if err != nil {
+		return
+	}
+	// create a pool holding the partially unstaked tokens until their unstaking period ends
+	unbonding, err := NewPool(UnbondingPoolName, &Account{
+		Address: DefaultUnbondingPool.Address(),
+		Amount:  types.BigIntToString(&big.Int{}),
+	})
+	if err != nil {
+		return
+	}
 	// create an account for the DAO / Param owner
 	pOwnerAddress := DefaultParamsOwner.Address()
 	state.Accounts = append(state.Accounts, &Account{
@@ -223,6 +249,8 @@ func NewGenesisState(genesisConfig *NewGenesisStateConfigs) (state *GenesisState
 	// populate the state pools with the previously created
 	state.Pools = append(state.Pools, dao)
 	state.Pools = append(state.Pools, fee)
+	state.Pools = append(state.Pools, govDeposit)
+	state.Pools = append(state.Pools, unbonding)
 	state.Pools = append(state.Pools, serNodeStakePool)
 	state.Pools = append(state.Pools, fishStakePool)
 	state.Pools = append(state.Pools, appStakePool)
@@ -230,116 +258,6 @@ func NewGenesisState(genesisConfig *NewGenesisStateConfigs) (state *GenesisState
 	return
 }
 
-func DefaultParams() *Params {
-	return &Params{
-		BlocksPerSession:                         4,
-		AppMinimumStake:                          types.BigIntToString(big.NewInt(15000000000)),
-		AppMaxChains:                             15,
-		AppBaselineStakeRate:                     100,
-		AppStakingAdjustment:                     0,
-		AppUnstakingBlocks:                       2016,
-		AppMinimumPauseBlocks:                    4,
-		AppMaxPauseBlocks:                        672,
-		ServiceNodeMinimumStake:                  types.BigIntToString(big.NewInt(15000000000)),
-		ServiceNodeMaxChains:                     15,
-		ServiceNodeUnstakingBlocks:               2016,
-		ServiceNodeMinimumPauseBlocks:            4,
-		ServiceNodeMaxPauseBlocks:                672,
-		ServiceNodesPerSession:                   24,
-		FishermanMinimumStake:                    types.BigIntToString(big.NewInt(15000000000)),
-		FishermanMaxChains:                       15,
-		FishermanUnstakingBlocks:                 2016,
-		FishermanMinimumPauseBlocks:              4,
-		FishermanMaxPauseBlocks:                  672,
-		ValidatorMinimumStake:                    types.BigIntToString(big.NewInt(15000000000)),
-		ValidatorUnstakingBlocks:                 2016,
-		ValidatorMinimumPauseBlocks:              4,
-		ValidatorMaxPauseBlocks:                  672,
-		ValidatorMaximumMissedBlocks:             5,
-		ValidatorMaxEvidenceAgeInBlocks:          8,
-		ProposerPercentageOfFees:                 10,
-		MissedBlocksBurnPercentage:               1,
-		DoubleSignBurnPercentage:                 5,
-		MessageDoubleSignFee:                     types.BigIntToString(big.NewInt(10000)),
-		MessageSendFee:                           types.BigIntToString(big.NewInt(10000)),
-		MessageStakeFishermanFee:                 types.BigIntToString(big.NewInt(10000)),
-		MessageEditStakeFishermanFee:             types.BigIntToString(big.NewInt(10000)),
-		MessageUnstakeFishermanFee:               types.BigIntToString(big.NewInt(10000)),
-		MessagePauseFishermanFee:                 types.BigIntToString(big.NewInt(10000)),
-		MessageUnpauseFishermanFee:               types.BigIntToString(big.NewInt(10000)),
-		MessageFishermanPauseServiceNodeFee:      types.BigIntToString(big.NewInt(10000)),
-		MessageTestScoreFee:                      types.BigIntToString(big.NewInt(10000)),
-		MessageProveTestScoreFee:                 types.BigIntToString(big.NewInt(10000)),
-		MessageStakeAppFee:                       types.BigIntToString(big.NewInt(10000)),
-		MessageEditStakeAppFee:                   types.BigIntToString(big.NewInt(10000)),
-		MessageUnstakeAppFee:                     types.BigIntToString(big.NewInt(10000)),
-		MessagePauseAppFee:                       types.BigIntToString(big.NewInt(10000)),
-		MessageUnpauseAppFee:                     types.BigIntToString(big.NewInt(10000)),
-		MessageStakeValidatorFee:                 types.BigIntToString(big.NewInt(10000)),
-		MessageEditStakeValidatorFee:             types.BigIntToString(big.NewInt(10000)),
-		MessageUnstakeValidatorFee:               types.BigIntToString(big.NewInt(10000)),
-		MessagePauseValidatorFee:                 types.BigIntToString(big.NewInt(10000)),
-		MessageUnpauseValidatorFee:               types.BigIntToString(big.NewInt(10000)),
-		MessageStakeServiceNodeFee:               types.BigIntToString(big.NewInt(10000)),
-		MessageEditStakeServiceNodeFee:           types.BigIntToString(big.NewInt(10000)),
-		MessageUnstakeServiceNodeFee:             types.BigIntToString(big.NewInt(10000)),
-		MessagePauseServiceNodeFee:               types.BigIntToString(big.NewInt(10000)),
-		MessageUnpauseServiceNodeFee:             types.BigIntToString(big.NewInt(10000)),
-		MessageChangeParameterFee:                types.BigIntToString(big.NewInt(10000)),
-		AclOwner:                                 DefaultParamsOwner.Address(),
-		BlocksPerSessionOwner:                    DefaultParamsOwner.Address(),
-		AppMinimumStakeOwner:                     DefaultParamsOwner.Address(),
-		AppMaxChainsOwner:                        DefaultParamsOwner.Address(),
-		AppBaselineStakeRateOwner:                DefaultParamsOwner.Address(),
-		AppStakingAdjustmentOwner:                DefaultParamsOwner.Address(),
-		AppUnstakingBlocksOwner:                  DefaultParamsOwner.Address(),
-		AppMinimumPauseBlocksOwner:               DefaultParamsOwner.Address(),
-		AppMaxPausedBlocksOwner:                  DefaultParamsOwner.Address(),
-		ServiceNodeMinimumStakeOwner:             DefaultParamsOwner.Address(),
-		ServiceNodeMaxChainsOwner:                DefaultParamsOwner.Address(),
-		ServiceNodeUnstakingBlocksOwner:          DefaultParamsOwner.Address(),
-		ServiceNodeMinimumPauseBlocksOwner:       DefaultParamsOwner.Address(),
-		ServiceNodeMaxPausedBlocksOwner:          DefaultParamsOwner.Address(),
-		ServiceNodesPerSessionOwner:              DefaultParamsOwner.Address(),
-		FishermanMinimumStakeOwner:               DefaultParamsOwner.Address(),
-		FishermanMaxChainsOwner:                  DefaultParamsOwner.Address(),
-		FishermanUnstakingBlocksOwner:            DefaultParamsOwner.Address(),
-		FishermanMinimumPauseBlocksOwner:         DefaultParamsOwner.Address(),
-		FishermanMaxPausedBlocksOwner:            DefaultParamsOwner.Address(),
-		ValidatorMinimumStakeOwner:               DefaultParamsOwner.Address(),
-		ValidatorUnstakingBlocksOwner:            DefaultParamsOwner.Address(),
-		ValidatorMinimumPauseBlocksOwner:         DefaultParamsOwner.Address(),
-		ValidatorMaxPausedBlocksOwner:            DefaultParamsOwner.Address(),
-		ValidatorMaximumMissedBlocksOwner:        DefaultParamsOwner.Address(),
-		ValidatorMaxEvidenceAgeInBlocksOwner:     DefaultParamsOwner.Address(),
-		ProposerPercentageOfFeesOwner:            DefaultParamsOwner.Address(),
-		MissedBlocksBurnPercentageOwner:          DefaultParamsOwner.Address(),
-		DoubleSignBurnPercentageOwner:            DefaultParamsOwner.Address(),
-		MessageDoubleSignFeeOwner:                DefaultParamsOwner.Address(),
-		MessageSendFeeOwner:                      DefaultParamsOwner.Address(),
-		MessageStakeFishermanFeeOwner:            DefaultParamsOwner.Address(),
-		MessageEditStakeFishermanFeeOwner:        DefaultParamsOwner.Address(),
-		MessageUnstakeFishermanFeeOwner:          DefaultParamsOwner.Address(),
-		MessagePauseFishermanFeeOwner:            DefaultParamsOwner.Address(),
-		MessageUnpauseFishermanFeeOwner:          DefaultParamsOwner.Address(),
-		MessageFishermanPauseServiceNodeFeeOwner: DefaultParamsOwner.Address(),
-		MessageTestScoreFeeOwner:                 DefaultParamsOwner.Address(),
-		MessageProveTestScoreFeeOwner:            DefaultParamsOwner.Address(),
-		MessageStakeAppFeeOwner:                  DefaultParamsOwner.Address(),
-		MessageEditStakeAppFeeOwner:              DefaultParamsOwner.Address(),
-		MessageUnstakeAppFeeOwner:                DefaultParamsOwner.Address(),
-		MessagePauseAppFeeOwner:                  DefaultParamsOwner.Address(),
-		MessageUnpauseAppFeeOwner:                DefaultParamsOwner.Address(),
-		MessageStakeValidatorFeeOwner:            DefaultParamsOwner.Address(),
-		MessageEditStakeValidatorFeeOwner:        DefaultParamsOwner.Address(),
-		MessageUnstakeValidatorFeeOwner:          DefaultParamsOwner.Address(),
-		MessagePauseValidatorFeeOwner:            DefaultParamsOwner.Address(),
-		MessageUnpauseValidatorFeeOwner:          DefaultParamsOwner.Address(),
-		MessageStakeServiceNodeFeeOwner:          DefaultParamsOwner.Address(),
-		MessageEditStakeServiceNodeFeeOwner:      DefaultParamsOwner.Address(),
-		MessageUnstakeServiceNodeFeeOwner:        DefaultParamsOwner.Address(),
-		MessagePauseServiceNodeFeeOwner:          DefaultParamsOwner.Address(),
-		MessageUnpauseServiceNodeFeeOwner:        DefaultParamsOwner.Address(),
-		MessageChangeParameterFeeOwner:           DefaultParamsOwner.Address(),
-	}
+func totalDefaultStake(numActors int) string {
+	return types.BigIntToString(new(big.Int).Mul(DefaultStakeBig, big.NewInt(int64(numActors))))
 }
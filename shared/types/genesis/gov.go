@@ -0,0 +1,588 @@
+package genesis
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	BlocksPerSessionParamName = "BlocksPerSession"
+
+	AppMinimumStakeParamName        = "AppMinimumStake"
+	AppMaxChainsParamName           = "AppMaximumChains"
+	AppBaselineStakeRateParamName   = "AppStakeRate"
+	AppStabilityAdjustmentParamName = "AppStakingAdjustment"
+	AppUnstakingBlocksParamName     = "AppUnstakingBlocks"
+	AppMinimumPauseBlocksParamName  = "AppMinimumPauseBlocks"
+	AppMaxPauseBlocksParamName      = "AppMaxPauseBlocks"
+
+	ServiceNodeMinimumStakeParamName       = "ServiceNodeMinimumStake"
+	ServiceNodeMaxChainsParamName          = "ServiceNodeMaximumChains"
+	ServiceNodeUnstakingBlocksParamName    = "ServiceNodeUnstakingBlocks"
+	ServiceNodeMinimumPauseBlocksParamName = "ServiceNodeMinimumPauseBlocks"
+	ServiceNodeMaxPauseBlocksParamName     = "ServiceNodeMaxPauseBlocks"
+	ServiceNodesPerSessionParamName        = "ServiceNodesPerSession"
+
+	FishermanMinimumStakeParamName       = "FishermanMinimumStake"
+	FishermanMaxChainsParamName          = "FishermanMaximumChains"
+	FishermanUnstakingBlocksParamName    = "FishermanUnstakingBlocks"
+	FishermanMinimumPauseBlocksParamName = "FishermanMinimumPauseBlocks"
+	FishermanMaxPauseBlocksParamName     = "FishermanMaxPauseBlocks"
+
+	ValidatorMinimumStakeParamName        = "ValidatorMinimumStake"
+	ValidatorUnstakingBlocksParamName     = "ValidatorUnstakingBlocks"
+	ValidatorMinimumPauseBlocksParamName  = "ValidatorMinimumPauseBlocks"
+	ValidatorMaxPausedBlocksParamName     = "ValidatorMaxPauseBlocks"
+	ValidatorMaximumMissedBlocksParamName = "ValidatorMaximumMissedBlocks"
+
+	ValidatorMaxEvidenceAgeInBlocksParamName = "ValidatorMaxEvidenceAgeInBlocks"
+	ProposerPercentageOfFeesParamName        = "ProposerPercentageOfFees"
+	MissedBlocksBurnPercentageParamName      = "MissedBlocksBurnPercentage"
+	DoubleSignBurnPercentageParamName        = "DoubleSignPercentage"
+
+	MessageDoubleSignFee                = "MessageDoubleSignFee"
+	MessageSendFee                      = "MessageSendFee"
+	MessageStakeFishermanFee            = "MessageStakeFishermanFee"
+	MessageEditStakeFishermanFee        = "MessageEditStakeFishermanFee"
+	MessageUnstakeFishermanFee          = "MessageUnstakeFishermanFee"
+	MessagePauseFishermanFee            = "MessagePauseFishermanFee"
+	MessageUnpauseFishermanFee          = "MessageUnpauseFishermanFee"
+	MessageFishermanPauseServiceNodeFee = "MessageFishermanPauseServiceNodeFee"
+	MessageTestScoreFee                 = "MessageTestScoreFee"
+	MessageProveTestScoreFee            = "MessageProveTestScoreFee"
+	MessageStakeAppFee                  = "MessageStakeAppFee"
+	MessageEditStakeAppFee              = "MessageEditStakeAppFee"
+	MessageUnstakeAppFee                = "MessageUnstakeAppFee"
+	MessagePauseAppFee                  = "MessagePauseAppFee"
+	MessageUnpauseAppFee                = "MessageUnpauseAppFee"
+	MessageStakeValidatorFee            = "MessageStakeValidatorFee"
+	MessageEditStakeValidatorFee        = "MessageEditStakeValidatorFee"
+	MessageUnstakeValidatorFee          = "MessageUnstakeValidatorFee"
+	MessagePauseValidatorFee            = "MessagePauseValidatorFee"
+	MessageUnpauseValidatorFee          = "MessageUnpauseValidatorFee"
+	MessageStakeServiceNodeFee          = "MessageStakeServiceNodeFee"
+	MessageEditStakeServiceNodeFee      = "MessageEditStakeServiceNodeFee"
+	MessageUnstakeServiceNodeFee        = "MessageUnstakeServiceNodeFee"
+	MessagePauseServiceNodeFee          = "MessagePauseServiceNodeFee"
+	MessageUnpauseServiceNodeFee        = "MessageUnpauseServiceNodeFee"
+	MessageChangeParameterFee           = "MessageChangeParameterFee"
+
+	AclOwner                                 = "AclOwner"
+	BlocksPerSessionOwner                    = "BlocksPerSessionOwner"
+	AppMinimumStakeOwner                     = "AppMinimumStakeOwner"
+	AppMaxChainsOwner                        = "AppMaxChainsOwner"
+	AppBaselineStakeRateOwner                = "AppBaselineStakeRateOwner"
+	AppStakingAdjustmentOwner                = "AppStakingAdjustmentOwner"
+	AppUnstakingBlocksOwner                  = "AppUnstakingBlocksOwner"
+	AppMinimumPauseBlocksOwner               = "AppMinimumPauseBlocksOwner"
+	AppMaxPausedBlocksOwner                  = "AppMaxPausedBlocksOwner"
+	ServiceNodeMinimumStakeOwner             = "ServiceNodeMinimumStakeOwner"
+	ServiceNodeMaxChainsOwner                = "ServiceNodeMaxChainsOwner"
+	ServiceNodeUnstakingBlocksOwner          = "ServiceNodeUnstakingBlocksOwner"
+	ServiceNodeMinimumPauseBlocksOwner       = "ServiceNodeMinimumPauseBlocksOwner"
+	ServiceNodeMaxPausedBlocksOwner          = "ServiceNodeMaxPausedBlocksOwner"
+	ServiceNodesPerSessionOwner              = "ServiceNodesPerSessionOwner"
+	FishermanMinimumStakeOwner               = "FishermanMinimumStakeOwner"
+	FishermanMaxChainsOwner                  = "FishermanMaxChainsOwner"
+	FishermanUnstakingBlocksOwner            = "FishermanUnstakingBlocksOwner"
+	FishermanMinimumPauseBlocksOwner         = "FishermanMinimumPauseBlocksOwner"
+	FishermanMaxPausedBlocksOwner            = "FishermanMaxPausedBlocksOwner"
+	ValidatorMinimumStakeOwner               = "ValidatorMinimumStakeOwner"
+	ValidatorUnstakingBlocksOwner            = "ValidatorUnstakingBlocksOwner"
+	ValidatorMinimumPauseBlocksOwner         = "ValidatorMinimumPauseBlocksOwner"
+	ValidatorMaxPausedBlocksOwner            = "ValidatorMaxPausedBlocksOwner"
+	ValidatorMaximumMissedBlocksOwner        = "ValidatorMaximumMissedBlocksOwner"
+	ValidatorMaxEvidenceAgeInBlocksOwner     = "ValidatorMaxEvidenceAgeInBlocksOwner"
+	ProposerPercentageOfFeesOwner            = "ProposerPercentageOfFeesOwner"
+	MissedBlocksBurnPercentageOwner          = "MissedBlocksBurnPercentageOwner"
+	DoubleSignBurnPercentageOwner            = "DoubleSignBurnPercentageOwner"
+	MessageDoubleSignFeeOwner                = "MessageDoubleSignFeeOwner"
+	MessageSendFeeOwner                      = "MessageSendFeeOwner"
+	MessageStakeFishermanFeeOwner            = "MessageStakeFishermanFeeOwner"
+	MessageEditStakeFishermanFeeOwner        = "MessageEditStakeFishermanFeeOwner"
+	MessageUnstakeFishermanFeeOwner          = "MessageUnstakeFishermanFeeOwner"
+	MessagePauseFishermanFeeOwner            = "MessagePauseFishermanFeeOwner"
+	MessageUnpauseFishermanFeeOwner          = "MessageUnpauseFishermanFeeOwner"
+	MessageFishermanPauseServiceNodeFeeOwner = "MessageFishermanPauseServiceNodeFeeOwner"
+	MessageTestScoreFeeOwner                 = "MessageTestScoreFeeOwner"
+	MessageProveTestScoreFeeOwner            = "MessageProveTestScoreFeeOwner"
+	MessageStakeAppFeeOwner                  = "MessageStakeAppFeeOwner"
+	MessageEditStakeAppFeeOwner              = "MessageEditStakeAppFeeOwner"
+	MessageUnstakeAppFeeOwner                = "MessageUnstakeAppFeeOwner"
+	MessagePauseAppFeeOwner                  = "MessagePauseAppFeeOwner"
+	MessageUnpauseAppFeeOwner                = "MessageUnpauseAppFeeOwner"
+	MessageStakeValidatorFeeOwner            = "MessageStakeValidatorFeeOwner"
+	MessageEditStakeValidatorFeeOwner        = "MessageEditStakeValidatorFeeOwner"
+	MessageUnstakeValidatorFeeOwner          = "MessageUnstakeValidatorFeeOwner"
+	MessagePauseValidatorFeeOwner            = "MessagePauseValidatorFeeOwner"
+	MessageUnpauseValidatorFeeOwner          = "MessageUnpauseValidatorFeeOwner"
+	MessageStakeServiceNodeFeeOwner          = "MessageStakeServiceNodeFeeOwner"
+	MessageEditStakeServiceNodeFeeOwner      = "MessageEditStakeServiceNodeFeeOwner"
+	MessageUnstakeServiceNodeFeeOwner        = "MessageUnstakeServiceNodeFeeOwner"
+	MessagePauseServiceNodeFeeOwner          = "MessagePauseServiceNodeFeeOwner"
+	MessageUnpauseServiceNodeFeeOwner        = "MessageUnpauseServiceNodeFeeOwner"
+	MessageChangeParameterFeeOwner           = "MessageChangeParameterFeeOwner"
+
+	GovVotingPeriodBlocksParamName      = "GovVotingPeriodBlocks"
+	GovMinimumDepositParamName          = "GovMinimumDeposit"
+	GovQuorumPercentageParamName        = "GovQuorumPercentage"
+	GovPassThresholdPercentageParamName = "GovPassThresholdPercentage"
+	MessageSubmitProposalFee            = "MessageSubmitProposalFee"
+	MessageVoteProposalFee              = "MessageVoteProposalFee"
+
+	GovVotingPeriodBlocksOwner      = "GovVotingPeriodBlocksOwner"
+	GovMinimumDepositOwner          = "GovMinimumDepositOwner"
+	GovQuorumPercentageOwner        = "GovQuorumPercentageOwner"
+	GovPassThresholdPercentageOwner = "GovPassThresholdPercentageOwner"
+	MessageSubmitProposalFeeOwner   = "MessageSubmitProposalFeeOwner"
+	MessageVoteProposalFeeOwner     = "MessageVoteProposalFeeOwner"
+
+	DoubleSignReporterRewardPercentageParamName = "DoubleSignReporterRewardPercentage"
+
+	DoubleSignReporterRewardPercentageOwner = "DoubleSignReporterRewardPercentageOwner"
+
+	FeeOwnerPercentageOfFeesParamName = "FeeOwnerPercentageOfFees"
+
+	FeeOwnerPercentageOfFeesOwner = "FeeOwnerPercentageOfFeesOwner"
+
+	BlockRewardAmountParamName                 = "BlockRewardAmount"
+	BlockRewardProposerPercentageParamName     = "BlockRewardProposerPercentage"
+	BlockRewardValidatorsPercentageParamName   = "BlockRewardValidatorsPercentage"
+	BlockRewardServiceNodesPercentageParamName = "BlockRewardServiceNodesPercentage"
+
+	BlockRewardAmountOwner                 = "BlockRewardAmountOwner"
+	BlockRewardProposerPercentageOwner     = "BlockRewardProposerPercentageOwner"
+	BlockRewardValidatorsPercentageOwner   = "BlockRewardValidatorsPercentageOwner"
+	BlockRewardServiceNodesPercentageOwner = "BlockRewardServiceNodesPercentageOwner"
+
+	MessagePartialUnstakeAppFee         = "MessagePartialUnstakeAppFee"
+	MessagePartialUnstakeServiceNodeFee = "MessagePartialUnstakeServiceNodeFee"
+	MessagePartialUnstakeFishermanFee   = "MessagePartialUnstakeFishermanFee"
+	MessagePartialUnstakeValidatorFee   = "MessagePartialUnstakeValidatorFee"
+
+	MessagePartialUnstakeAppFeeOwner         = "MessagePartialUnstakeAppFeeOwner"
+	MessagePartialUnstakeServiceNodeFeeOwner = "MessagePartialUnstakeServiceNodeFeeOwner"
+	MessagePartialUnstakeFishermanFeeOwner   = "MessagePartialUnstakeFishermanFeeOwner"
+	MessagePartialUnstakeValidatorFeeOwner   = "MessagePartialUnstakeValidatorFeeOwner"
+
+	MessageDelegateFee   = "MessageDelegateFee"
+	MessageUndelegateFee = "MessageUndelegateFee"
+	MessageRedelegateFee = "MessageRedelegateFee"
+
+	MessageDelegateFeeOwner   = "MessageDelegateFeeOwner"
+	MessageUndelegateFeeOwner = "MessageUndelegateFeeOwner"
+	MessageRedelegateFeeOwner = "MessageRedelegateFeeOwner"
+
+	MessageCreateVestingAccountFee = "MessageCreateVestingAccountFee"
+
+	MessageCreateVestingAccountFeeOwner = "MessageCreateVestingAccountFeeOwner"
+
+	RelayChainsParamName = "RelayChains"
+
+	RelayChainsOwner = "RelayChainsOwner"
+
+	MessageUpgradeFee = "MessageUpgradeFee"
+
+	MessageUpgradeFeeOwner = "MessageUpgradeFeeOwner"
+
+	UpgradeOwner = "UpgradeOwner"
+
+	ServiceNodeQoSWindowParamName       = "ServiceNodeQoSWindow"
+	ServiceNodeMinimumQoSScoreParamName = "ServiceNodeMinimumQoSScore"
+	ServiceNodeJailBlocksParamName      = "ServiceNodeJailBlocks"
+	ServiceNodeSlashPercentageParamName = "ServiceNodeSlashPercentage"
+	FishermanBountyPercentageParamName  = "FishermanBountyPercentage"
+
+	ServiceNodeQoSWindowOwner       = "ServiceNodeQoSWindowOwner"
+	ServiceNodeMinimumQoSScoreOwner = "ServiceNodeMinimumQoSScoreOwner"
+	ServiceNodeJailBlocksOwner      = "ServiceNodeJailBlocksOwner"
+	ServiceNodeSlashPercentageOwner = "ServiceNodeSlashPercentageOwner"
+	FishermanBountyPercentageOwner  = "FishermanBountyPercentageOwner"
+
+	ServiceNodeSessionQoSFloorParamName = "ServiceNodeSessionQoSFloor"
+
+	ServiceNodeSessionQoSFloorOwner = "ServiceNodeSessionQoSFloorOwner"
+
+	MessageClaimFee = "MessageClaimFee"
+
+	MessageClaimFeeOwner = "MessageClaimFeeOwner"
+)
+
+// Param declares a governance parameter once: its key, the `Params` field holding its value, the wrapper type and
+// default of that value, the validation applied to every change, and the key of the param holding its owner
+type Param struct {
+	Key      string
+	Field    protoreflect.Name
+	Owner    string
+	Default  proto.Message // *wrapperspb.Int32Value, *wrapperspb.StringValue, *wrapperspb.BytesValue or *RelayChains
+	Validate func(value proto.Message) types.Error
+}
+
+// ParamRegistry is the ordered list of every governance parameter; the owner params are themselves owned by the `AclOwner`
+var ParamRegistry = []*Param{
+	int32Param(BlocksPerSessionParamName, "blocks_per_session", BlocksPerSessionOwner, 4, 1, math.MaxInt32),
+	amountParam(AppMinimumStakeParamName, "app_minimum_stake", AppMinimumStakeOwner, 15000000000),
+	int32Param(AppMaxChainsParamName, "app_max_chains", AppMaxChainsOwner, 15, 1, math.MaxInt32),
+	int32Param(AppBaselineStakeRateParamName, "app_baseline_stake_rate", AppBaselineStakeRateOwner, 100, 0, math.MaxInt32),
+	int32Param(AppStabilityAdjustmentParamName, "app_staking_adjustment", AppStakingAdjustmentOwner, 0, math.MinInt32, math.MaxInt32),
+	int32Param(AppUnstakingBlocksParamName, "app_unstaking_blocks", AppUnstakingBlocksOwner, 2016, 0, math.MaxInt32),
+	int32Param(AppMinimumPauseBlocksParamName, "app_minimum_pause_blocks", AppMinimumPauseBlocksOwner, 4, 0, math.MaxInt32),
+	int32Param(AppMaxPauseBlocksParamName, "app_max_pause_blocks", AppMaxPausedBlocksOwner, 672, 0, math.MaxInt32),
+	amountParam(ServiceNodeMinimumStakeParamName, "service_node_minimum_stake", ServiceNodeMinimumStakeOwner, 15000000000),
+	int32Param(ServiceNodeMaxChainsParamName, "service_node_max_chains", ServiceNodeMaxChainsOwner, 15, 1, math.MaxInt32),
+	int32Param(ServiceNodeUnstakingBlocksParamName, "service_node_unstaking_blocks", ServiceNodeUnstakingBlocksOwner, 2016, 0, math.MaxInt32),
+	int32Param(ServiceNodeMinimumPauseBlocksParamName, "service_node_minimum_pause_blocks", ServiceNodeMinimumPauseBlocksOwner, 4, 0, math.MaxInt32),
+	int32Param(ServiceNodeMaxPauseBlocksParamName, "service_node_max_pause_blocks", ServiceNodeMaxPausedBlocksOwner, 672, 0, math.MaxInt32),
+	int32Param(ServiceNodesPerSessionParamName, "service_nodes_per_session", ServiceNodesPerSessionOwner, 24, 1, math.MaxInt32),
+	amountParam(FishermanMinimumStakeParamName, "fisherman_minimum_stake", FishermanMinimumStakeOwner, 15000000000),
+	int32Param(FishermanMaxChainsParamName, "fisherman_max_chains", FishermanMaxChainsOwner, 15, 1, math.MaxInt32),
+	int32Param(FishermanUnstakingBlocksParamName, "fisherman_unstaking_blocks", FishermanUnstakingBlocksOwner, 2016, 0, math.MaxInt32),
+	int32Param(FishermanMinimumPauseBlocksParamName, "fisherman_minimum_pause_blocks", FishermanMinimumPauseBlocksOwner, 4, 0, math.MaxInt32),
+	int32Param(FishermanMaxPauseBlocksParamName, "fisherman_max_pause_blocks", FishermanMaxPausedBlocksOwner, 672, 0, math.MaxInt32),
+	amountParam(ValidatorMinimumStakeParamName, "validator_minimum_stake", ValidatorMinimumStakeOwner, 15000000000),
+	int32Param(ValidatorUnstakingBlocksParamName, "validator_unstaking_blocks", ValidatorUnstakingBlocksOwner, 2016, 0, math.MaxInt32),
+	int32Param(ValidatorMinimumPauseBlocksParamName, "validator_minimum_pause_blocks", ValidatorMinimumPauseBlocksOwner, 4, 0, math.MaxInt32),
+	int32Param(ValidatorMaxPausedBlocksParamName, "validator_max_pause_blocks", ValidatorMaxPausedBlocksOwner, 672, 0, math.MaxInt32),
+	int32Param(ValidatorMaximumMissedBlocksParamName, "validator_maximum_missed_blocks", ValidatorMaximumMissedBlocksOwner, 5, 0, math.MaxInt32),
+	int32Param(ValidatorMaxEvidenceAgeInBlocksParamName, "validator_max_evidence_age_in_blocks", ValidatorMaxEvidenceAgeInBlocksOwner, 8, 1, math.MaxInt32),
+	int32Param(ProposerPercentageOfFeesParamName, "proposer_percentage_of_fees", ProposerPercentageOfFeesOwner, 10, 0, 100),
+	int32Param(MissedBlocksBurnPercentageParamName, "missed_blocks_burn_percentage", MissedBlocksBurnPercentageOwner, 1, 0, 100),
+	int32Param(DoubleSignBurnPercentageParamName, "double_sign_burn_percentage", DoubleSignBurnPercentageOwner, 5, 0, 100),
+	amountParam(MessageDoubleSignFee, "message_double_sign_fee", MessageDoubleSignFeeOwner, 10000),
+	amountParam(MessageSendFee, "message_send_fee", MessageSendFeeOwner, 10000),
+	amountParam(MessageStakeFishermanFee, "message_stake_fisherman_fee", MessageStakeFishermanFeeOwner, 10000),
+	amountParam(MessageEditStakeFishermanFee, "message_edit_stake_fisherman_fee", MessageEditStakeFishermanFeeOwner, 10000),
+	amountParam(MessageUnstakeFishermanFee, "message_unstake_fisherman_fee", MessageUnstakeFishermanFeeOwner, 10000),
+	amountParam(MessagePauseFishermanFee, "message_pause_fisherman_fee", MessagePauseFishermanFeeOwner, 10000),
+	amountParam(MessageUnpauseFishermanFee, "message_unpause_fisherman_fee", MessageUnpauseFishermanFeeOwner, 10000),
+	amountParam(MessageFishermanPauseServiceNodeFee, "message_fisherman_pause_service_node_fee", MessageFishermanPauseServiceNodeFeeOwner, 10000),
+	amountParam(MessageTestScoreFee, "message_test_score_fee", MessageTestScoreFeeOwner, 10000),
+	amountParam(MessageProveTestScoreFee, "message_prove_test_score_fee", MessageProveTestScoreFeeOwner, 10000),
+	amountParam(MessageStakeAppFee, "message_stake_app_fee", MessageStakeAppFeeOwner, 10000),
+	amountParam(MessageEditStakeAppFee, "message_edit_stake_app_fee", MessageEditStakeAppFeeOwner, 10000),
+	amountParam(MessageUnstakeAppFee, "message_unstake_app_fee", MessageUnstakeAppFeeOwner, 10000),
+	amountParam(MessagePauseAppFee, "message_pause_app_fee", MessagePauseAppFeeOwner, 10000),
+	amountParam(MessageUnpauseAppFee, "message_unpause_app_fee", MessageUnpauseAppFeeOwner, 10000),
+	amountParam(MessageStakeValidatorFee, "message_stake_validator_fee", MessageStakeValidatorFeeOwner, 10000),
+	amountParam(MessageEditStakeValidatorFee, "message_edit_stake_validator_fee", MessageEditStakeValidatorFeeOwner, 10000),
+	amountParam(MessageUnstakeValidatorFee, "message_unstake_validator_fee", MessageUnstakeValidatorFeeOwner, 10000),
+	amountParam(MessagePauseValidatorFee, "message_pause_validator_fee", MessagePauseValidatorFeeOwner, 10000),
+	amountParam(MessageUnpauseValidatorFee, "message_unpause_validator_fee", MessageUnpauseValidatorFeeOwner, 10000),
+	amountParam(MessageStakeServiceNodeFee, "message_stake_service_node_fee", MessageStakeServiceNodeFeeOwner, 10000),
+	amountParam(MessageEditStakeServiceNodeFee, "message_edit_stake_service_node_fee", MessageEditStakeServiceNodeFeeOwner, 10000),
+	amountParam(MessageUnstakeServiceNodeFee, "message_unstake_service_node_fee", MessageUnstakeServiceNodeFeeOwner, 10000),
+	amountParam(MessagePauseServiceNodeFee, "message_pause_service_node_fee", MessagePauseServiceNodeFeeOwner, 10000),
+	amountParam(MessageUnpauseServiceNodeFee, "message_unpause_service_node_fee", MessageUnpauseServiceNodeFeeOwner, 10000),
+	amountParam(MessageChangeParameterFee, "message_change_parameter_fee", MessageChangeParameterFeeOwner, 10000),
+	int32Param(GovVotingPeriodBlocksParamName, "gov_voting_period_blocks", GovVotingPeriodBlocksOwner, 1008, 1, math.MaxInt32),
+	amountParam(GovMinimumDepositParamName, "gov_minimum_deposit", GovMinimumDepositOwner, 1000000000),
+	int32Param(GovQuorumPercentageParamName, "gov_quorum_percentage", GovQuorumPercentageOwner, 33, 0, 100),
+	int32Param(GovPassThresholdPercentageParamName, "gov_pass_threshold_percentage", GovPassThresholdPercentageOwner, 50, 0, 100),
+	amountParam(MessageSubmitProposalFee, "message_submit_proposal_fee", MessageSubmitProposalFeeOwner, 10000),
+	amountParam(MessageVoteProposalFee, "message_vote_proposal_fee", MessageVoteProposalFeeOwner, 10000),
+	int32Param(DoubleSignReporterRewardPercentageParamName, "double_sign_reporter_reward_percentage", DoubleSignReporterRewardPercentageOwner, 10, 0, 100),
+	int32Param(FeeOwnerPercentageOfFeesParamName, "fee_owner_percentage_of_fees", FeeOwnerPercentageOfFeesOwner, 10, 0, 100),
+	amountParam(BlockRewardAmountParamName, "block_reward_amount", BlockRewardAmountOwner, 0),
+	int32Param(BlockRewardProposerPercentageParamName, "block_reward_proposer_percentage", BlockRewardProposerPercentageOwner, 10, 0, 100),
+	int32Param(BlockRewardValidatorsPercentageParamName, "block_reward_validators_percentage", BlockRewardValidatorsPercentageOwner, 40, 0, 100),
+	int32Param(BlockRewardServiceNodesPercentageParamName, "block_reward_service_nodes_percentage", BlockRewardServiceNodesPercentageOwner, 40, 0, 100),
+	amountParam(MessagePartialUnstakeAppFee, "message_partial_unstake_app_fee", MessagePartialUnstakeAppFeeOwner, 10000),
+	amountParam(MessagePartialUnstakeServiceNodeFee, "message_partial_unstake_service_node_fee", MessagePartialUnstakeServiceNodeFeeOwner, 10000),
+	amountParam(MessagePartialUnstakeFishermanFee, "message_partial_unstake_fisherman_fee", MessagePartialUnstakeFishermanFeeOwner, 10000),
+	amountParam(MessagePartialUnstakeValidatorFee, "message_partial_unstake_validator_fee", MessagePartialUnstakeValidatorFeeOwner, 10000),
+	amountParam(MessageDelegateFee, "message_delegate_fee", MessageDelegateFeeOwner, 10000),
+	amountParam(MessageUndelegateFee, "message_undelegate_fee", MessageUndelegateFeeOwner, 10000),
+	amountParam(MessageRedelegateFee, "message_redelegate_fee", MessageRedelegateFeeOwner, 10000),
+	amountParam(MessageCreateVestingAccountFee, "message_create_vesting_account_fee", MessageCreateVestingAccountFeeOwner, 10000),
+	relayChainsParam(RelayChainsParamName, "relay_chains", RelayChainsOwner, DefaultChains),
+	amountParam(MessageUpgradeFee, "message_upgrade_fee", MessageUpgradeFeeOwner, 10000),
+	int32Param(ServiceNodeQoSWindowParamName, "service_node_qos_window", ServiceNodeQoSWindowOwner, 10, 1, math.MaxInt32),
+	int32Param(ServiceNodeMinimumQoSScoreParamName, "service_node_minimum_qos_score", ServiceNodeMinimumQoSScoreOwner, 50, 0, 100),
+	int32Param(ServiceNodeJailBlocksParamName, "service_node_jail_blocks", ServiceNodeJailBlocksOwner, 96, 0, math.MaxInt32),
+	int32Param(ServiceNodeSlashPercentageParamName, "service_node_slash_percentage", ServiceNodeSlashPercentageOwner, 1, 0, 100),
+	int32Param(FishermanBountyPercentageParamName, "fisherman_bounty_percentage", FishermanBountyPercentageOwner, 10, 0, 100),
+	int32Param(ServiceNodeSessionQoSFloorParamName, "service_node_session_qos_floor", ServiceNodeSessionQoSFloorOwner, 20, 1, 100),
+	amountParam(MessageClaimFee, "message_claim_fee", MessageClaimFeeOwner, 10000),
+
+	ownerParam(AclOwner, "acl_owner"),
+	ownerParam(BlocksPerSessionOwner, "blocks_per_session_owner"),
+	ownerParam(AppMinimumStakeOwner, "app_minimum_stake_owner"),
+	ownerParam(AppMaxChainsOwner, "app_max_chains_owner"),
+	ownerParam(AppBaselineStakeRateOwner, "app_baseline_stake_rate_owner"),
+	ownerParam(AppStakingAdjustmentOwner, "app_staking_adjustment_owner"),
+	ownerParam(AppUnstakingBlocksOwner, "app_unstaking_blocks_owner"),
+	ownerParam(AppMinimumPauseBlocksOwner, "app_minimum_pause_blocks_owner"),
+	ownerParam(AppMaxPausedBlocksOwner, "app_max_paused_blocks_owner"),
+	ownerParam(ServiceNodeMinimumStakeOwner, "service_node_minimum_stake_owner"),
+	ownerParam(ServiceNodeMaxChainsOwner, "service_node_max_chains_owner"),
+	ownerParam(ServiceNodeUnstakingBlocksOwner, "service_node_unstaking_blocks_owner"),
+	ownerParam(ServiceNodeMinimumPauseBlocksOwner, "service_node_minimum_pause_blocks_owner"),
+	ownerParam(ServiceNodeMaxPausedBlocksOwner, "service_node_max_paused_blocks_owner"),
+	ownerParam(ServiceNodesPerSessionOwner, "service_nodes_per_session_owner"),
+	ownerParam(FishermanMinimumStakeOwner, "fisherman_minimum_stake_owner"),
+	ownerParam(FishermanMaxChainsOwner, "fisherman_max_chains_owner"),
+	ownerParam(FishermanUnstakingBlocksOwner, "fisherman_unstaking_blocks_owner"),
+	ownerParam(FishermanMinimumPauseBlocksOwner, "fisherman_minimum_pause_blocks_owner"),
+	ownerParam(FishermanMaxPausedBlocksOwner, "fisherman_max_paused_blocks_owner"),
+	ownerParam(ValidatorMinimumStakeOwner, "validator_minimum_stake_owner"),
+	ownerParam(ValidatorUnstakingBlocksOwner, "validator_unstaking_blocks_owner"),
+	ownerParam(ValidatorMinimumPauseBlocksOwner, "validator_minimum_pause_blocks_owner"),
+	ownerParam(ValidatorMaxPausedBlocksOwner, "validator_max_paused_blocks_owner"),
+	ownerParam(ValidatorMaximumMissedBlocksOwner, "validator_maximum_missed_blocks_owner"),
+	ownerParam(ValidatorMaxEvidenceAgeInBlocksOwner, "validator_max_evidence_age_in_blocks_owner"),
+	ownerParam(ProposerPercentageOfFeesOwner, "proposer_percentage_of_fees_owner"),
+	ownerParam(MissedBlocksBurnPercentageOwner, "missed_blocks_burn_percentage_owner"),
+	ownerParam(DoubleSignBurnPercentageOwner, "double_sign_burn_percentage_owner"),
+	ownerParam(MessageDoubleSignFeeOwner, "message_double_sign_fee_owner"),
+	ownerParam(MessageSendFeeOwner, "message_send_fee_owner"),
+	ownerParam(MessageStakeFishermanFeeOwner, "message_stake_fisherman_fee_owner"),
+	ownerParam(MessageEditStakeFishermanFeeOwner, "message_edit_stake_fisherman_fee_owner"),
+	ownerParam(MessageUnstakeFishermanFeeOwner, "message_unstake_fisherman_fee_owner"),
+	ownerParam(MessagePauseFishermanFeeOwner, "message_pause_fisherman_fee_owner"),
+	ownerParam(MessageUnpauseFishermanFeeOwner, "message_unpause_fisherman_fee_owner"),
+	ownerParam(MessageFishermanPauseServiceNodeFeeOwner, "message_fisherman_pause_service_node_fee_owner"),
+	ownerParam(MessageTestScoreFeeOwner, "message_test_score_fee_owner"),
+	ownerParam(MessageProveTestScoreFeeOwner, "message_prove_test_score_fee_owner"),
+	ownerParam(MessageStakeAppFeeOwner, "message_stake_app_fee_owner"),
+	ownerParam(MessageEditStakeAppFeeOwner, "message_edit_stake_app_fee_owner"),
+	ownerParam(MessageUnstakeAppFeeOwner, "message_unstake_app_fee_owner"),
+	ownerParam(MessagePauseAppFeeOwner, "message_pause_app_fee_owner"),
+	ownerParam(MessageUnpauseAppFeeOwner, "message_unpause_app_fee_owner"),
+	ownerParam(MessageStakeValidatorFeeOwner, "message_stake_validator_fee_owner"),
+	ownerParam(MessageEditStakeValidatorFeeOwner, "message_edit_stake_validator_fee_owner"),
+	ownerParam(MessageUnstakeValidatorFeeOwner, "message_unstake_validator_fee_owner"),
+	ownerParam(MessagePauseValidatorFeeOwner, "message_pause_validator_fee_owner"),
+	ownerParam(MessageUnpauseValidatorFeeOwner, "message_unpause_validator_fee_owner"),
+	ownerParam(MessageStakeServiceNodeFeeOwner, "message_stake_service_node_fee_owner"),
+	ownerParam(MessageEditStakeServiceNodeFeeOwner, "message_edit_stake_service_node_fee_owner"),
+	ownerParam(MessageUnstakeServiceNodeFeeOwner, "message_unstake_service_node_fee_owner"),
+	ownerParam(MessagePauseServiceNodeFeeOwner, "message_pause_service_node_fee_owner"),
+	ownerParam(MessageUnpauseServiceNodeFeeOwner, "message_unpause_service_node_fee_owner"),
+	ownerParam(MessageChangeParameterFeeOwner, "message_change_parameter_fee_owner"),
+	ownerParam(GovVotingPeriodBlocksOwner, "gov_voting_period_blocks_owner"),
+	ownerParam(GovMinimumDepositOwner, "gov_minimum_deposit_owner"),
+	ownerParam(GovQuorumPercentageOwner, "gov_quorum_percentage_owner"),
+	ownerParam(GovPassThresholdPercentageOwner, "gov_pass_threshold_percentage_owner"),
+	ownerParam(MessageSubmitProposalFeeOwner, "message_submit_proposal_fee_owner"),
+	ownerParam(MessageVoteProposalFeeOwner, "message_vote_proposal_fee_owner"),
+	ownerParam(DoubleSignReporterRewardPercentageOwner, "double_sign_reporter_reward_percentage_owner"),
+	ownerParam(FeeOwnerPercentageOfFeesOwner, "fee_owner_percentage_of_fees_owner"),
+	ownerParam(BlockRewardAmountOwner, "block_reward_amount_owner"),
+	ownerParam(BlockRewardProposerPercentageOwner, "block_reward_proposer_percentage_owner"),
+	ownerParam(BlockRewardValidatorsPercentageOwner, "block_reward_validators_percentage_owner"),
+	ownerParam(BlockRewardServiceNodesPercentageOwner, "block_reward_service_nodes_percentage_owner"),
+	ownerParam(MessagePartialUnstakeAppFeeOwner, "message_partial_unstake_app_fee_owner"),
+	ownerParam(MessagePartialUnstakeServiceNodeFeeOwner, "message_partial_unstake_service_node_fee_owner"),
+	ownerParam(MessagePartialUnstakeFishermanFeeOwner, "message_partial_unstake_fisherman_fee_owner"),
+	ownerParam(MessagePartialUnstakeValidatorFeeOwner, "message_partial_unstake_validator_fee_owner"),
+	ownerParam(MessageDelegateFeeOwner, "message_delegate_fee_owner"),
+	ownerParam(MessageUndelegateFeeOwner, "message_undelegate_fee_owner"),
+	ownerParam(MessageRedelegateFeeOwner, "message_redelegate_fee_owner"),
+	ownerParam(MessageCreateVestingAccountFeeOwner, "message_create_vesting_account_fee_owner"),
+	ownerParam(RelayChainsOwner, "relay_chains_owner"),
+	ownerParam(MessageUpgradeFeeOwner, "message_upgrade_fee_owner"),
+	ownerParam(UpgradeOwner, "upgrade_owner"),
+	ownerParam(ServiceNodeQoSWindowOwner, "service_node_qos_window_owner"),
+	ownerParam(ServiceNodeMinimumQoSScoreOwner, "service_node_minimum_qos_score_owner"),
+	ownerParam(ServiceNodeJailBlocksOwner, "service_node_jail_blocks_owner"),
+	ownerParam(ServiceNodeSlashPercentageOwner, "service_node_slash_percentage_owner"),
+	ownerParam(FishermanBountyPercentageOwner, "fisherman_bounty_percentage_owner"),
+	ownerParam(ServiceNodeSessionQoSFloorOwner, "service_node_session_qos_floor_owner"),
+	ownerParam(MessageClaimFeeOwner, "message_claim_fee_owner"),
+}
+
+var paramsByKey = func() map[string]*Param {
+	m := make(map[string]*Param, len(ParamRegistry))
+	for _, param := range ParamRegistry {
+		m[param.Key] = param
+	}
+	return m
+}()
+
+func ParamByKey(key string) (param *Param, found bool) {
+	param, found = paramsByKey[key]
+	return
+}
+
+// Get returns a copy of the value of the param in `params` wrapped in the param's proto wrapper type
+func (p *Param) Get(params *Params) proto.Message {
+	value := p.Default.ProtoReflect().New()
+	copyField(value, wrapperValueField(value), params.ProtoReflect(), p.field(params))
+	return value.Interface()
+}
+
+// Set validates `value` and, if valid, stores it in `params`
+func (p *Param) Set(params *Params, value proto.Message) types.Error {
+	if value == nil || value.ProtoReflect().Descriptor().FullName() != p.Default.ProtoReflect().Descriptor().FullName() {
+		return types.ErrInvalidParamValue(value, p.Default)
+	}
+	if err := p.Validate(value); err != nil {
+		return err
+	}
+	v := value.ProtoReflect()
+	copyField(params.ProtoReflect(), p.field(params), v, wrapperValueField(v))
+	return nil
+}
+
+// Apply is Set followed by ValidateParamLimits, for a change to params that already hold the values of the other
+// params
+func (p *Param) Apply(params *Params, value proto.Message) types.Error {
+	if err := p.Set(params, value); err != nil {
+		return err
+	}
+	return ValidateParamLimits(params)
+}
+
+func (p *Param) field(params *Params) protoreflect.FieldDescriptor {
+	return params.ProtoReflect().Descriptor().Fields().ByName(p.Field)
+}
+
+// ValidateParams checks every registered param value in `params`, e.g. the params of a genesis file
+func ValidateParams(params *Params) types.Error {
+	for _, param := range ParamRegistry {
+		if err := param.Validate(param.Get(params)); err != nil {
+			return err
+		}
+	}
+	return ValidateParamLimits(params)
+}
+
+// ValidateParamLimits checks the limits that span several params, which the validation of a single param can't see
+func ValidateParamLimits(params *Params) types.Error {
+	proposer, validators, serviceNodes := params.GetBlockRewardProposerPercentage(), params.GetBlockRewardValidatorsPercentage(), params.GetBlockRewardServiceNodesPercentage()
+	if proposer+validators+serviceNodes > 100 {
+		return types.ErrInvalidRewardSplit(int(proposer), int(validators), int(serviceNodes))
+	}
+	if proposerOfFees, feeOwnerOfFees := params.GetProposerPercentageOfFees(), params.GetFeeOwnerPercentageOfFees(); proposerOfFees+feeOwnerOfFees > 100 {
+		return types.ErrInvalidFeePercentages(int(proposerOfFees), int(feeOwnerOfFees))
+	}
+	return nil
+}
+
+func DefaultParams() *Params {
+	params := &Params{}
+	for _, param := range ParamRegistry {
+		v := param.Default.ProtoReflect()
+		copyField(params.ProtoReflect(), param.field(params), v, wrapperValueField(v))
+	}
+	return params
+}
+
+// all of the well known wrapper types hold their value in the field `value`
+func wrapperValueField(wrapper protoreflect.Message) protoreflect.FieldDescriptor {
+	return wrapper.Descriptor().Fields().ByName("value")
+}
+
+// copyField sets the field `toField` of `to` to the value of the field `fromField` of `from`. A list is copied element
+// by element, as a list can't be shared between messages and an empty list read from a message is read-only
+func copyField(to protoreflect.Message, toField protoreflect.FieldDescriptor, from protoreflect.Message, fromField protoreflect.FieldDescriptor) {
+	if !fromField.IsList() {
+		to.Set(toField, from.Get(fromField))
+		return
+	}
+	to.Clear(toField)
+	list := from.Get(fromField).List()
+	if list.Len() == 0 {
+		return
+	}
+	copied := to.Mutable(toField).List()
+	for i := 0; i < list.Len(); i++ {
+		element := list.Get(i)
+		if fromField.Message() != nil {
+			element = protoreflect.ValueOfMessage(proto.Clone(element.Message().Interface()).ProtoReflect())
+		}
+		copied.Append(element)
+	}
+}
+
+func int32Param(key string, field protoreflect.Name, owner string, defaultValue, min, max int32) *Param {
+	return &Param{
+		Key:     key,
+		Field:   field,
+		Owner:   owner,
+		Default: wrapperspb.Int32(defaultValue),
+		Validate: func(value proto.Message) types.Error {
+			if v := value.(*wrapperspb.Int32Value).Value; v < min || v > max {
+				return types.ErrParamOutOfBounds(key, int64(v), int64(min), int64(max))
+			}
+			return nil
+		},
+	}
+}
+
+func amountParam(key string, field protoreflect.Name, owner string, defaultValue int64) *Param {
+	return &Param{
+		Key:     key,
+		Field:   field,
+		Owner:   owner,
+		Default: wrapperspb.String(types.BigIntToString(big.NewInt(defaultValue))),
+		Validate: func(value proto.Message) types.Error {
+			amount, err := types.StringToBigInt(value.(*wrapperspb.StringValue).Value)
+			if err != nil {
+				return err
+			}
+			if amount.Sign() == -1 {
+				return types.ErrNegativeAmountError()
+			}
+			return nil
+		},
+	}
+}
+
+func ownerParam(key string, field protoreflect.Name) *Param {
+	return &Param{
+		Key:     key,
+		Field:   field,
+		Owner:   AclOwner,
+		Default: wrapperspb.Bytes(DefaultParamsOwner.Address()),
+		Validate: func(value proto.Message) types.Error {
+			if owner := value.(*wrapperspb.BytesValue).Value; len(owner) != crypto.AddressLen {
+				return types.ErrInvalidAddressLen(crypto.ErrInvalidAddressLen(len(owner)))
+			}
+			return nil
+		},
+	}
+}
+
+// RelayChainLength is the length of a relay chain id; it strikes a balance between combination possibilities & storage
+const RelayChainLength = 4
+
+// relayChainsParam registers `chains` as enabled by default; every id of the registry must be valid and unique
+func relayChainsParam(key string, field protoreflect.Name, owner string, chains []string) *Param {
+	defaultValue := &RelayChains{}
+	for _, chain := range chains {
+		defaultValue.Value = append(defaultValue.Value, &RelayChain{Id: chain, Enabled: true})
+	}
+	return &Param{
+		Key:     key,
+		Field:   field,
+		Owner:   owner,
+		Default: defaultValue,
+		Validate: func(value proto.Message) types.Error {
+			// the stake messages check their chains against the registry, so an empty one would halt staking
+			if len(value.(*RelayChains).Value) == 0 {
+				return types.ErrEmptyRelayChains()
+			}
+			ids := make(map[string]bool)
+			for _, chain := range value.(*RelayChains).Value {
+				if chain.Id == "" {
+					return types.ErrEmptyRelayChain()
+				}
+				if len(chain.Id) != RelayChainLength {
+					return types.ErrInvalidRelayChainLength(len(chain.Id), RelayChainLength)
+				}
+				if ids[chain.Id] {
+					return types.ErrDuplicateRelayChain(chain.Id)
+				}
+				ids[chain.Id] = true
+			}
+			return nil
+		},
+	}
+}
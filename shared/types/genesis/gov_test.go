@@ -0,0 +1,70 @@
+package genesis
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestParamRegistryCoversParams(t *testing.T) {
+	fields := (&Params{}).ProtoReflect().Descriptor().Fields()
+	registered := make(map[protoreflect.Name]bool, len(ParamRegistry))
+	for _, param := range ParamRegistry {
+		require.False(t, registered[param.Field], "field %s registered twice", param.Field)
+		registered[param.Field] = true
+		field := fields.ByName(param.Field)
+		require.NotNil(t, field, "param %s has no field %s", param.Key, param.Field)
+		wrapper := param.Default.ProtoReflect()
+		require.Equal(t, field.Kind(), wrapper.Descriptor().Fields().ByName("value").Kind(), "param %s", param.Key)
+		_, found := ParamByKey(param.Owner)
+		require.True(t, found, "owner %s of param %s is not registered", param.Owner, param.Key)
+	}
+	require.Equal(t, fields.Len(), len(registered))
+}
+
+func TestDefaultParamsAreValid(t *testing.T) {
+	params := DefaultParams()
+	require.Nil(t, ValidateParams(params))
+	for _, param := range ParamRegistry {
+		require.True(t, param.Get(params).ProtoReflect().Equal(param.Default.ProtoReflect()), "param %s", param.Key)
+	}
+}
+
+func TestValidateParamLimits(t *testing.T) {
+	params := DefaultParams()
+	param, found := ParamByKey(BlockRewardValidatorsPercentageParamName)
+	require.True(t, found)
+	// each value is within its own bounds, but the reward split adds up to more than 100
+	require.Nil(t, param.Set(params, wrapperspb.Int32(60)))
+	require.Equal(t, types.CodeInvalidRewardSplitError, ValidateParams(params).Code())
+	params = DefaultParams()
+	require.Equal(t, types.CodeInvalidRewardSplitError, param.Apply(params, wrapperspb.Int32(60)).Code())
+	require.Nil(t, param.Apply(params, wrapperspb.Int32(50)))
+	param, found = ParamByKey(FeeOwnerPercentageOfFeesParamName)
+	require.True(t, found)
+	require.Equal(t, types.CodeInvalidFeePercentagesError, param.Apply(params, wrapperspb.Int32(91)).Code())
+	require.Nil(t, param.Apply(params, wrapperspb.Int32(90)))
+}
+
+func TestRelayChainsParamValidate(t *testing.T) {
+	param, found := ParamByKey(RelayChainsParamName)
+	require.True(t, found)
+	params := DefaultParams()
+	valid := &RelayChains{Value: []*RelayChain{{Id: "0001", Enabled: true}, {Id: "0002", Description: "disabled"}}}
+	require.Nil(t, param.Set(params, valid))
+	require.True(t, param.Get(params).ProtoReflect().Equal(valid.ProtoReflect()))
+	duplicate := &RelayChains{Value: []*RelayChain{{Id: "0001"}, {Id: "0001"}}}
+	require.Equal(t, types.CodeDuplicateRelayChainError, param.Set(params, duplicate).Code())
+	invalid := &RelayChains{Value: []*RelayChain{{Id: "001"}}}
+	require.Equal(t, types.CodeInvalidRelayChainLengthError, param.Set(params, invalid).Code())
+	require.Equal(t, types.CodeEmptyRelayChainsError, param.Set(params, &RelayChains{}).Code())
+	// the params don't share the list with the value set or the value returned
+	valid.Value[0].Enabled = false
+	got := param.Get(params).(*RelayChains)
+	require.True(t, got.Value[0].Enabled)
+	got.Value[1].Enabled = true
+	require.False(t, param.Get(params).(*RelayChains).Value[1].Enabled)
+}
@@ -100,6 +100,14 @@ func (ps *NodeState) loadStateFromGenesis(cfg *config.Config) {
 			AppHash:      genesis.AppHash,
 			ValidatorMap: ValidatorListToMap(genesisState.Validators),
 		}
+	} else if genesis.GenesisState != nil {
+		log.Println("Loading state from `genesis_state`")
+		*ps = NodeState{
+			GenesisState: genesis.GenesisState,
+			BlockHeight:  0,
+			AppHash:      genesis.AppHash,
+			ValidatorMap: ValidatorListToMap(genesis.GenesisState.Validators),
+		}
 	} else {
 		log.Println("Loading state from json file data")
 		*ps = NodeState{
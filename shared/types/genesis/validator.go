@@ -3,6 +3,9 @@ package genesis
 import (
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
 )
 
 // TECHDEBT(olshansky): This is a wrapper around the generated `Validator.go`
@@ -18,6 +21,9 @@ type ValidatorJsonCompatibleWrapper struct {
 	PausedHeight    uint64  `json:"paused_height,omitempty"`
 	UnstakingHeight int64   `json:"unstaking_height,omitempty"`
 	Output          HexData `json:"output,omitempty"`
+	Commission      int32   `json:"commission,omitempty"`
+	DelegatedTokens string  `json:"delegated_tokens,omitempty"`
+	DelegatorShares string  `json:"delegator_shares,omitempty"`
 }
 
 type HexData []byte
@@ -51,6 +57,9 @@ func (v *ValidatorJsonCompatibleWrapper) Validator() *Validator {
 		PausedHeight:    v.PausedHeight,
 		UnstakingHeight: v.UnstakingHeight,
 		Output:          v.Output,
+		Commission:      v.Commission,
+		DelegatedTokens: v.DelegatedTokens,
+		DelegatorShares: v.DelegatorShares,
 	}
 }
 
@@ -68,7 +77,42 @@ func GetValidators(vals []*ValidatorJsonCompatibleWrapper) (validators []*Valida
 			PausedHeight:    v.PausedHeight,
 			UnstakingHeight: v.UnstakingHeight,
 			Output:          v.Output,
+			Commission:      v.Commission,
+			DelegatedTokens: v.DelegatedTokens,
+			DelegatorShares: v.DelegatorShares,
 		}
 	}
 	return
 }
+
+// GetDelegation returns the tokens delegated to the validator and the shares issued for them, which are both zero
+// for a validator without delegators
+func (v *Validator) GetDelegation() (tokens, shares *big.Int, err types.Error) {
+	if tokens, err = amountOrZero(v.DelegatedTokens); err != nil {
+		return nil, nil, err
+	}
+	if shares, err = amountOrZero(v.DelegatorShares); err != nil {
+		return nil, nil, err
+	}
+	return tokens, shares, nil
+}
+
+// GetVotingPower returns the stake of the operator plus the tokens delegated to the validator
+func (v *Validator) GetVotingPower() (*big.Int, types.Error) {
+	stake, err := types.StringToBigInt(v.StakedTokens)
+	if err != nil {
+		return nil, err
+	}
+	delegated, _, err := v.GetDelegation()
+	if err != nil {
+		return nil, err
+	}
+	return stake.Add(stake, delegated), nil
+}
+
+func amountOrZero(amount string) (*big.Int, types.Error) {
+	if amount == types.EmptyString {
+		return big.NewInt(0), nil
+	}
+	return types.StringToBigInt(amount)
+}
@@ -33,6 +33,53 @@ func StringToBigInt(s string) (*big.Int, Error) {
 	return i, nil
 }
 
+// Token amounts must be calculated identically by every node, so the fractional math on them is done on integers
+// instead of floating point numbers, with a single rounding step at the end
+
+// MulDiv returns `x * numerator / denominator` rounded down (towards negative infinity). The product is exact, so
+// the result never overflows and never depends on the order of the operations. `denominator` must be positive
+func MulDiv(x *big.Int, numerator, denominator int64) *big.Int {
+	result := new(big.Int).Mul(x, big.NewInt(numerator))
+	// Div is Euclidean division, which rounds down for a positive denominator
+	return result.Div(result, big.NewInt(denominator))
+}
+
+// PercentageOf returns `percentage`% of `x` rounded down
+func PercentageOf(x *big.Int, percentage int) *big.Int {
+	return MulDiv(x, int64(percentage), 100)
+}
+
+// ProRata splits `amount` between the `weights` proportionally, rounding every share down, and returns the shares
+// along with the undistributed remainder. If the weights add up to zero, the whole amount is the remainder
+func ProRata(amount *big.Int, weights []*big.Int) (shares []*big.Int, remainder *big.Int) {
+	totalWeight := big.NewInt(0)
+	for _, weight := range weights {
+		totalWeight.Add(totalWeight, weight)
+	}
+	shares = make([]*big.Int, len(weights))
+	remainder = new(big.Int).Set(amount)
+	for i, weight := range weights {
+		shares[i] = big.NewInt(0)
+		if totalWeight.Sign() <= 0 {
+			continue
+		}
+		shares[i].Mul(amount, weight).Div(shares[i], totalWeight)
+		remainder.Sub(remainder, shares[i])
+	}
+	return shares, remainder
+}
+
+// BoundBigInt returns `x` limited to the range [lower, upper]
+func BoundBigInt(x, lower, upper *big.Int) *big.Int {
+	if x.Cmp(lower) < 0 {
+		return new(big.Int).Set(lower)
+	}
+	if x.Cmp(upper) > 0 {
+		return new(big.Int).Set(upper)
+	}
+	return x
+}
+
 func BigIntToString(b *big.Int) string {
 	return b.Text(10)
 }
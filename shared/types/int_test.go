@@ -1,7 +1,9 @@
 package types
 
 import (
+	"math"
 	"math/big"
+	"math/rand"
 	"testing"
 )
 
@@ -16,3 +18,91 @@ func TestBigIntToString(t *testing.T) {
 		t.Fatal("unequal after conversion")
 	}
 }
+
+func TestMulDivOverflow(t *testing.T) {
+	// amounts far beyond int64 and float64 precision are calculated exactly
+	x := new(big.Int).Lsh(big.NewInt(1), 300)
+	x.Add(x, big.NewInt(1))
+	got := MulDiv(x, math.MaxInt64, math.MaxInt64)
+	if got.Cmp(x) != 0 {
+		t.Fatalf("unexpected result: expected %v got %v", x, got)
+	}
+	got = MulDiv(x, 3, 2)
+	expected := new(big.Int).Mul(x, big.NewInt(3))
+	expected.Rsh(expected, 1)
+	if got.Cmp(expected) != 0 {
+		t.Fatalf("unexpected result: expected %v got %v", expected, got)
+	}
+}
+
+func TestMulDivRounding(t *testing.T) {
+	tests := []struct {
+		x, numerator, denominator, expected int64
+	}{
+		{7, 1, 2, 3},
+		{-7, 1, 2, -4}, // rounds down rather than towards zero
+		{99, 1, 100, 0},
+		{100, 1, 100, 1},
+		{0, 5, 3, 0},
+	}
+	for _, test := range tests {
+		if got := MulDiv(big.NewInt(test.x), test.numerator, test.denominator); got.Int64() != test.expected {
+			t.Fatalf("MulDiv(%d, %d, %d): expected %d got %v", test.x, test.numerator, test.denominator, test.expected, got)
+		}
+	}
+}
+
+func TestPercentageOfProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	limit := new(big.Int).Lsh(big.NewInt(1), 200)
+	for i := 0; i < 1000; i++ {
+		x := new(big.Int).Rand(r, limit)
+		percentage := r.Intn(101)
+		got := PercentageOf(x, percentage)
+		// got is the largest integer with got * 100 <= x * percentage
+		exact := new(big.Int).Mul(x, big.NewInt(int64(percentage)))
+		scaled := new(big.Int).Mul(got, big.NewInt(100))
+		if scaled.Cmp(exact) > 0 || scaled.Add(scaled, big.NewInt(100)).Cmp(exact) <= 0 {
+			t.Fatalf("%d%% of %v is not rounded down, got %v", percentage, x, got)
+		}
+		// splitting an amount never hands out more than the amount, and loses less than one unit
+		rest := PercentageOf(x, 100-percentage)
+		split := new(big.Int).Add(got, rest)
+		if split.Cmp(x) > 0 || new(big.Int).Sub(x, split).Cmp(big.NewInt(1)) > 0 {
+			t.Fatalf("splitting %v by %d%% hands out %v", x, percentage, split)
+		}
+	}
+}
+
+func TestBoundBigInt(t *testing.T) {
+	lower, upper := big.NewInt(0), big.NewInt(math.MaxInt64)
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 100)
+	if got := BoundBigInt(tooLarge, lower, upper); got.Cmp(upper) != 0 {
+		t.Fatalf("expected %v got %v", upper, got)
+	}
+	if got := BoundBigInt(big.NewInt(-1), lower, upper); got.Cmp(lower) != 0 {
+		t.Fatalf("expected %v got %v", lower, got)
+	}
+	if got := BoundBigInt(big.NewInt(5), lower, upper); got.Int64() != 5 {
+		t.Fatalf("expected 5 got %v", got)
+	}
+}
+
+func TestProRata(t *testing.T) {
+	weights := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(0), big.NewInt(3)}
+	shares, remainder := ProRata(big.NewInt(100), weights)
+	// 100/6 = 16.67, 33.33, 0 and 50 rounded down, with 1 left over
+	for i, expected := range []int64{16, 33, 0, 50} {
+		if shares[i].Int64() != expected {
+			t.Fatalf("unexpected share %d: expected %d got %v", i, expected, shares[i])
+		}
+	}
+	if remainder.Int64() != 1 {
+		t.Fatalf("unexpected remainder: expected 1 got %v", remainder)
+	}
+	// nothing is handed out without any weight
+	shares, remainder = ProRata(big.NewInt(100), []*big.Int{big.NewInt(0)})
+	if shares[0].Sign() != 0 || remainder.Int64() != 100 {
+		t.Fatalf("expected the whole amount as the remainder, got shares %v and remainder %v", shares, remainder)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"container/list"
 	"encoding/hex"
+	"math/big"
 	"sync"
 
 	"github.com/pokt-network/pocket/shared/crypto"
@@ -13,6 +14,7 @@ type Mempool interface {
 	Contains(hash string) bool
 	AddTransaction(tx []byte) Error
 	DeleteTransaction(tx []byte) Error
+	GetTransactions() [][]byte
 
 	Clear()
 	Size() int
@@ -22,20 +24,30 @@ type Mempool interface {
 
 var _ Mempool = &FIFOMempool{}
 
+// FIFOMempool keeps the transactions in arrival order, unless it is created with a priority function
+// (see NewPriorityMempool), in which case the transactions are kept in descending order of priority and
+// in arrival order among equal priorities. When full, a FIFO mempool drops the oldest transaction while
+// a priority mempool drops the one with the lowest priority.
 type FIFOMempool struct {
 	l                    sync.RWMutex
-	hashMap              map[string]struct{}
+	hashMap              map[string]*list.Element
 	pool                 *list.List
 	size                 int
 	transactionBytes     int
 	maxTransactionsBytes int
 	maxTransactions      int
+	priority             func(tx []byte) *big.Int // nil for arrival order
+}
+
+type mempoolTransaction struct {
+	tx       []byte
+	priority *big.Int
 }
 
 func NewMempool(maxTransactionBytes int, maxTransactions int) Mempool {
 	return &FIFOMempool{
 		l:                    sync.RWMutex{},
-		hashMap:              make(map[string]struct{}),
+		hashMap:              make(map[string]*list.Element),
 		pool:                 list.New(),
 		size:                 0,
 		transactionBytes:     0,
@@ -44,23 +56,36 @@ func NewMempool(maxTransactionBytes int, maxTransactions int) Mempool {
 	}
 }
 
+func NewPriorityMempool(maxTransactionBytes int, maxTransactions int, priority func(tx []byte) *big.Int) Mempool {
+	mempool := NewMempool(maxTransactionBytes, maxTransactions).(*FIFOMempool)
+	mempool.priority = priority
+	return mempool
+}
+
 func (f *FIFOMempool) AddTransaction(tx []byte) Error {
 	f.l.Lock()
 	defer f.l.Unlock()
+	// a transaction that can't fit in the mempool on its own would evict every other transaction before itself
+	if len(tx) >= f.maxTransactionsBytes {
+		return ErrTransactionTooLarge(len(tx), f.maxTransactionsBytes)
+	}
 	hash := crypto.SHA3Hash(tx)
 	hashString := hex.EncodeToString(hash)
 	if _, ok := f.hashMap[hashString]; ok {
 		return ErrDuplicateTransaction()
 	}
-	f.pool.PushBack(tx)
-	f.hashMap[hashString] = struct{}{}
+	f.hashMap[hashString] = insertTransaction(f, tx)
 	f.size++
 	f.transactionBytes += len(tx)
 	for f.size >= f.maxTransactions || f.transactionBytes >= f.maxTransactionsBytes {
-		_, err := popTransaction(f)
+		evicted, err := evictTransaction(f)
 		if err != nil {
 			return err
 		}
+		// the transaction itself was dropped, as it is the lowest priority one (or the only one) in the full mempool
+		if bytes.Equal(evicted, tx) {
+			return ErrMempoolFull()
+		}
 	}
 	return nil
 }
@@ -77,22 +102,28 @@ func (f *FIFOMempool) Contains(hash string) bool {
 func (f *FIFOMempool) DeleteTransaction(tx []byte) Error {
 	f.l.Lock()
 	defer f.l.Unlock()
-	var toRemove *list.Element
-	for e := f.pool.Front(); e.Next() != nil; {
-		if bytes.Equal(tx, e.Value.([]byte)) {
-			toRemove = e
-			break
-		}
+	hash := crypto.SHA3Hash(tx)
+	toRemove, ok := f.hashMap[hex.EncodeToString(hash)]
+	if !ok {
+		return nil
 	}
-	if toRemove != nil {
-		_, err := removeTransaction(f, toRemove)
-		if err != nil {
-			return err
-		}
+	if _, err := removeTransaction(f, toRemove); err != nil {
+		return err
 	}
 	return nil
 }
 
+// GetTransactions returns a copy of the transactions in the mempool in the order they would be popped
+func (f *FIFOMempool) GetTransactions() [][]byte {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	transactions := make([][]byte, 0, f.size)
+	for e := f.pool.Front(); e != nil; e = e.Next() {
+		transactions = append(transactions, e.Value.(*mempoolTransaction).tx)
+	}
+	return transactions
+}
+
 func (f *FIFOMempool) PopTransaction() ([]byte, Error) {
 	tx, err := popTransaction(f)
 	if err != nil {
@@ -105,7 +136,7 @@ func (f *FIFOMempool) Clear() {
 	f.l.Lock()
 	defer f.l.Unlock()
 	f.pool = list.New()
-	f.hashMap = make(map[string]struct{})
+	f.hashMap = make(map[string]*list.Element)
 	f.size = 0
 	f.transactionBytes = 0
 }
@@ -126,7 +157,7 @@ func removeTransaction(f *FIFOMempool, e *list.Element) ([]byte, Error) {
 	if f.size == 0 {
 		return nil, nil
 	}
-	txBz := e.Value.([]byte)
+	txBz := e.Value.(*mempoolTransaction).tx
 	txBzLen := len(txBz)
 	f.pool.Remove(e)
 	hash := crypto.SHA3Hash(txBz)
@@ -138,5 +169,34 @@ func removeTransaction(f *FIFOMempool, e *list.Element) ([]byte, Error) {
 }
 
 func popTransaction(f *FIFOMempool) ([]byte, Error) {
-	return removeTransaction(f, f.pool.Front())
+	front := f.pool.Front()
+	if front == nil {
+		return nil, nil
+	}
+	return removeTransaction(f, front)
+}
+
+// insertTransaction places the transaction behind every transaction of the same or a higher priority
+func insertTransaction(f *FIFOMempool, tx []byte) *list.Element {
+	if f.priority == nil {
+		return f.pool.PushBack(&mempoolTransaction{tx: tx})
+	}
+	transaction := &mempoolTransaction{tx: tx, priority: f.priority(tx)}
+	for e := f.pool.Back(); e != nil; e = e.Prev() {
+		if e.Value.(*mempoolTransaction).priority.Cmp(transaction.priority) >= 0 {
+			return f.pool.InsertAfter(transaction, e)
+		}
+	}
+	return f.pool.PushFront(transaction)
+}
+
+func evictTransaction(f *FIFOMempool) ([]byte, Error) {
+	if f.priority == nil {
+		return popTransaction(f)
+	}
+	back := f.pool.Back()
+	if back == nil {
+		return nil, nil
+	}
+	return removeTransaction(f, back)
 }
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+// the priority of the test transactions is their first byte
+func firstBytePriority(tx []byte) *big.Int {
+	return big.NewInt(int64(tx[0]))
+}
+
+func TestPriorityMempool(t *testing.T) {
+	mempool := NewPriorityMempool(1000, 4, firstBytePriority)
+	for _, tx := range [][]byte{{1, 0}, {3, 0}, {2, 0}, {3, 1}} {
+		if err := mempool.AddTransaction(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the mempool is full once it holds `maxTransactions`, so the lowest priority transaction is evicted
+	expected := [][]byte{{3, 0}, {3, 1}, {2, 0}}
+	transactions := mempool.GetTransactions()
+	if len(transactions) != len(expected) {
+		t.Fatalf("unexpected number of transactions; expected %d got %d", len(expected), len(transactions))
+	}
+	for i := range expected {
+		tx, err := mempool.PopTransaction()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(tx, expected[i]) || !bytes.Equal(transactions[i], expected[i]) {
+			t.Fatalf("unexpected transaction at position %d; expected %v got %v", i, expected[i], tx)
+		}
+	}
+}
+
+func TestPriorityMempoolFull(t *testing.T) {
+	mempool := NewPriorityMempool(1000, 3, firstBytePriority)
+	for _, tx := range [][]byte{{3, 0}, {2, 0}} {
+		if err := mempool.AddTransaction(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a transaction of a lower priority than every transaction in the full mempool is evicted right away
+	if err := mempool.AddTransaction([]byte{1, 0}); err == nil || err.Code() != CodeMempoolFullError {
+		t.Fatalf("expected the mempool full error, got %v", err)
+	}
+	if mempool.Contains(hex.EncodeToString(crypto.SHA3Hash([]byte{1, 0}))) || mempool.Size() != 2 {
+		t.Fatal("the evicted transaction is still in the mempool")
+	}
+	// a higher priority transaction evicts the lowest priority one instead
+	if err := mempool.AddTransaction([]byte{4, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if mempool.Contains(hex.EncodeToString(crypto.SHA3Hash([]byte{2, 0}))) || mempool.Size() != 2 {
+		t.Fatal("expected the lowest priority transaction to be evicted")
+	}
+}
+
+func TestMempoolTransactionTooLarge(t *testing.T) {
+	mempool := NewMempool(4, 3)
+	if err := mempool.AddTransaction([]byte{1, 0}); err != nil {
+		t.Fatal(err)
+	}
+	// the oversized transaction is rejected without evicting the transactions already in the mempool
+	if err := mempool.AddTransaction([]byte{2, 0, 0, 0}); err == nil || err.Code() != CodeTransactionTooLargeError {
+		t.Fatalf("expected the transaction too large error, got %v", err)
+	}
+	if !mempool.Contains(hex.EncodeToString(crypto.SHA3Hash([]byte{1, 0}))) || mempool.Size() != 1 {
+		t.Fatal("expected the mempool to keep its transactions")
+	}
+}
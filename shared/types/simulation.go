@@ -0,0 +1,15 @@
+package types
+
+import (
+	"math/big"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// SimulationResult is the outcome of dry-running a transaction against the latest state
+type SimulationResult struct {
+	Result       *anypb.Any     // the `TransactionResult` of the utility module
+	Error        Error          // the error that failed the transaction, if any; also recorded as the result code
+	Fee          *big.Int       // the minimum fee for the message type of the transaction; a higher fee is a tip
+	StateChanges []*StateChange // empty when the ante handler fails; only the fee and the sequence when the message fails
+}
@@ -18,13 +18,16 @@ func (u *UtilityContext) HandleMessageSend(message *typesUtil.MessageSend) types
 	if err != nil {
 		return err
 	}
-	// subtract that amount from the sender
-	fromAccountAmount.Sub(fromAccountAmount, amount)
-	// if they go negative, they don't have sufficient funds
-	// NOTE: we don't use the u.SubtractAccountAmount() function because Utility needs to do this check
-	if fromAccountAmount.Sign() == -1 {
+	// unvested tokens can't be sent
+	spendable, err := u.GetSpendableAmount(message.FromAddress)
+	if err != nil {
+		return err
+	}
+	if spendable.Cmp(amount) < 0 {
 		return types.ErrInsufficientAmountError()
 	}
+	// subtract that amount from the sender
+	fromAccountAmount.Sub(fromAccountAmount, amount)
 	// add the amount to the recipient's account
 	if err := u.AddAccountAmount(message.ToAddress, amount); err != nil {
 		return err
@@ -33,6 +36,12 @@ func (u *UtilityContext) HandleMessageSend(message *typesUtil.MessageSend) types
 	if err := u.SetAccountAmount(message.FromAddress, fromAccountAmount); err != nil {
 		return err
 	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_SEND,
+		Address:   message.FromAddress,
+		Recipient: message.ToAddress,
+		Amount:    message.Amount,
+	})
 	return nil
 }
 
@@ -50,6 +59,23 @@ func (u *UtilityContext) GetAccountAmount(address []byte) (*big.Int, types.Error
 	return types.StringToBigInt(amount)
 }
 
+func (u *UtilityContext) GetAccountSequence(address []byte) (uint64, types.Error) {
+	store := u.Store()
+	sequence, er := store.GetAccountSequence(address)
+	if er != nil {
+		return 0, types.ErrGetSequence(er)
+	}
+	return sequence, nil
+}
+
+func (u *UtilityContext) SetAccountSequence(address []byte, sequence uint64) types.Error {
+	store := u.Store()
+	if er := store.SetAccountSequence(address, sequence); er != nil {
+		return types.ErrSetSequence(er)
+	}
+	return nil
+}
+
 func (u *UtilityContext) AddAccountAmount(address []byte, amountToAdd *big.Int) types.Error {
 	store := u.Store()
 	if err := store.AddAccountAmount(address, types.BigIntToString(amountToAdd)); err != nil {
@@ -127,7 +153,15 @@ func (u *UtilityContext) SetAccountAmount(address []byte, amount *big.Int) types
 	return nil
 }
 
+// SubtractAccountAmount fails if the account can't spend `amountToSubtract` without its unvested tokens
 func (u *UtilityContext) SubtractAccountAmount(address []byte, amountToSubtract *big.Int) types.Error {
+	spendable, err := u.GetSpendableAmount(address)
+	if err != nil {
+		return err
+	}
+	if spendable.Cmp(amountToSubtract) < 0 {
+		return types.ErrInsufficientAmountError()
+	}
 	store := u.Store()
 	if err := store.SubtractAccountAmount(address, types.BigIntToString(amountToSubtract)); err != nil {
 		return types.ErrSetAccountAmount(err)
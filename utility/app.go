@@ -6,6 +6,7 @@ import (
 
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
@@ -35,6 +36,10 @@ func (u *UtilityContext) HandleMessageStakeApp(message *typesUtil.MessageStakeAp
 	if signerAccountAmount.Sign() == -1 {
 		return types.ErrInsufficientAmountError()
 	}
+	// locked tokens may be staked if the stake returns to the vesting account
+	if err := u.checkLockedStake(message.Signer, message.OutputAddress, amount); err != nil {
+		return err
+	}
 	maxChains, err := u.GetAppMaxChains()
 	if err != nil {
 		return err
@@ -43,6 +48,10 @@ func (u *UtilityContext) HandleMessageStakeApp(message *typesUtil.MessageStakeAp
 	if len(message.Chains) > maxChains {
 		return types.ErrMaxChains(maxChains)
 	}
+	// the chains must be enabled in the relay chain registry
+	if err := u.checkRelayChains(message.Chains); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.SetAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -68,6 +77,7 @@ func (u *UtilityContext) HandleMessageStakeApp(message *typesUtil.MessageStakeAp
 	if err := u.InsertApplication(publicKey.Address(), message.PublicKey, message.OutputAddress, maxRelays, message.Amount, message.Chains); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_STAKE, typesUtil.ActorType_ACTOR_TYPE_APP, publicKey.Address(), amount, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -92,6 +102,14 @@ func (u *UtilityContext) HandleMessageEditStakeApp(message *typesUtil.MessageEdi
 	if signerAccountAmount.Sign() == -1 {
 		return types.ErrInsufficientAmountError()
 	}
+	// locked tokens may be staked if the stake returns to the vesting account
+	output, err := u.GetAppOutputAddress(message.Address)
+	if err != nil {
+		return err
+	}
+	if err := u.checkLockedStake(message.Signer, output, amountToAdd); err != nil {
+		return err
+	}
 	maxChains, err := u.GetAppMaxChains()
 	if err != nil {
 		return err
@@ -100,6 +118,10 @@ func (u *UtilityContext) HandleMessageEditStakeApp(message *typesUtil.MessageEdi
 	if len(message.Chains) > maxChains {
 		return types.ErrMaxChains(maxChains)
 	}
+	// the chains must be enabled in the relay chain registry
+	if err := u.checkRelayChains(message.Chains); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.SetAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -117,6 +139,7 @@ func (u *UtilityContext) HandleMessageEditStakeApp(message *typesUtil.MessageEdi
 	if err := u.UpdateApplication(message.Address, maxRelaysToAdd, message.AmountToAdd, message.Chains); err != nil {
 		return err
 	}
+	u.emitActorEvent(typesUtil.EventType_EVENT_TYPE_EDIT_STAKE, typesUtil.ActorType_ACTOR_TYPE_APP, message.Address, amountToAdd)
 	return nil
 }
 
@@ -136,6 +159,59 @@ func (u *UtilityContext) HandleMessageUnstakeApp(message *typesUtil.MessageUnsta
 	if err := u.SetAppUnstakingHeightAndStatus(message.Address, unstakingHeight); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_BEGIN_UNSTAKE, typesUtil.ActorType_ACTOR_TYPE_APP, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
+	return nil
+}
+
+// HandleMessagePartialUnstakeApp lowers the stake of the app, which stays staked, and queues the difference for release
+// to the output address after the unstaking period
+func (u *UtilityContext) HandleMessagePartialUnstakeApp(message *typesUtil.MessagePartialUnstakeApp) types.Error {
+	status, err := u.GetAppStatus(message.Address)
+	if err != nil {
+		return err
+	}
+	// validate is staked
+	if status != typesUtil.StakedStatus {
+		return types.ErrInvalidStatus(status, typesUtil.StakedStatus)
+	}
+	stakedTokens, err := u.GetAppStakedTokens(message.Address)
+	if err != nil {
+		return err
+	}
+	minStake, err := u.GetAppMinimumStake()
+	if err != nil {
+		return err
+	}
+	amount, remaining, err := partialUnstakeAmounts(stakedTokens, message.Amount, minStake)
+	if err != nil {
+		return err
+	}
+	maxRelays, err := u.CalculateAppRelays(types.BigIntToString(remaining))
+	if err != nil {
+		return err
+	}
+	if err := u.SetAppStakedTokensAndMaxRelays(message.Address, remaining, maxRelays); err != nil {
+		return err
+	}
+	unstakingHeight, err := u.CalculateAppUnstakingHeight()
+	if err != nil {
+		return err
+	}
+	output, err := u.GetAppOutputAddress(message.Address)
+	if err != nil {
+		return err
+	}
+	if err := u.QueueUnbondingStake(message.Address, output, nil, amount, unstakingHeight, typesUtil.AppStakePoolName); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_PARTIAL_UNSTAKE,
+		ActorType: typesUtil.ActorType_ACTOR_TYPE_APP,
+		Address:   message.Address,
+		Recipient: output,
+		Pool:      typesUtil.AppStakePoolName,
+		Amount:    types.BigIntToString(amount),
+	})
 	return nil
 }
 
@@ -154,6 +230,15 @@ func (u *UtilityContext) UnstakeAppsThatAreReady() types.Error {
 		if err := u.DeleteApplication(app.GetAddress()); err != nil {
 			return err
 		}
+		u.emitEvent(&typesUtil.Event{
+			Type:        typesUtil.EventType_EVENT_TYPE_UNSTAKE,
+			ActorType:   typesUtil.ActorType_ACTOR_TYPE_APP,
+			Address:     app.GetAddress(),
+			Recipient:   app.GetOutputAddress(),
+			Pool:        typesUtil.AppStakePoolName,
+			Amount:      app.GetStakeAmount(),
+			StatusCause: typesUtil.StatusCause_STATUS_CAUSE_UNSTAKING_PERIOD,
+		})
 	}
 	return nil
 }
@@ -192,6 +277,7 @@ func (u *UtilityContext) HandleMessagePauseApp(message *typesUtil.MessagePauseAp
 	if err := u.SetAppPauseHeight(message.Address, height); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_PAUSE, typesUtil.ActorType_ACTOR_TYPE_APP, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -217,6 +303,7 @@ func (u *UtilityContext) HandleMessageUnpauseApp(message *typesUtil.MessageUnpau
 	if err := u.SetAppPauseHeight(message.Address, typesUtil.HeightNotUsed); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_UNPAUSE, typesUtil.ActorType_ACTOR_TYPE_APP, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -237,27 +324,28 @@ func (u *UtilityContext) CalculateAppRelays(stakedTokens string) (string, types.
 	if err != nil {
 		return typesUtil.EmptyString, err
 	}
-	// convert tokens to float64
-	tokensFloat64 := big.NewFloat(float64(tokens.Int64()))
-	// get the percentage of the baseline stake rate (can be over 100%)
-	basePercentage := big.NewFloat(float64(baseRate) / float64(100))
-	// multiply the two
+	// the baseline stake rate is a percentage (can be over 100%) of the stake in uPOKT
 	// TODO (team) evaluate whether or not we should use micro denomination or not
-	baselineThroughput := basePercentage.Mul(basePercentage, tokensFloat64)
-	// adjust for uPOKT
-	baselineThroughput.Quo(baselineThroughput, big.NewFloat(typesUtil.MillionInt))
+	baselineThroughput := types.MulDiv(tokens, int64(baseRate), 100*typesUtil.MillionInt)
 	// add staking adjustment (can be negative)
-	adjusted := baselineThroughput.Add(baselineThroughput, big.NewFloat(float64(stabilityAdjustment)))
-	// truncate the integer
-	result, _ := adjusted.Int(nil)
-	// bounding Max Amount of relays to maxint64
-	max := big.NewInt(math.MaxInt64)
-	if i := result.Cmp(max); i < -1 {
-		result = max
-	}
+	adjusted := baselineThroughput.Add(baselineThroughput, big.NewInt(int64(stabilityAdjustment)))
+	// bounding Max Amount of relays to [0, maxint64]
+	result := types.BoundBigInt(adjusted, big.NewInt(0), big.NewInt(math.MaxInt64))
 	return types.BigIntToString(result), nil
 }
 
+func (u *UtilityContext) GetApp(address []byte) (*typesGenesis.App, types.Error) {
+	store := u.Store()
+	app, er := store.GetApp(address)
+	if er != nil {
+		return nil, types.ErrGetExists(er)
+	}
+	if app == nil {
+		return nil, types.ErrNotExists()
+	}
+	return app, nil
+}
+
 func (u *UtilityContext) GetAppExists(address []byte) (bool, types.Error) {
 	store := u.Store()
 	exists, er := store.GetAppExists(address)
@@ -313,13 +401,42 @@ func (u *UtilityContext) UnstakeAppsPausedBefore(pausedBeforeHeight int64) types
 	if err != nil {
 		return err
 	}
+	wasUnstaking, err := u.getUnstakingApps()
+	if err != nil {
+		return err
+	}
 	er := store.SetAppsStatusAndUnstakingHeightPausedBefore(pausedBeforeHeight, unstakingHeight, typesUtil.UnstakingStatus)
 	if er != nil {
 		return types.ErrSetStatusPausedBefore(er, pausedBeforeHeight)
 	}
+	unstaking, err := u.getUnstakingApps()
+	if err != nil {
+		return err
+	}
+	u.emitBeginUnstakeEvents(typesUtil.ActorType_ACTOR_TYPE_APP, wasUnstaking, unstaking)
 	return nil
 }
 
+// getUnstakingApps returns the addresses of the unstaking apps, in the order of the store
+func (u *UtilityContext) getUnstakingApps() ([][]byte, types.Error) {
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, types.ErrGetLatestHeight(er)
+	}
+	apps, er := store.GetAllApps(height)
+	if er != nil {
+		return nil, types.ErrGetAllApps(er)
+	}
+	var unstaking [][]byte
+	for _, app := range apps {
+		if app.Status == typesUtil.UnstakingStatus {
+			unstaking = append(unstaking, app.Address)
+		}
+	}
+	return unstaking, nil
+}
+
 func (u *UtilityContext) GetAppStatus(address []byte) (int, types.Error) {
 	store := u.Store()
 	status, er := store.GetAppStatus(address)
@@ -399,6 +516,17 @@ func (u *UtilityContext) GetMessageUnstakeAppSignerCandidates(msg *typesUtil.Mes
 	return candidates, nil
 }
 
+func (u *UtilityContext) GetMessagePartialUnstakeAppSignerCandidates(msg *typesUtil.MessagePartialUnstakeApp) ([][]byte, types.Error) {
+	output, err := u.GetAppOutputAddress(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Address)
+	return candidates, nil
+}
+
 func (u *UtilityContext) GetMessageUnpauseAppSignerCandidates(msg *typesUtil.MessageUnpauseApp) ([][]byte, types.Error) {
 	output, err := u.GetAppOutputAddress(msg.Address)
 	if err != nil {
@@ -429,3 +557,21 @@ func (u *UtilityContext) GetAppOutputAddress(operator []byte) ([]byte, types.Err
 	}
 	return output, nil
 }
+
+func (u *UtilityContext) GetAppStakedTokens(address []byte) (*big.Int, types.Error) {
+	store := u.Store()
+	stakedTokens, er := store.GetAppStakedTokens(address)
+	if er != nil {
+		return nil, types.ErrGetStakedTokens(er)
+	}
+	return types.StringToBigInt(stakedTokens)
+}
+
+func (u *UtilityContext) SetAppStakedTokensAndMaxRelays(address []byte, tokens *big.Int, maxRelays string) types.Error {
+	store := u.Store()
+	er := store.SetAppStakedTokensAndMaxRelays(address, types.BigIntToString(tokens), maxRelays)
+	if er != nil {
+		return types.ErrSetStakedTokens(er)
+	}
+	return nil
+}
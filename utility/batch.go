@@ -0,0 +1,98 @@
+package utility
+
+import (
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// A batch applies several messages atomically under the signature of one transaction. Its fee is the sum of the
+// fees of its messages, each distributed as if the message was sent alone, and its signer must be a valid signer of
+// every message in the state the message is handled in
+
+// applyBatch handles the messages of the batch in order under a single save point, reverting all of them if any fails.
+// The signer of each message is checked right before it is handled, as the messages before it may change who can sign
+// it (e.g. staking an actor that the next message edits)
+func (u *UtilityContext) applyBatch(tx *typesUtil.Transaction, batch *typesUtil.MessageBatch) types.Error {
+	messages, err := batch.Messages()
+	if err != nil {
+		return err
+	}
+	hash, err := tx.Hash()
+	if err != nil {
+		return err
+	}
+	if err := u.NewSavePoint([]byte(batchSavePointPrefix + hash)); err != nil {
+		return err
+	}
+	for _, msg := range messages {
+		err := u.checkSigner(msg, batch.Signer)
+		if err == nil {
+			msg.SetSigner(batch.Signer)
+			err = u.HandleMessage(msg)
+		}
+		if err != nil {
+			if er := u.RevertLastSavePoint(); er != nil {
+				return er
+			}
+			return err
+		}
+	}
+	return u.ReleaseLastSavePoint()
+}
+
+// GetMessageBatchFee returns the sum of the fees of the messages of the batch
+func (u *UtilityContext) GetMessageBatchFee(batch *typesUtil.MessageBatch) (*big.Int, types.Error) {
+	messages, err := batch.Messages()
+	if err != nil {
+		return nil, err
+	}
+	total := big.NewInt(0)
+	for _, msg := range messages {
+		if _, ok := msg.(*typesUtil.MessageBatch); ok {
+			return nil, types.ErrNestedBatch()
+		}
+		fee, err := u.GetFee(msg)
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, fee)
+	}
+	return total, nil
+}
+
+// distributeBatchFee distributes the fee of each message of the batch; the tip goes to the FEE_POOL once
+func (u *UtilityContext) distributeBatchFee(batch *typesUtil.MessageBatch, tip *big.Int) types.Error {
+	messages, err := batch.Messages()
+	if err != nil {
+		return err
+	}
+	for _, msg := range messages {
+		fee, err := u.GetFee(msg)
+		if err != nil {
+			return err
+		}
+		if err := u.DistributeFee(msg, fee, big.NewInt(0)); err != nil {
+			return err
+		}
+	}
+	if err := u.AddPoolAmount(typesUtil.FeePoolName, tip); err != nil {
+		return err
+	}
+	u.emitPoolEvent(typesUtil.EventType_EVENT_TYPE_FEE, typesUtil.FeePoolName, nil, tip)
+	return nil
+}
+
+// GetMessageBatchSignerCandidates returns the signer candidates of the first message of the batch, the only one whose
+// candidates are known before the batch is applied; `applyBatch` checks the signer of every message
+func (u *UtilityContext) GetMessageBatchSignerCandidates(batch *typesUtil.MessageBatch) ([][]byte, types.Error) {
+	messages, err := batch.Messages()
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, types.ErrEmptyBatch()
+	}
+	return u.GetSignerCandidates(messages[0])
+}
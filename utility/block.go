@@ -1,18 +1,31 @@
 package utility
 
 import (
+	"log"
+
+	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
 func (u *UtilityContext) ApplyBlock(latestHeight int64, proposerAddress []byte, transactions [][]byte, lastBlockByzantineValidators [][]byte) ([]byte, error) {
 	u.LatestHeight = latestHeight
+	// the state at the halt height is left as is, to be exported
+	if u.HaltHeight != 0 && latestHeight >= u.HaltHeight {
+		return nil, types.ErrHaltHeight(u.HaltHeight)
+	}
+	// halt before changing the state rather than fork from the nodes running the upgraded protocol; consensus stops
+	// the node on an upgrade required error
+	if err := u.CheckUpgrade(); err != nil {
+		return nil, err
+	}
+	u.Context.Events = make([]*typesUtil.Event, 0)
 	// begin block lifecycle phase
 	if err := u.BeginBlock(lastBlockByzantineValidators); err != nil {
 		return nil, err
 	}
 	// deliver txs lifecycle phase
-	for _, transaction := range transactions {
+	for index, transaction := range transactions {
 		tx, err := typesUtil.TransactionFromBytes(transaction)
 		if err != nil {
 			return nil, err
@@ -20,23 +33,56 @@ func (u *UtilityContext) ApplyBlock(latestHeight int64, proposerAddress []byte,
 		if err := tx.ValidateBasic(); err != nil {
 			return nil, err
 		}
-		if err := u.ApplyTransaction(tx); err != nil {
+		txHash := typesUtil.TransactionHash(transaction)
+		if u.Store().TransactionExists(txHash) {
+			return nil, types.ErrTransactionAlreadyCommitted()
+		}
+		// a failed transaction is indexed with its error code rather than invalidating the block. A transaction that
+		// fails the ante handler is reverted, while one whose message fails still pays its fee and uses up its
+		// sequence, and only the message is reverted
+		if err := u.NewSavePoint(crypto.SHA3Hash(transaction)); err != nil {
+			return nil, err
+		}
+		eventsBefore := len(u.Context.Events)
+		u.Context.TransactionHash = txHash
+		msg, txErr := u.AnteHandleMessage(tx)
+		if txErr == nil {
+			txErr = u.applyMessage(tx, msg)
+			if err := u.ReleaseLastSavePoint(); err != nil {
+				return nil, err
+			}
+		} else if err := u.RevertLastSavePoint(); err != nil {
+			return nil, err
+		}
+		u.Context.TransactionHash = ""
+		if err := u.StoreTransaction(txHash, tx, index, txErr, u.EventsSince(eventsBefore)); err != nil {
 			return nil, err
 		}
-		// TODO: if found, remove transaction from mempool
-		// if err := u.Mempool.DeleteTransaction(tx); err != nil {
-		// 	return nil, err
-		// }
 	}
 	// end block lifecycle phase
 	if err := u.EndBlock(proposerAddress); err != nil {
 		return nil, err
 	}
+	if err := u.StoreBlockEvents(); err != nil {
+		return nil, err
+	}
+	if u.CheckInvariants {
+		report, err := u.CheckTokenInvariants()
+		if err != nil {
+			return nil, err
+		}
+		if !report.Holds() {
+			log.Fatalf("[INVARIANT] tokens were created or destroyed outside of a mint or burn:\n%s", report)
+		}
+	}
 	// return the app hash (consensus module will get the validator set directly
 	return u.GetAppHash()
 }
 
 func (u *UtilityContext) BeginBlock(previousBlockByzantineValidators [][]byte) types.Error {
+	if err := u.ApplyPendingParamChanges(); err != nil {
+		return err
+	}
 	if err := u.HandleByzantineValidators(previousBlockByzantineValidators); err != nil {
 		return err
 	}
@@ -47,9 +93,18 @@ func (u *UtilityContext) EndBlock(proposer []byte) types.Error {
 	if err := u.HandleProposalRewards(proposer); err != nil {
 		return err
 	}
+	if err := u.HandleBlockReward(proposer); err != nil {
+		return err
+	}
+	if err := u.TallyProposals(); err != nil {
+		return err
+	}
 	if err := u.UnstakeActorsThatAreReady(); err != nil {
 		return err
 	}
+	if err := u.ReleaseUnbondingStakes(); err != nil {
+		return err
+	}
 	if err := u.BeginUnstakingMaxPausedActors(); err != nil {
 		return err
 	}
@@ -104,3 +159,13 @@ func (u *UtilityContext) GetBlockHash(height int64) ([]byte, types.Error) {
 	}
 	return hash, nil
 }
+
+// GetChainId returns the chain id of the genesis, which is the network id of the block headers
+func (u *UtilityContext) GetChainId() (string, types.Error) {
+	store := u.Store()
+	chainId, er := store.GetChainId()
+	if er != nil {
+		return "", types.ErrGetChainId(er)
+	}
+	return chainId, nil
+}
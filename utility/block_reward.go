@@ -0,0 +1,142 @@
+package utility
+
+import (
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// HandleBlockReward mints the `BlockRewardAmount` of the block and splits it between the proposer, the validators
+// and the service nodes by their percentages. The validators share their part by voting power and the service nodes
+// by stake, and the DAO receives what is left, including the rounding remainders. The percentages add up to at most
+// 100, which is checked whenever the params change (see `ValidateParamLimits`)
+func (u *UtilityContext) HandleBlockReward(proposer []byte) types.Error {
+	reward, err := u.GetBlockRewardAmount()
+	if err != nil {
+		return err
+	}
+	if reward.Sign() <= 0 {
+		return nil
+	}
+	proposerPercentage, err := u.GetBlockRewardProposerPercentage()
+	if err != nil {
+		return err
+	}
+	validatorsPercentage, err := u.GetBlockRewardValidatorsPercentage()
+	if err != nil {
+		return err
+	}
+	serviceNodesPercentage, err := u.GetBlockRewardServiceNodesPercentage()
+	if err != nil {
+		return err
+	}
+	amountToProposer := types.PercentageOf(reward, proposerPercentage)
+	if err := u.MintToAccount(proposer, amountToProposer); err != nil {
+		return err
+	}
+	validators, serviceNodes, err := u.getBlockRewardRecipients()
+	if err != nil {
+		return err
+	}
+	amountToValidators, err := u.mintByStake(types.PercentageOf(reward, validatorsPercentage), validators)
+	if err != nil {
+		return err
+	}
+	amountToServiceNodes, err := u.mintByStake(types.PercentageOf(reward, serviceNodesPercentage), serviceNodes)
+	if err != nil {
+		return err
+	}
+	amountToDAO := new(big.Int).Sub(reward, amountToProposer)
+	amountToDAO.Sub(amountToDAO, amountToValidators)
+	amountToDAO.Sub(amountToDAO, amountToServiceNodes)
+	return u.MintToPool(typesUtil.DAOPoolName, amountToDAO)
+}
+
+// mintByStake mints `amount` to the recipients in proportion to their stakes, and returns the amount minted. The
+// delegators' part of a validator's share is minted to the VALIDATOR_STAKE_POOL (see RewardDelegators)
+func (u *UtilityContext) mintByStake(amount *big.Int, recipients *rewardStakes) (*big.Int, types.Error) {
+	shares, remainder := types.ProRata(amount, recipients.stakes)
+	for i, share := range shares {
+		if recipients.validators != nil {
+			toDelegators, err := u.RewardDelegators(recipients.validators[i], share)
+			if err != nil {
+				return nil, err
+			}
+			if err := u.MintToPool(typesUtil.ValidatorStakePoolName, toDelegators); err != nil {
+				return nil, err
+			}
+			share.Sub(share, toDelegators)
+		}
+		if err := u.MintToAccount(recipients.outputs[i], share); err != nil {
+			return nil, err
+		}
+	}
+	return amount.Sub(amount, remainder), nil
+}
+
+// rewardStakes are the output addresses and the stakes of the actors that share a part of the block reward. For
+// validators the stakes are their voting power
+type rewardStakes struct {
+	outputs    [][]byte
+	stakes     []*big.Int
+	validators []*typesGenesis.Validator
+}
+
+func (r *rewardStakes) add(output []byte, stakedTokens string) types.Error {
+	stake, err := types.StringToBigInt(stakedTokens)
+	if err != nil {
+		return err
+	}
+	r.outputs = append(r.outputs, output)
+	r.stakes = append(r.stakes, stake)
+	return nil
+}
+
+func (r *rewardStakes) addValidator(validator *typesGenesis.Validator) types.Error {
+	votingPower, err := validator.GetVotingPower()
+	if err != nil {
+		return err
+	}
+	r.outputs = append(r.outputs, validator.Output)
+	r.stakes = append(r.stakes, votingPower)
+	r.validators = append(r.validators, validator)
+	return nil
+}
+
+// getBlockRewardRecipients returns the validators and the service nodes that are staked and not paused, leaving out
+// the jailed service nodes
+func (u *UtilityContext) getBlockRewardRecipients() (validators, serviceNodes *rewardStakes, err types.Error) {
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, nil, types.ErrGetLatestHeight(er)
+	}
+	validators, serviceNodes = &rewardStakes{}, &rewardStakes{}
+	allValidators, er := store.GetAllValidators(height)
+	if er != nil {
+		return nil, nil, types.ErrGetAllValidators(er)
+	}
+	for _, validator := range allValidators {
+		if validator.Status != typesUtil.StakedStatus || validator.Paused {
+			continue
+		}
+		if err := validators.addValidator(validator); err != nil {
+			return nil, nil, err
+		}
+	}
+	allServiceNodes, er := store.GetAllServiceNodes(height)
+	if er != nil {
+		return nil, nil, types.ErrGetAllServiceNodes(er)
+	}
+	for _, serviceNode := range allServiceNodes {
+		if serviceNode.Status != typesUtil.StakedStatus || serviceNode.Paused || IsServiceNodeJailed(serviceNode, height) {
+			continue
+		}
+		if err := serviceNodes.add(serviceNode.Output, serviceNode.StakedTokens); err != nil {
+			return nil, nil, err
+		}
+	}
+	return validators, serviceNodes, nil
+}
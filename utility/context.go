@@ -1,6 +1,7 @@
 package utility
 
 import (
+	"bytes"
 	"encoding/hex"
 
 	"github.com/pokt-network/pocket/shared/modules"
@@ -11,15 +12,22 @@ import (
 // TODO (Team) Protocol hour discussion about contexts. We need to better understand the intermodule relationship here
 
 type UtilityContext struct {
-	LatestHeight int64
-	Mempool      types.Mempool
-	Context      *Context
+	LatestHeight    int64
+	Mempool         types.Mempool
+	Context         *Context
+	CheckInvariants bool  // check the token invariants after every block, see `UtilityConfig`
+	HaltHeight      int64 // refuse to apply the blocks from this height on if not zero, see `UtilityConfig`
 }
 
 type Context struct {
 	modules.PersistenceContext
 	SavePointsM map[string]struct{}
 	SavePoints  [][]byte
+	// the events recorded so far, the number of events recorded when each save point was created, and the hash of
+	// the transaction being applied, see `emitEvent`
+	Events          []*typesUtil.Event
+	SavePointEvents []int
+	TransactionHash string
 }
 
 func (u *UtilityModule) NewContext(height int64) (modules.UtilityContext, error) {
@@ -28,8 +36,10 @@ func (u *UtilityModule) NewContext(height int64) (modules.UtilityContext, error)
 		return nil, types.ErrNewPersistenceContext(err)
 	}
 	return &UtilityContext{
-		LatestHeight: height,
-		Mempool:      u.Mempool,
+		LatestHeight:    height,
+		Mempool:         u.Mempool,
+		CheckInvariants: u.checkInvariants,
+		HaltHeight:      u.haltHeight,
 		Context: &Context{
 			PersistenceContext: ctx,
 			SavePoints:         make([][]byte, 0),
@@ -67,12 +77,45 @@ func (u *UtilityContext) RevertLastSavePoint() types.Error {
 	popIndex := len(u.Context.SavePoints) - 1
 	key, u.Context.SavePoints = u.Context.SavePoints[popIndex], u.Context.SavePoints[:popIndex]
 	delete(u.Context.SavePointsM, hex.EncodeToString(key))
+	// the events recorded after the save point are reverted with the state
+	u.Context.Events = u.Context.Events[:u.Context.SavePointEvents[popIndex]]
+	u.Context.SavePointEvents = u.Context.SavePointEvents[:popIndex]
 	if err := u.Context.PersistenceContext.RollbackToSavePoint(key); err != nil {
 		return types.ErrRollbackSavePoint(err)
 	}
 	return nil
 }
 
+// ReleaseLastSavePoint drops the last save point while keeping the state and the events recorded after it
+func (u *UtilityContext) ReleaseLastSavePoint() types.Error {
+	if len(u.Context.SavePointsM) == typesUtil.ZeroInt {
+		return types.ErrEmptySavePoints()
+	}
+	var key []byte
+	popIndex := len(u.Context.SavePoints) - 1
+	key, u.Context.SavePoints = u.Context.SavePoints[popIndex], u.Context.SavePoints[:popIndex]
+	delete(u.Context.SavePointsM, hex.EncodeToString(key))
+	u.Context.SavePointEvents = u.Context.SavePointEvents[:popIndex]
+	if err := u.Context.PersistenceContext.ReleaseSavePoint(key); err != nil {
+		return types.ErrReleaseSavePoint(err)
+	}
+	return nil
+}
+
+// RevertToSavePoint rolls back the context to before `savePoint`, discarding every save point created after it
+func (u *UtilityContext) RevertToSavePoint(savePoint []byte) types.Error {
+	for len(u.Context.SavePoints) != typesUtil.ZeroInt {
+		last := u.Context.SavePoints[len(u.Context.SavePoints)-1]
+		if err := u.RevertLastSavePoint(); err != nil {
+			return err
+		}
+		if bytes.Equal(last, savePoint) {
+			return nil
+		}
+	}
+	return types.ErrSavePointNotFound()
+}
+
 func (u *UtilityContext) NewSavePoint(transactionHash []byte) types.Error {
 	if err := u.Context.PersistenceContext.NewSavePoint(transactionHash); err != nil {
 		return types.ErrNewSavePoint(err)
@@ -83,6 +126,7 @@ func (u *UtilityContext) NewSavePoint(transactionHash []byte) types.Error {
 	}
 	u.Context.SavePoints = append(u.Context.SavePoints, transactionHash)
 	u.Context.SavePointsM[txHash] = struct{}{}
+	u.Context.SavePointEvents = append(u.Context.SavePointEvents, len(u.Context.Events))
 	return nil
 }
 
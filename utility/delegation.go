@@ -0,0 +1,396 @@
+package utility
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// Delegators stake a validator without running it. Their tokens are held in the VALIDATOR_STAKE_POOL along with the
+// operator's stake and count toward the validator's voting power. Each validator accounts its delegated tokens in
+// shares, so rewards and slashes change the value of a share instead of every delegation: a share is worth the
+// `DelegatedTokens` of the validator divided by its `DelegatorShares`
+
+func (u *UtilityContext) HandleMessageDelegate(message *typesUtil.MessageDelegate) types.Error {
+	validator, err := u.GetValidator(message.Validator)
+	if err != nil {
+		return err
+	}
+	if validator.Status != typesUtil.StakedStatus {
+		return types.ErrInvalidStatus(int(validator.Status), typesUtil.StakedStatus)
+	}
+	amount, err := types.StringToBigInt(message.Amount)
+	if err != nil {
+		return err
+	}
+	// ensure the delegator has sufficient funding for the delegation
+	delegatorAccountAmount, err := u.GetAccountAmount(message.Delegator)
+	if err != nil {
+		return err
+	}
+	delegatorAccountAmount.Sub(delegatorAccountAmount, amount)
+	if delegatorAccountAmount.Sign() == -1 {
+		return types.ErrInsufficientAmountError()
+	}
+	if err := u.SetAccountAmount(message.Delegator, delegatorAccountAmount); err != nil {
+		return err
+	}
+	// move funds from account to pool
+	if err := u.AddPoolAmount(typesUtil.ValidatorStakePoolName, amount); err != nil {
+		return err
+	}
+	if err := u.delegate(validator, message.Delegator, amount); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_DELEGATE,
+		Address:   message.Delegator,
+		Validator: message.Validator,
+		Pool:      typesUtil.ValidatorStakePoolName,
+		Amount:    types.BigIntToString(amount),
+	})
+	return nil
+}
+
+// HandleMessageUndelegate redeems the shares of the delegation and queues their value for release to the delegator
+// after the validator unstaking period, during which it is still slashed for the double signs of the validator
+func (u *UtilityContext) HandleMessageUndelegate(message *typesUtil.MessageUndelegate) types.Error {
+	tokens, err := u.undelegate(message.Validator, message.Delegator, message.Shares)
+	if err != nil {
+		return err
+	}
+	unstakingHeight, err := u.CalculateValidatorUnstakingHeight()
+	if err != nil {
+		return err
+	}
+	if err := u.QueueUnbondingStake(message.Delegator, message.Delegator, message.Validator, tokens, unstakingHeight, typesUtil.ValidatorStakePoolName); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_UNDELEGATE,
+		Address:   message.Delegator,
+		Validator: message.Validator,
+		Pool:      typesUtil.ValidatorStakePoolName,
+		Amount:    types.BigIntToString(tokens),
+	})
+	return nil
+}
+
+// HandleMessageRedelegate moves the value of the shares to another staked validator right away; the tokens stay in
+// the VALIDATOR_STAKE_POOL and are slashed with the destination validator from then on. Unlike an undelegation, they
+// are no longer slashed for the earlier double signs of the source validator
+func (u *UtilityContext) HandleMessageRedelegate(message *typesUtil.MessageRedelegate) types.Error {
+	destination, err := u.GetValidator(message.DestinationValidator)
+	if err != nil {
+		return err
+	}
+	if destination.Status != typesUtil.StakedStatus {
+		return types.ErrInvalidStatus(int(destination.Status), typesUtil.StakedStatus)
+	}
+	tokens, err := u.undelegate(message.SourceValidator, message.Delegator, message.Shares)
+	if err != nil {
+		return err
+	}
+	if err := u.delegate(destination, message.Delegator, tokens); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:                 typesUtil.EventType_EVENT_TYPE_REDELEGATE,
+		Address:              message.Delegator,
+		Validator:            message.SourceValidator,
+		DestinationValidator: message.DestinationValidator,
+		Amount:               types.BigIntToString(tokens),
+	})
+	return nil
+}
+
+// delegate issues the shares worth `amount` to the delegator; the tokens must already be in the stake pool
+func (u *UtilityContext) delegate(validator *typesGenesis.Validator, delegator []byte, amount *big.Int) types.Error {
+	delegatedTokens, totalShares, err := validator.GetDelegation()
+	if err != nil {
+		return err
+	}
+	shares, err := sharesForTokens(amount, delegatedTokens, totalShares)
+	if err != nil {
+		return err
+	}
+	if shares.Sign() <= 0 {
+		return types.ErrInvalidAmount()
+	}
+	if err := u.SetValidatorDelegatedTokensAndShares(validator.Address, delegatedTokens.Add(delegatedTokens, amount), totalShares.Add(totalShares, shares)); err != nil {
+		return err
+	}
+	delegation, err := u.GetDelegation(validator.Address, delegator)
+	if err != nil {
+		return err
+	}
+	if delegation == nil {
+		delegation = &typesUtil.Delegation{
+			Delegator: delegator,
+			Validator: validator.Address,
+			Shares:    types.BigIntToString(big.NewInt(0)),
+		}
+	}
+	delegationShares, err := types.StringToBigInt(delegation.Shares)
+	if err != nil {
+		return err
+	}
+	delegation.Shares = types.BigIntToString(delegationShares.Add(delegationShares, shares))
+	return u.SetDelegation(delegation)
+}
+
+// undelegate redeems `sharesToRedeem` of the delegation and returns their value, which is left in the stake pool
+func (u *UtilityContext) undelegate(validatorAddress, delegator []byte, sharesToRedeem string) (*big.Int, types.Error) {
+	validator, err := u.GetValidator(validatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegation, err := u.GetDelegation(validatorAddress, delegator)
+	if err != nil {
+		return nil, err
+	}
+	if delegation == nil {
+		return nil, types.ErrInsufficientShares(sharesToRedeem, types.BigIntToString(big.NewInt(0)))
+	}
+	shares, err := types.StringToBigInt(sharesToRedeem)
+	if err != nil {
+		return nil, err
+	}
+	// negative shares would add to the delegation and to the voting power of the validator
+	if shares.Sign() <= 0 {
+		return nil, types.ErrInvalidAmount()
+	}
+	delegationShares, err := types.StringToBigInt(delegation.Shares)
+	if err != nil {
+		return nil, err
+	}
+	if delegationShares.Cmp(shares) < 0 {
+		return nil, types.ErrInsufficientShares(sharesToRedeem, delegation.Shares)
+	}
+	delegatedTokens, totalShares, err := validator.GetDelegation()
+	if err != nil {
+		return nil, err
+	}
+	tokens := tokensForShares(shares, delegatedTokens, totalShares)
+	if err := u.SetValidatorDelegatedTokensAndShares(validatorAddress, delegatedTokens.Sub(delegatedTokens, tokens), totalShares.Sub(totalShares, shares)); err != nil {
+		return nil, err
+	}
+	if delegationShares.Cmp(shares) == 0 {
+		store := u.Store()
+		if er := store.DeleteDelegation(validatorAddress, delegator); er != nil {
+			return nil, types.ErrSetDelegation(er)
+		}
+		return tokens, nil
+	}
+	delegation.Shares = types.BigIntToString(delegationShares.Sub(delegationShares, shares))
+	if err := u.SetDelegation(delegation); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// sharesForTokens returns the shares worth `tokens`, rounded down. The first delegation gets one share per token
+func sharesForTokens(tokens, delegatedTokens, totalShares *big.Int) (*big.Int, types.Error) {
+	if totalShares.Sign() == 0 {
+		return new(big.Int).Set(tokens), nil
+	}
+	// the outstanding shares are worthless, so new shares can't be priced
+	if delegatedTokens.Sign() == 0 {
+		return nil, types.ErrDelegationsSlashed()
+	}
+	shares := new(big.Int).Mul(tokens, totalShares)
+	return shares.Div(shares, delegatedTokens), nil
+}
+
+// tokensForShares returns the value of `shares` rounded down; the last shares redeemed get all of the tokens left
+func tokensForShares(shares, delegatedTokens, totalShares *big.Int) *big.Int {
+	if shares.Cmp(totalShares) == 0 {
+		return new(big.Int).Set(delegatedTokens)
+	}
+	tokens := new(big.Int).Mul(shares, delegatedTokens)
+	return tokens.Div(tokens, totalShares)
+}
+
+// RewardDelegators adds the delegators' part of `reward` to the delegated tokens of the validator, raising the
+// value of every share, and returns it. The delegators earn in proportion to their part of the voting power, less
+// the commission of the validator. The caller moves the returned amount into the VALIDATOR_STAKE_POOL and pays the
+// rest to the operator
+func (u *UtilityContext) RewardDelegators(validator *typesGenesis.Validator, reward *big.Int) (*big.Int, types.Error) {
+	delegatedTokens, totalShares, err := validator.GetDelegation()
+	if err != nil {
+		return nil, err
+	}
+	if totalShares.Sign() == 0 || delegatedTokens.Sign() == 0 {
+		return big.NewInt(0), nil
+	}
+	votingPower, err := validator.GetVotingPower()
+	if err != nil {
+		return nil, err
+	}
+	toDelegators := new(big.Int).Mul(reward, delegatedTokens)
+	toDelegators.Div(toDelegators, votingPower)
+	toDelegators.Sub(toDelegators, types.PercentageOf(toDelegators, int(validator.Commission)))
+	if err := u.SetValidatorDelegatedTokensAndShares(validator.Address, delegatedTokens.Add(delegatedTokens, toDelegators), totalShares); err != nil {
+		return nil, err
+	}
+	return toDelegators, nil
+}
+
+// slashDelegators takes `percentage` of the tokens delegated to the validator, pays the `reward` out of them and burns
+// the rest. It returns the amount slashed
+func (u *UtilityContext) slashDelegators(address []byte, percentage int, reward *slashReward) (*big.Int, types.Error) {
+	validator, err := u.GetValidator(address)
+	if err != nil {
+		return nil, err
+	}
+	delegatedTokens, totalShares, err := validator.GetDelegation()
+	if err != nil {
+		return nil, err
+	}
+	burned := types.PercentageOf(delegatedTokens, percentage)
+	if burned.Sign() == 0 {
+		return burned, nil
+	}
+	if err := u.slashFromPool(typesUtil.ValidatorStakePoolName, address, burned, reward); err != nil {
+		return nil, err
+	}
+	if err := u.SetValidatorDelegatedTokensAndShares(address, delegatedTokens.Sub(delegatedTokens, burned), totalShares); err != nil {
+		return nil, err
+	}
+	return burned, nil
+}
+
+// ReleaseDelegations pays the delegators of a validator that is done unstaking the value of their shares. The
+// rounding remainder goes to the DAO
+func (u *UtilityContext) ReleaseDelegations(address []byte) types.Error {
+	validator, err := u.GetValidator(address)
+	if err != nil {
+		return err
+	}
+	delegatedTokens, _, err := validator.GetDelegation()
+	if err != nil {
+		return err
+	}
+	delegations, err := u.GetValidatorDelegations(address)
+	if err != nil {
+		return err
+	}
+	shares := make([]*big.Int, len(delegations))
+	for i, delegation := range delegations {
+		if shares[i], err = types.StringToBigInt(delegation.Shares); err != nil {
+			return err
+		}
+	}
+	tokens, remainder := types.ProRata(delegatedTokens, shares)
+	store := u.Store()
+	for i, delegation := range delegations {
+		if err := u.SubPoolAmount(typesUtil.ValidatorStakePoolName, types.BigIntToString(tokens[i])); err != nil {
+			return err
+		}
+		if err := u.AddAccountAmount(delegation.Delegator, tokens[i]); err != nil {
+			return err
+		}
+		if er := store.DeleteDelegation(address, delegation.Delegator); er != nil {
+			return types.ErrSetDelegation(er)
+		}
+		u.emitEvent(&typesUtil.Event{
+			Type:      typesUtil.EventType_EVENT_TYPE_UNBONDING_RELEASE,
+			Address:   delegation.Delegator,
+			Validator: address,
+			Pool:      typesUtil.ValidatorStakePoolName,
+			Amount:    types.BigIntToString(tokens[i]),
+		})
+	}
+	if err := u.SubPoolAmount(typesUtil.ValidatorStakePoolName, types.BigIntToString(remainder)); err != nil {
+		return err
+	}
+	if err := u.AddPoolAmount(typesUtil.DAOPoolName, remainder); err != nil {
+		return err
+	}
+	return u.SetValidatorDelegatedTokensAndShares(address, big.NewInt(0), big.NewInt(0))
+}
+
+// GetDelegatorDelegations returns every delegation of `delegator`
+func (u *UtilityContext) GetDelegatorDelegations(delegator []byte) ([]*typesUtil.Delegation, types.Error) {
+	store := u.Store()
+	delegationsBz, er := store.GetAllDelegations()
+	if er != nil {
+		return nil, types.ErrGetDelegation(er)
+	}
+	delegations, err := u.unmarshalDelegations(delegationsBz)
+	if err != nil {
+		return nil, err
+	}
+	delegatorDelegations := make([]*typesUtil.Delegation, 0)
+	for _, delegation := range delegations {
+		if bytes.Equal(delegation.Delegator, delegator) {
+			delegatorDelegations = append(delegatorDelegations, delegation)
+		}
+	}
+	return delegatorDelegations, nil
+}
+
+func (u *UtilityContext) GetValidatorDelegations(validator []byte) ([]*typesUtil.Delegation, types.Error) {
+	store := u.Store()
+	delegationsBz, er := store.GetValidatorDelegations(validator)
+	if er != nil {
+		return nil, types.ErrGetDelegation(er)
+	}
+	return u.unmarshalDelegations(delegationsBz)
+}
+
+// GetDelegation returns nil if `delegator` has not delegated to `validator`
+func (u *UtilityContext) GetDelegation(validator, delegator []byte) (*typesUtil.Delegation, types.Error) {
+	store := u.Store()
+	bz, er := store.GetDelegation(validator, delegator)
+	if er != nil {
+		return nil, types.ErrGetDelegation(er)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	delegation := &typesUtil.Delegation{}
+	if er := u.Codec().Unmarshal(bz, delegation); er != nil {
+		return nil, types.ErrProtoUnmarshal(er)
+	}
+	return delegation, nil
+}
+
+func (u *UtilityContext) SetDelegation(delegation *typesUtil.Delegation) types.Error {
+	store := u.Store()
+	bz, er := u.Codec().Marshal(delegation)
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.SetDelegation(delegation.Validator, delegation.Delegator, bz); er != nil {
+		return types.ErrSetDelegation(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) unmarshalDelegations(delegationsBz [][]byte) ([]*typesUtil.Delegation, types.Error) {
+	delegations := make([]*typesUtil.Delegation, 0, len(delegationsBz))
+	for _, bz := range delegationsBz {
+		delegation := &typesUtil.Delegation{}
+		if er := u.Codec().Unmarshal(bz, delegation); er != nil {
+			return nil, types.ErrProtoUnmarshal(er)
+		}
+		delegations = append(delegations, delegation)
+	}
+	return delegations, nil
+}
+
+func (u *UtilityContext) GetMessageDelegateSignerCandidates(msg *typesUtil.MessageDelegate) ([][]byte, types.Error) {
+	return [][]byte{msg.Delegator}, nil
+}
+
+func (u *UtilityContext) GetMessageUndelegateSignerCandidates(msg *typesUtil.MessageUndelegate) ([][]byte, types.Error) {
+	return [][]byte{msg.Delegator}, nil
+}
+
+func (u *UtilityContext) GetMessageRedelegateSignerCandidates(msg *typesUtil.MessageRedelegate) ([][]byte, types.Error) {
+	return [][]byte{msg.Delegator}, nil
+}
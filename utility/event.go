@@ -0,0 +1,158 @@
+package utility
+
+import (
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Every change of the state applied by a block is recorded as a typed `Event`. The events of a transaction are
+// reverted with its save point if it fails and stored with its result otherwise; the events of the whole block are
+// stored by height and published on the bus once the block is committed (see `GetEvents`)
+
+func (u *UtilityContext) emitEvent(event *typesUtil.Event) {
+	event.Height = u.LatestHeight
+	event.TransactionHash = u.Context.TransactionHash
+	u.Context.Events = append(u.Context.Events, event)
+}
+
+// emitActorEvent records an event about the actor at `address`; `amount` is nil if the event doesn't move tokens
+func (u *UtilityContext) emitActorEvent(eventType typesUtil.EventType, actorType typesUtil.ActorType, address []byte, amount *big.Int) {
+	u.emitEvent(&typesUtil.Event{
+		Type:      eventType,
+		ActorType: actorType,
+		Address:   address,
+		Amount:    eventAmount(amount),
+	})
+}
+
+// emitStatusEvent records an event that changes the status of the actor at `address`, along with its cause, which is
+// added to the status history of the actor once the block events are stored
+func (u *UtilityContext) emitStatusEvent(eventType typesUtil.EventType, actorType typesUtil.ActorType, address []byte, amount *big.Int, cause typesUtil.StatusCause) {
+	u.emitEvent(&typesUtil.Event{
+		Type:        eventType,
+		ActorType:   actorType,
+		Address:     address,
+		Amount:      eventAmount(amount),
+		StatusCause: cause,
+	})
+}
+
+// emitPoolEvent records an event that moves `amount` in or out of the pool, to or from `address` if not nil
+func (u *UtilityContext) emitPoolEvent(eventType typesUtil.EventType, pool string, address []byte, amount *big.Int) {
+	u.emitEvent(&typesUtil.Event{
+		Type:    eventType,
+		Address: address,
+		Pool:    pool,
+		Amount:  eventAmount(amount),
+	})
+}
+
+func eventAmount(amount *big.Int) string {
+	if amount == nil {
+		return ""
+	}
+	return types.BigIntToString(amount)
+}
+
+// EventsSince returns the events recorded after the first `start` events of the context
+func (u *UtilityContext) EventsSince(start int) []*typesUtil.Event {
+	events := make([]*typesUtil.Event, len(u.Context.Events)-start)
+	copy(events, u.Context.Events[start:])
+	return events
+}
+
+// StoreBlockEvents stores every event recorded while applying the block at the latest height, and adds the events
+// that changed the status of an actor, i.e. the ones with a cause, to the status history of the actor
+func (u *UtilityContext) StoreBlockEvents() types.Error {
+	store := u.Store()
+	codec := u.Codec()
+	bz, er := codec.Marshal(u.blockEvents())
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.StoreBlockEvents(u.LatestHeight, bz); er != nil {
+		return types.ErrStoreBlockEvents(er)
+	}
+	for i, event := range u.Context.Events {
+		if event.StatusCause == typesUtil.StatusCause_STATUS_CAUSE_UNSPECIFIED {
+			continue
+		}
+		bz, er := codec.Marshal(event)
+		if er != nil {
+			return types.ErrProtoMarshal(er)
+		}
+		if er := store.StoreStatusEvent(event.Address, u.LatestHeight, i, bz); er != nil {
+			return types.ErrStoreStatusEvent(er)
+		}
+	}
+	return nil
+}
+
+// GetBlockEvents returns nil if no events were stored at `height`
+func (u *UtilityContext) GetBlockEvents(height int64) (*typesUtil.BlockEvents, types.Error) {
+	store := u.Store()
+	bz, er := store.GetBlockEvents(height)
+	if er != nil {
+		return nil, types.ErrGetBlockEvents(er)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	blockEvents := &typesUtil.BlockEvents{}
+	if er := u.Codec().Unmarshal(bz, blockEvents); er != nil {
+		return nil, types.ErrProtoUnmarshal(er)
+	}
+	return blockEvents, nil
+}
+
+// GetStatusHistory returns the events that changed the status of the actor, from oldest to newest: when it staked,
+// was paused, unpaused or jailed, began unstaking and unstaked, each with its height and cause
+func (u *UtilityContext) GetStatusHistory(actorType typesUtil.ActorType, address []byte) ([]*typesUtil.Event, types.Error) {
+	store := u.Store()
+	bzs, er := store.GetStatusEvents(address)
+	if er != nil {
+		return nil, types.ErrGetStatusEvents(er)
+	}
+	history := make([]*typesUtil.Event, 0)
+	for _, bz := range bzs {
+		event := &typesUtil.Event{}
+		if er := u.Codec().Unmarshal(bz, event); er != nil {
+			return nil, types.ErrProtoUnmarshal(er)
+		}
+		// an address may be an actor of several types
+		if event.ActorType == actorType {
+			history = append(history, event)
+		}
+	}
+	return history, nil
+}
+
+// GetEvents returns the events recorded by `ApplyBlock` as a `BlockEvents`, to be published on the bus once the
+// block is committed
+func (u *UtilityContext) GetEvents() (*anypb.Any, error) {
+	return u.Codec().ToAny(u.blockEvents())
+}
+
+func (u *UtilityContext) blockEvents() *typesUtil.BlockEvents {
+	return &typesUtil.BlockEvents{
+		Height: u.LatestHeight,
+		Events: u.Context.Events,
+	}
+}
+
+// emitBeginUnstakeEvents records a BEGIN_UNSTAKE event for every actor of `unstaking` that isn't in `wasUnstaking`,
+// as actors paused for too long begin unstaking in bulk (see `UnstakeAppsPausedBefore`)
+func (u *UtilityContext) emitBeginUnstakeEvents(actorType typesUtil.ActorType, wasUnstaking, unstaking [][]byte) {
+	before := make(map[string]bool, len(wasUnstaking))
+	for _, address := range wasUnstaking {
+		before[string(address)] = true
+	}
+	for _, address := range unstaking {
+		if !before[string(address)] {
+			u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_BEGIN_UNSTAKE, actorType, address, nil, typesUtil.StatusCause_STATUS_CAUSE_MAX_PAUSE)
+		}
+	}
+}
@@ -1,13 +1,49 @@
 package utility
 
 import (
+	"bytes"
+	"math/big"
+
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
 func (u *UtilityContext) HandleMessageTestScore(message *typesUtil.MessageTestScore) types.Error {
-	panic("TODO")
+	exists, err := u.GetFishermanExists(message.Reporter)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return types.ErrNotExists()
+	}
+	// only the fisherman of the session tests its service nodes, once per session, so a single fisherman can't fill
+	// the QoS window of a service node with its own reports
+	session, err := u.GetSessionOfHeader(message.SessionHeader)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(session.Fishermen, message.Reporter) {
+		return types.ErrNotSessionFisherman(message.Reporter)
+	}
+	if !inSession(session, message.Address) {
+		return types.ErrNotInSession(message.Address)
+	}
+	score, err := message.Score()
+	if err != nil {
+		return err
+	}
+	reported, err := u.GetTestScoreReported(session.SessionKey, message.Address)
+	if err != nil {
+		return err
+	}
+	if reported {
+		return types.ErrTestScoreAlreadyReported(message.Address)
+	}
+	if err := u.SetTestScoreReported(session.SessionKey, message.Address); err != nil {
+		return err
+	}
+	return u.HandleServiceNodeTestScore(message.Address, message.Reporter, score)
 }
 
 func (u *UtilityContext) HandleMessageProveTestScore(message *typesUtil.MessageProveTestScore) types.Error {
@@ -40,6 +76,10 @@ func (u *UtilityContext) HandleMessageStakeFisherman(message *typesUtil.MessageS
 	if signerAccountAmount.Sign() == -1 {
 		return types.ErrInsufficientAmountError()
 	}
+	// locked tokens may be staked if the stake returns to the vesting account
+	if err := u.checkLockedStake(message.Signer, message.OutputAddress, amount); err != nil {
+		return err
+	}
 	maxChains, err := u.GetFishermanMaxChains()
 	if err != nil {
 		return err
@@ -48,6 +88,10 @@ func (u *UtilityContext) HandleMessageStakeFisherman(message *typesUtil.MessageS
 	if len(message.Chains) > maxChains {
 		return types.ErrMaxChains(maxChains)
 	}
+	// the chains must be enabled in the relay chain registry
+	if err := u.checkRelayChains(message.Chains); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.SetAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -68,6 +112,7 @@ func (u *UtilityContext) HandleMessageStakeFisherman(message *typesUtil.MessageS
 	if err := u.InsertFisherman(publicKey.Address(), message.PublicKey, message.OutputAddress, message.ServiceUrl, message.Amount, message.Chains); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_STAKE, typesUtil.ActorType_ACTOR_TYPE_FISHERMAN, publicKey.Address(), amount, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -92,6 +137,14 @@ func (u *UtilityContext) HandleMessageEditStakeFisherman(message *typesUtil.Mess
 	if signerAccountAmount.Sign() == -1 {
 		return types.ErrInsufficientAmountError()
 	}
+	// locked tokens may be staked if the stake returns to the vesting account
+	output, err := u.GetFishermanOutputAddress(message.Address)
+	if err != nil {
+		return err
+	}
+	if err := u.checkLockedStake(message.Signer, output, amountToAdd); err != nil {
+		return err
+	}
 	maxChains, err := u.GetFishermanMaxChains()
 	if err != nil {
 		return err
@@ -100,6 +153,10 @@ func (u *UtilityContext) HandleMessageEditStakeFisherman(message *typesUtil.Mess
 	if len(message.Chains) > maxChains {
 		return types.ErrMaxChains(maxChains)
 	}
+	// the chains must be enabled in the relay chain registry
+	if err := u.checkRelayChains(message.Chains); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.SetAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -112,6 +169,7 @@ func (u *UtilityContext) HandleMessageEditStakeFisherman(message *typesUtil.Mess
 	if err := u.UpdateFisherman(message.Address, message.ServiceUrl, message.AmountToAdd, message.Chains); err != nil {
 		return err
 	}
+	u.emitActorEvent(typesUtil.EventType_EVENT_TYPE_EDIT_STAKE, typesUtil.ActorType_ACTOR_TYPE_FISHERMAN, message.Address, amountToAdd)
 	return nil
 }
 
@@ -131,6 +189,55 @@ func (u *UtilityContext) HandleMessageUnstakeFisherman(message *typesUtil.Messag
 	if err := u.SetFishermanUnstakingHeightAndStatus(message.Address, unstakingHeight); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_BEGIN_UNSTAKE, typesUtil.ActorType_ACTOR_TYPE_FISHERMAN, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
+	return nil
+}
+
+// HandleMessagePartialUnstakeFisherman lowers the stake of the fisherman, which stays staked, and queues the difference
+// for release to the output address after the unstaking period
+func (u *UtilityContext) HandleMessagePartialUnstakeFisherman(message *typesUtil.MessagePartialUnstakeFisherman) types.Error {
+	status, err := u.GetFishermanStatus(message.Address)
+	if err != nil {
+		return err
+	}
+	// validate is staked
+	if status != typesUtil.StakedStatus {
+		return types.ErrInvalidStatus(status, typesUtil.StakedStatus)
+	}
+	stakedTokens, err := u.GetFishermanStakedTokens(message.Address)
+	if err != nil {
+		return err
+	}
+	minStake, err := u.GetFishermanMinimumStake()
+	if err != nil {
+		return err
+	}
+	amount, remaining, err := partialUnstakeAmounts(stakedTokens, message.Amount, minStake)
+	if err != nil {
+		return err
+	}
+	if err := u.SetFishermanStakedTokens(message.Address, remaining); err != nil {
+		return err
+	}
+	unstakingHeight, err := u.CalculateFishermanUnstakingHeight()
+	if err != nil {
+		return err
+	}
+	output, err := u.GetFishermanOutputAddress(message.Address)
+	if err != nil {
+		return err
+	}
+	if err := u.QueueUnbondingStake(message.Address, output, nil, amount, unstakingHeight, typesUtil.FishermanStakePoolName); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_PARTIAL_UNSTAKE,
+		ActorType: typesUtil.ActorType_ACTOR_TYPE_FISHERMAN,
+		Address:   message.Address,
+		Recipient: output,
+		Pool:      typesUtil.FishermanStakePoolName,
+		Amount:    types.BigIntToString(amount),
+	})
 	return nil
 }
 
@@ -149,6 +256,15 @@ func (u *UtilityContext) UnstakeFishermenThatAreReady() types.Error {
 		if err := u.DeleteFisherman(fisherman.GetAddress()); err != nil {
 			return err
 		}
+		u.emitEvent(&typesUtil.Event{
+			Type:        typesUtil.EventType_EVENT_TYPE_UNSTAKE,
+			ActorType:   typesUtil.ActorType_ACTOR_TYPE_FISHERMAN,
+			Address:     fisherman.GetAddress(),
+			Recipient:   fisherman.GetOutputAddress(),
+			Pool:        typesUtil.FishermanStakePoolName,
+			Amount:      fisherman.GetStakeAmount(),
+			StatusCause: typesUtil.StatusCause_STATUS_CAUSE_UNSTAKING_PERIOD,
+		})
 	}
 	return nil
 }
@@ -187,6 +303,7 @@ func (u *UtilityContext) HandleMessagePauseFisherman(message *typesUtil.MessageP
 	if err := u.SetFishermanPauseHeight(message.Address, height); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_PAUSE, typesUtil.ActorType_ACTOR_TYPE_FISHERMAN, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -212,6 +329,7 @@ func (u *UtilityContext) HandleMessageFishermanPauseServiceNode(message *typesUt
 	if err := u.SetServiceNodePauseHeight(message.Address, height); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_PAUSE, typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_FISHERMAN)
 	return nil
 }
 
@@ -237,6 +355,7 @@ func (u *UtilityContext) HandleMessageUnpauseFisherman(message *typesUtil.Messag
 	if err := u.SetFishermanPauseHeight(message.Address, typesUtil.HeightNotUsed); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_UNPAUSE, typesUtil.ActorType_ACTOR_TYPE_FISHERMAN, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -294,13 +413,42 @@ func (u *UtilityContext) UnstakeFishermenPausedBefore(pausedBeforeHeight int64)
 	if err != nil {
 		return err
 	}
+	wasUnstaking, err := u.getUnstakingFishermen()
+	if err != nil {
+		return err
+	}
 	er := store.SetFishermansStatusAndUnstakingHeightPausedBefore(pausedBeforeHeight, unstakingHeight, typesUtil.UnstakingStatus)
 	if er != nil {
 		return types.ErrSetStatusPausedBefore(er, pausedBeforeHeight)
 	}
+	unstaking, err := u.getUnstakingFishermen()
+	if err != nil {
+		return err
+	}
+	u.emitBeginUnstakeEvents(typesUtil.ActorType_ACTOR_TYPE_FISHERMAN, wasUnstaking, unstaking)
 	return nil
 }
 
+// getUnstakingFishermen returns the addresses of the unstaking fishermen, in the order of the store
+func (u *UtilityContext) getUnstakingFishermen() ([][]byte, types.Error) {
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, types.ErrGetLatestHeight(er)
+	}
+	fishermen, er := store.GetAllFishermen(height)
+	if er != nil {
+		return nil, types.ErrGetAllFishermen(er)
+	}
+	var unstaking [][]byte
+	for _, fisherman := range fishermen {
+		if fisherman.Status == typesUtil.UnstakingStatus {
+			unstaking = append(unstaking, fisherman.Address)
+		}
+	}
+	return unstaking, nil
+}
+
 func (u *UtilityContext) GetFishermanStatus(address []byte) (int, types.Error) {
 	store := u.Store()
 	status, er := store.GetFishermanStatus(address)
@@ -380,6 +528,17 @@ func (u *UtilityContext) GetMessageUnstakeFishermanSignerCandidates(msg *typesUt
 	return candidates, nil
 }
 
+func (u *UtilityContext) GetMessagePartialUnstakeFishermanSignerCandidates(msg *typesUtil.MessagePartialUnstakeFisherman) ([][]byte, types.Error) {
+	output, err := u.GetFishermanOutputAddress(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Address)
+	return candidates, nil
+}
+
 func (u *UtilityContext) GetMessageUnpauseFishermanSignerCandidates(msg *typesUtil.MessageUnpauseFisherman) ([][]byte, types.Error) {
 	output, err := u.GetFishermanOutputAddress(msg.Address)
 	if err != nil {
@@ -413,6 +572,17 @@ func (u *UtilityContext) GetMessageFishermanPauseServiceNodeSignerCandidates(msg
 	return candidates, nil
 }
 
+func (u *UtilityContext) GetMessageTestScoreSignerCandidates(msg *typesUtil.MessageTestScore) ([][]byte, types.Error) {
+	output, err := u.GetFishermanOutputAddress(msg.Reporter)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Reporter)
+	return candidates, nil
+}
+
 func (u *UtilityContext) GetFishermanOutputAddress(operator []byte) ([]byte, types.Error) {
 	store := u.Store()
 	output, er := store.GetFishermanOutputAddress(operator)
@@ -421,3 +591,21 @@ func (u *UtilityContext) GetFishermanOutputAddress(operator []byte) ([]byte, typ
 	}
 	return output, nil
 }
+
+func (u *UtilityContext) GetFishermanStakedTokens(address []byte) (*big.Int, types.Error) {
+	store := u.Store()
+	stakedTokens, er := store.GetFishermanStakedTokens(address)
+	if er != nil {
+		return nil, types.ErrGetStakedTokens(er)
+	}
+	return types.StringToBigInt(stakedTokens)
+}
+
+func (u *UtilityContext) SetFishermanStakedTokens(address []byte, tokens *big.Int) types.Error {
+	store := u.Store()
+	er := store.SetFishermanStakedTokens(address, types.BigIntToString(tokens))
+	if er != nil {
+		return types.ErrSetStakedTokens(er)
+	}
+	return nil
+}
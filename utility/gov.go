@@ -1,10 +1,13 @@
 package utility
 
 import (
+	"log"
 	"math/big"
 
 	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -14,1864 +17,697 @@ func (u *UtilityContext) HandleMessageChangeParameter(message *typesUtil.Message
 	if err != nil {
 		return types.ErrProtoFromAny(err)
 	}
-	return u.UpdateParam(message.ParameterKey, v)
+	if message.ActivationHeight == 0 {
+		return u.UpdateParam(message.ParameterKey, v)
+	}
+	return u.schedulePendingParamChange(message.ParameterKey, v, message.ActivationHeight)
 }
 
-func (u *UtilityContext) UpdateParam(paramName string, value interface{}) types.Error {
+// schedulePendingParamChange validates the change right away and queues it until its activation height, so the
+// change can't break what is in progress when it is submitted (e.g. the current session)
+func (u *UtilityContext) schedulePendingParamChange(paramName string, value proto.Message, activationHeight int64) types.Error {
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	if activationHeight <= latestHeight {
+		return types.ErrInvalidActivationHeight(activationHeight)
+	}
+	if err := u.validateParamValue(paramName, value); err != nil {
+		return err
+	}
+	paramValue, err := u.Codec().ToAny(value)
+	if err != nil {
+		return err
+	}
+	if err := u.SetPendingParamChange(&typesUtil.PendingParamChange{
+		ParameterKey:     paramName,
+		ParameterValue:   paramValue,
+		ActivationHeight: activationHeight,
+	}); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:             typesUtil.EventType_EVENT_TYPE_SCHEDULE_PARAM_CHANGE,
+		ParamKey:         paramName,
+		ActivationHeight: activationHeight,
+	})
+	return nil
+}
+
+// validateParamValue checks `value` against the param registry before a change is queued, by a proposal or for an
+// activation height
+func (u *UtilityContext) validateParamValue(paramName string, value proto.Message) types.Error {
+	param, found := typesGenesis.ParamByKey(paramName)
+	if !found {
+		return types.ErrUnknownParam(paramName)
+	}
+	params, err := u.getCurrentParams(paramName)
+	if err != nil {
+		return err
+	}
+	// validate against a copy of the current params, so the limits that span several params are checked too; the
+	// change is only applied to the state later
+	return param.Apply(params, value)
+}
+
+func (u *UtilityContext) getCurrentParams(paramName string) (*typesGenesis.Params, types.Error) {
 	store := u.Store()
-	switch paramName {
-	case typesUtil.BlocksPerSessionParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetBlocksPerSession(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodesPerSessionParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetServiceNodesPerSession(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppMaxChainsParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMaxAppChains(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppMinimumStakeParamName:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetParamAppMinimumStake(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppBaselineStakeRateParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetBaselineAppStakeRate(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppStabilityAdjustmentParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetStakingAdjustment(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppUnstakingBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetAppUnstakingBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppMinimumPauseBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetAppMinimumPauseBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppMaxPauseBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetAppMaxPausedBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeMinimumStakeParamName:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetParamServiceNodeMinimumStake(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeMaxChainsParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetServiceNodeMaxChains(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeUnstakingBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetServiceNodeUnstakingBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeMinimumPauseBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetServiceNodeMinimumPauseBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeMaxPauseBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetServiceNodeMaxPausedBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanMinimumStakeParamName:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetParamFishermanMinimumStake(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanMaxChainsParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetFishermanMaxChains(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanUnstakingBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetFishermanUnstakingBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanMinimumPauseBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetFishermanMinimumPauseBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanMaxPauseBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetFishermanMaxPausedBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMinimumStakeParamName:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetParamValidatorMinimumStake(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorUnstakingBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetValidatorUnstakingBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMinimumPauseBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetValidatorMinimumPauseBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMaxPausedBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetValidatorMaxPausedBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMaximumMissedBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetValidatorMaximumMissedBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ProposerPercentageOfFeesParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetProposerPercentageOfFees(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMaxEvidenceAgeInBlocksParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMaxEvidenceAgeInBlocks(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MissedBlocksBurnPercentageParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMissedBlocksBurnPercentage(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.DoubleSignBurnPercentageParamName:
-		i, ok := value.(*wrapperspb.Int32Value)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetDoubleSignBurnPercentage(int(i.Value))
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AclOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetAclOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.BlocksPerSessionOwner:
-		i, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetBlocksPerSessionOwner(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodesPerSessionOwner:
-		i, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetServiceNodesPerSessionOwner(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppMaxChainsOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMaxAppChainsOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppMinimumStakeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetAppMinimumStakeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppBaselineStakeRateOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetBaselineAppOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppStakingAdjustmentOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetStakingAdjustmentOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppUnstakingBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetAppUnstakingBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppMinimumPauseBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetAppMinimumPauseBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.AppMaxPausedBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetAppMaxPausedBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeMinimumStakeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetParamServiceNodeMinimumStakeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeMaxChainsOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMaxServiceNodeChainsOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeUnstakingBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetServiceNodeUnstakingBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeMinimumPauseBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetServiceNodeMinimumPauseBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ServiceNodeMaxPausedBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetServiceNodeMaxPausedBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanMinimumStakeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetFishermanMinimumStakeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanMaxChainsOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMaxFishermanChainsOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanUnstakingBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetFishermanUnstakingBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanMinimumPauseBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetFishermanMinimumPauseBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.FishermanMaxPausedBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetFishermanMaxPausedBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMinimumStakeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetParamValidatorMinimumStakeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorUnstakingBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetValidatorUnstakingBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMinimumPauseBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetValidatorMinimumPauseBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMaxPausedBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetValidatorMaxPausedBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMaximumMissedBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetValidatorMaximumMissedBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ProposerPercentageOfFeesOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetProposerPercentageOfFeesOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.ValidatorMaxEvidenceAgeInBlocksOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMaxEvidenceAgeInBlocksOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MissedBlocksBurnPercentageOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMissedBlocksBurnPercentageOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.DoubleSignBurnPercentageOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetDoubleSignBurnPercentageOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, types.ErrGetParam(paramName, er)
+	}
+	params, er := store.GetParams(height)
+	if er != nil {
+		return nil, types.ErrGetParam(paramName, er)
+	}
+	return params, nil
+}
 
-	case typesUtil.MessageSendFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageSendFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageStakeFishermanFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageStakeFishermanFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageEditStakeFishermanFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageEditStakeFishermanFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnstakeFishermanFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageUnstakeFishermanFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessagePauseFishermanFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessagePauseFishermanFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnpauseFishermanFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageUnpauseFishermanFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageFishermanPauseServiceNodeFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageFishermanPauseServiceNodeFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageTestScoreFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageTestScoreFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageProveTestScoreFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageProveTestScoreFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageStakeAppFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageStakeAppFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageEditStakeAppFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageEditStakeAppFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnstakeAppFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageUnstakeAppFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessagePauseAppFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessagePauseAppFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnpauseAppFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageUnpauseAppFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageStakeValidatorFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageStakeValidatorFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageEditStakeValidatorFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageEditStakeValidatorFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnstakeValidatorFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageUnstakeValidatorFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessagePauseValidatorFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessagePauseValidatorFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnpauseValidatorFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageUnpauseValidatorFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageStakeServiceNodeFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageStakeServiceNodeFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageEditStakeServiceNodeFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageEditStakeServiceNodeFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnstakeServiceNodeFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageUnstakeServiceNodeFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessagePauseServiceNodeFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessagePauseServiceNodeFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnpauseServiceNodeFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageUnpauseServiceNodeFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageChangeParameterFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
-		}
-		err := store.SetMessageChangeParameterFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageSendFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageSendFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageStakeFishermanFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageStakeFishermanFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageEditStakeFishermanFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageEditStakeFishermanFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnstakeFishermanFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageUnstakeFishermanFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessagePauseFishermanFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessagePauseFishermanFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnpauseFishermanFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageUnpauseFishermanFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageFishermanPauseServiceNodeFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageFishermanPauseServiceNodeFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageTestScoreFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageTestScoreFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageProveTestScoreFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageProveTestScoreFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageStakeAppFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageStakeAppFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageEditStakeAppFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageEditStakeAppFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnstakeAppFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageUnstakeAppFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessagePauseAppFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessagePauseAppFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnpauseAppFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageUnpauseAppFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageStakeValidatorFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageStakeValidatorFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageEditStakeValidatorFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageEditStakeValidatorFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnstakeValidatorFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageUnstakeValidatorFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessagePauseValidatorFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessagePauseValidatorFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnpauseValidatorFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageUnpauseValidatorFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageStakeServiceNodeFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageStakeServiceNodeFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageEditStakeServiceNodeFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageEditStakeServiceNodeFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageUnstakeServiceNodeFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessageUnstakeServiceNodeFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessagePauseServiceNodeFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
-		}
-		err := store.SetMessagePauseServiceNodeFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
+// ApplyPendingParamChanges applies the param changes scheduled for the latest height, before the transactions of the
+// block
+func (u *UtilityContext) ApplyPendingParamChanges() types.Error {
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	paramChanges, err := u.GetPendingParamChangesAt(latestHeight)
+	if err != nil {
+		return err
+	}
+	store := u.Store()
+	for _, paramChange := range paramChanges {
+		value, er := u.Codec().FromAny(paramChange.ParameterValue)
+		if er != nil {
+			return types.ErrProtoFromAny(er)
 		}
-		return nil
-	case typesUtil.MessageUnpauseServiceNodeFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
+		param, found := typesGenesis.ParamByKey(paramChange.ParameterKey)
+		if !found {
+			return types.ErrUnknownParam(paramChange.ParameterKey)
 		}
-		err := store.SetMessageUnpauseServiceNodeFee(i.Value)
+		params, err := u.getCurrentParams(paramChange.ParameterKey)
 		if err != nil {
-			return types.ErrUpdateParam(err)
-		}
-		return nil
-	case typesUtil.MessageChangeParameterFee:
-		i, ok := value.(*wrapperspb.StringValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, i)
+			return err
 		}
-		err := store.SetMessageChangeParameterFee(i.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
+		// another change since this one was scheduled can make it break a limit that spans several params, so it is
+		// checked again and dropped if invalid instead of failing the block
+		if err := param.Apply(params, value); err != nil {
+			log.Printf("[PARAMS] dropped the %s change scheduled for height %d: %s\n", paramChange.ParameterKey, latestHeight, err)
+		} else if err := u.UpdateParam(paramChange.ParameterKey, value); err != nil {
+			return err
 		}
-		return nil
-	case typesUtil.MessageDoubleSignFeeOwner:
-		owner, ok := value.(*wrapperspb.BytesValue)
-		if !ok {
-			return types.ErrInvalidParamValue(value, owner)
+		if er := store.DeletePendingParamChange(paramChange.ActivationHeight, paramChange.ParameterKey); er != nil {
+			return types.ErrDeletePendingParamChange(er)
 		}
-		err := store.SetMessageDoubleSignFeeOwner(owner.Value)
-		if err != nil {
-			return types.ErrUpdateParam(err)
+	}
+	return nil
+}
+
+func (u *UtilityContext) SetPendingParamChange(paramChange *typesUtil.PendingParamChange) types.Error {
+	store := u.Store()
+	bz, er := u.Codec().Marshal(paramChange)
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.SetPendingParamChange(paramChange.ActivationHeight, paramChange.ParameterKey, bz); er != nil {
+		return types.ErrSetPendingParamChange(er)
+	}
+	return nil
+}
+
+// GetPendingParamChangesAt returns the param changes scheduled for `height`, ordered by param key
+func (u *UtilityContext) GetPendingParamChangesAt(height int64) ([]*typesUtil.PendingParamChange, types.Error) {
+	store := u.Store()
+	paramChangesBz, er := store.GetPendingParamChangesAt(height)
+	if er != nil {
+		return nil, types.ErrGetPendingParamChanges(er)
+	}
+	return u.unmarshalPendingParamChanges(paramChangesBz)
+}
+
+// GetAllPendingParamChanges returns every scheduled param change, ordered by activation height
+func (u *UtilityContext) GetAllPendingParamChanges() ([]*typesUtil.PendingParamChange, types.Error) {
+	store := u.Store()
+	paramChangesBz, er := store.GetAllPendingParamChanges()
+	if er != nil {
+		return nil, types.ErrGetPendingParamChanges(er)
+	}
+	return u.unmarshalPendingParamChanges(paramChangesBz)
+}
+
+func (u *UtilityContext) unmarshalPendingParamChanges(paramChangesBz [][]byte) ([]*typesUtil.PendingParamChange, types.Error) {
+	paramChanges := make([]*typesUtil.PendingParamChange, len(paramChangesBz))
+	for i, bz := range paramChangesBz {
+		paramChanges[i] = &typesUtil.PendingParamChange{}
+		if er := u.Codec().Unmarshal(bz, paramChanges[i]); er != nil {
+			return nil, types.ErrProtoUnmarshal(er)
 		}
-		return nil
-	default:
+	}
+	return paramChanges, nil
+}
+
+func (u *UtilityContext) UpdateParam(paramName string, value interface{}) types.Error {
+	param, found := typesGenesis.ParamByKey(paramName)
+	if !found {
 		return types.ErrUnknownParam(paramName)
 	}
+	v, ok := value.(proto.Message)
+	if !ok {
+		return types.ErrInvalidParamValue(value, param.Default)
+	}
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return types.ErrUpdateParam(er)
+	}
+	params, er := store.GetParams(height)
+	if er != nil {
+		return types.ErrUpdateParam(er)
+	}
+	if err := param.Apply(params, v); err != nil {
+		return err
+	}
+	if er := store.SetParams(params); er != nil {
+		return types.ErrUpdateParam(er)
+	}
+	u.emitEvent(&typesUtil.Event{Type: typesUtil.EventType_EVENT_TYPE_PARAM_CHANGE, ParamKey: paramName})
+	return nil
 }
 
-func (u *UtilityContext) GetBlocksPerSession() (int, types.Error) {
+// GetParam returns the current value of the param wrapped in its registered proto wrapper type
+func (u *UtilityContext) GetParam(paramName string) (proto.Message, types.Error) {
+	param, found := typesGenesis.ParamByKey(paramName)
+	if !found {
+		return nil, types.ErrUnknownParam(paramName)
+	}
 	store := u.Store()
-	blocksPerSession, err := store.GetBlocksPerSession()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.BlocksPerSessionParamName, err)
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, types.ErrGetParam(paramName, er)
+	}
+	params, er := store.GetParams(height)
+	if er != nil {
+		return nil, types.ErrGetParam(paramName, er)
 	}
-	return blocksPerSession, nil
+	return param.Get(params), nil
 }
 
-func (u *UtilityContext) GetAppMinimumStake() (*big.Int, types.Error) {
-	store := u.Store()
-	appMininimumStake, err := store.GetParamAppMinimumStake()
+func (u *UtilityContext) getIntParam(paramName string) (int, types.Error) {
+	value, err := u.GetParam(paramName)
 	if err != nil {
-		return nil, types.ErrGetParam(typesUtil.AppMinimumStakeParamName, err)
+		return typesUtil.ZeroInt, err
+	}
+	i, ok := value.(*wrapperspb.Int32Value)
+	if !ok {
+		return typesUtil.ZeroInt, types.ErrInvalidParamValue(value, i)
 	}
-	return types.StringToBigInt(appMininimumStake)
+	return int(i.Value), nil
 }
 
-func (u *UtilityContext) GetAppMaxChains() (int, types.Error) {
-	store := u.Store()
-	maxChains, err := store.GetMaxAppChains()
+func (u *UtilityContext) getBigIntParam(paramName string) (*big.Int, types.Error) {
+	value, err := u.GetParam(paramName)
 	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.AppMaxChainsParamName, err)
+		return nil, err
 	}
-	return maxChains, nil
+	s, ok := value.(*wrapperspb.StringValue)
+	if !ok {
+		return nil, types.ErrInvalidParamValue(value, s)
+	}
+	return types.StringToBigInt(s.Value)
 }
 
-func (u *UtilityContext) GetBaselineAppStakeRate() (int, types.Error) {
-	store := u.Store()
-	baselineRate, err := store.GetBaselineAppStakeRate()
+func (u *UtilityContext) getBytesParam(paramName string) ([]byte, types.Error) {
+	value, err := u.GetParam(paramName)
 	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.AppBaselineStakeRateParamName, err)
+		return nil, err
+	}
+	b, ok := value.(*wrapperspb.BytesValue)
+	if !ok {
+		return nil, types.ErrInvalidParamValue(value, b)
 	}
-	return baselineRate, nil
+	return b.Value, nil
+}
+
+func (u *UtilityContext) GetBlocksPerSession() (int, types.Error) {
+	return u.getIntParam(typesUtil.BlocksPerSessionParamName)
+}
+
+func (u *UtilityContext) GetAppMinimumStake() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.AppMinimumStakeParamName)
+}
+
+func (u *UtilityContext) GetAppMaxChains() (int, types.Error) {
+	return u.getIntParam(typesUtil.AppMaxChainsParamName)
+}
+
+func (u *UtilityContext) GetBaselineAppStakeRate() (int, types.Error) {
+	return u.getIntParam(typesUtil.AppBaselineStakeRateParamName)
 }
 
 func (u *UtilityContext) GetStabilityAdjustment() (int, types.Error) {
-	store := u.Store()
-	adjustment, err := store.GetStabilityAdjustment()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.AppStabilityAdjustmentParamName, err)
-	}
-	return adjustment, nil
+	return u.getIntParam(typesUtil.AppStabilityAdjustmentParamName)
 }
 
 func (u *UtilityContext) GetAppUnstakingBlocks() (int64, types.Error) {
-	store := u.Store()
-	unstakingHeight, err := store.GetAppUnstakingBlocks()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.AppUnstakingBlocksParamName, err)
-	}
-	return int64(unstakingHeight), nil
+	blocks, err := u.getIntParam(typesUtil.AppUnstakingBlocksParamName)
+	return int64(blocks), err
 }
 
 func (u *UtilityContext) GetAppMinimumPauseBlocks() (int, types.Error) {
-	store := u.Store()
-	minPauseBlocks, err := store.GetAppMinimumPauseBlocks()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.AppMinimumPauseBlocksParamName, err)
-	}
-	return minPauseBlocks, nil
+	return u.getIntParam(typesUtil.AppMinimumPauseBlocksParamName)
 }
 
 func (u *UtilityContext) GetAppMaxPausedBlocks() (maxPausedBlocks int, err types.Error) {
-	store := u.Store()
-	maxPausedBlocks, er := store.GetAppMaxPausedBlocks()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.AppMaxPauseBlocksParamName, er)
-	}
-	return maxPausedBlocks, nil
+	return u.getIntParam(typesUtil.AppMaxPauseBlocksParamName)
 }
 
 func (u *UtilityContext) GetServiceNodeMinimumStake() (*big.Int, types.Error) {
-	store := u.Store()
-	serviceNodeMininimumStake, err := store.GetParamServiceNodeMinimumStake()
-	if err != nil {
-		return nil, types.ErrGetParam(typesUtil.ServiceNodeMinimumStakeParamName, err)
-	}
-	return types.StringToBigInt(serviceNodeMininimumStake)
+	return u.getBigIntParam(typesUtil.ServiceNodeMinimumStakeParamName)
 }
 
 func (u *UtilityContext) GetServiceNodeMaxChains() (int, types.Error) {
-	store := u.Store()
-	maxChains, err := store.GetServiceNodeMaxChains()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ServiceNodeMaxChainsParamName, err)
-	}
-	return maxChains, nil
+	return u.getIntParam(typesUtil.ServiceNodeMaxChainsParamName)
 }
 
 func (u *UtilityContext) GetServiceNodeUnstakingBlocks() (int64, types.Error) {
-	store := u.Store()
-	unstakingHeight, err := store.GetServiceNodeUnstakingBlocks()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ServiceNodeUnstakingBlocksParamName, err)
-	}
-	return int64(unstakingHeight), nil
+	blocks, err := u.getIntParam(typesUtil.ServiceNodeUnstakingBlocksParamName)
+	return int64(blocks), err
 }
 
 func (u *UtilityContext) GetServiceNodeMinimumPauseBlocks() (int, types.Error) {
-	store := u.Store()
-	minPauseBlocks, err := store.GetServiceNodeMinimumPauseBlocks()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ServiceNodeMinimumPauseBlocksParamName, err)
-	}
-	return minPauseBlocks, nil
+	return u.getIntParam(typesUtil.ServiceNodeMinimumPauseBlocksParamName)
 }
 
 func (u *UtilityContext) GetServiceNodeMaxPausedBlocks() (maxPausedBlocks int, err types.Error) {
-	store := u.Store()
-	maxPausedBlocks, er := store.GetServiceNodeMaxPausedBlocks()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ServiceNodeMaxPauseBlocksParamName, er)
-	}
-	return maxPausedBlocks, nil
+	return u.getIntParam(typesUtil.ServiceNodeMaxPauseBlocksParamName)
+}
+
+func (u *UtilityContext) GetServiceNodeQoSWindow() (samples int, err types.Error) {
+	return u.getIntParam(typesUtil.ServiceNodeQoSWindowParamName)
+}
+
+func (u *UtilityContext) GetServiceNodeMinimumQoSScore() (score int, err types.Error) {
+	return u.getIntParam(typesUtil.ServiceNodeMinimumQoSScoreParamName)
+}
+
+func (u *UtilityContext) GetServiceNodeJailBlocks() (int64, types.Error) {
+	blocks, err := u.getIntParam(typesUtil.ServiceNodeJailBlocksParamName)
+	return int64(blocks), err
+}
+
+func (u *UtilityContext) GetServiceNodeSlashPercentage() (slashPercentage int, err types.Error) {
+	return u.getIntParam(typesUtil.ServiceNodeSlashPercentageParamName)
+}
+
+func (u *UtilityContext) GetFishermanBountyPercentage() (bountyPercentage int, err types.Error) {
+	return u.getIntParam(typesUtil.FishermanBountyPercentageParamName)
+}
+
+func (u *UtilityContext) GetServiceNodeSessionQoSFloor() (floor int, err types.Error) {
+	return u.getIntParam(typesUtil.ServiceNodeSessionQoSFloorParamName)
 }
 
 func (u *UtilityContext) GetValidatorMinimumStake() (*big.Int, types.Error) {
-	store := u.Store()
-	validatorMininimumStake, err := store.GetParamValidatorMinimumStake()
-	if err != nil {
-		return nil, types.ErrGetParam(typesUtil.ValidatorMinimumStakeParamName, err)
-	}
-	return types.StringToBigInt(validatorMininimumStake)
+	return u.getBigIntParam(typesUtil.ValidatorMinimumStakeParamName)
 }
 
 func (u *UtilityContext) GetValidatorUnstakingBlocks() (int64, types.Error) {
-	store := u.Store()
-	unstakingHeight, err := store.GetValidatorUnstakingBlocks()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ValidatorUnstakingBlocksParamName, err)
-	}
-	return int64(unstakingHeight), nil
+	blocks, err := u.getIntParam(typesUtil.ValidatorUnstakingBlocksParamName)
+	return int64(blocks), err
 }
 
 func (u *UtilityContext) GetValidatorMinimumPauseBlocks() (int, types.Error) {
-	store := u.Store()
-	minPauseBlocks, err := store.GetValidatorMinimumPauseBlocks()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ValidatorMinimumPauseBlocksParamName, err)
-	}
-	return minPauseBlocks, nil
+	return u.getIntParam(typesUtil.ValidatorMinimumPauseBlocksParamName)
 }
 
 func (u *UtilityContext) GetValidatorMaxPausedBlocks() (maxPausedBlocks int, err types.Error) {
-	store := u.Store()
-	maxPausedBlocks, er := store.GetValidatorMaxPausedBlocks()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ValidatorMaxPausedBlocksParamName, er)
-	}
-	return maxPausedBlocks, nil
+	return u.getIntParam(typesUtil.ValidatorMaxPausedBlocksParamName)
 }
 
 func (u *UtilityContext) GetProposerPercentageOfFees() (proposerPercentage int, err types.Error) {
-	store := u.Store()
-	proposerPercentage, er := store.GetProposerPercentageOfFees()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ProposerPercentageOfFeesParamName, er)
-	}
-	return proposerPercentage, nil
+	return u.getIntParam(typesUtil.ProposerPercentageOfFeesParamName)
 }
 
 func (u *UtilityContext) GetValidatorMaxMissedBlocks() (maxMissedBlocks int, err types.Error) {
-	store := u.Store()
-	maxMissedBlocks, er := store.GetValidatorMaximumMissedBlocks()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ValidatorMaximumMissedBlocksParamName, er)
-	}
-	return maxMissedBlocks, nil
+	return u.getIntParam(typesUtil.ValidatorMaximumMissedBlocksParamName)
 }
 
 func (u *UtilityContext) GetMaxEvidenceAgeInBlocks() (maxMissedBlocks int, err types.Error) {
-	store := u.Store()
-	maxMissedBlocks, er := store.GetMaxEvidenceAgeInBlocks()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.ValidatorMaxEvidenceAgeInBlocksParamName, er)
-	}
-	return maxMissedBlocks, nil
+	return u.getIntParam(typesUtil.ValidatorMaxEvidenceAgeInBlocksParamName)
 }
 
 func (u *UtilityContext) GetDoubleSignBurnPercentage() (burnPercentage int, err types.Error) {
-	store := u.Store()
-	burnPercentage, er := store.GetDoubleSignBurnPercentage()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.DoubleSignBurnPercentageParamName, er)
-	}
-	return burnPercentage, nil
+	return u.getIntParam(typesUtil.DoubleSignBurnPercentageParamName)
+}
+
+func (u *UtilityContext) GetFeeOwnerPercentageOfFees() (feeOwnerPercentage int, err types.Error) {
+	return u.getIntParam(typesUtil.FeeOwnerPercentageOfFeesParamName)
+}
+
+func (u *UtilityContext) GetBlockRewardAmount() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.BlockRewardAmountParamName)
+}
+
+func (u *UtilityContext) GetBlockRewardProposerPercentage() (proposerPercentage int, err types.Error) {
+	return u.getIntParam(typesUtil.BlockRewardProposerPercentageParamName)
+}
+
+func (u *UtilityContext) GetBlockRewardValidatorsPercentage() (validatorsPercentage int, err types.Error) {
+	return u.getIntParam(typesUtil.BlockRewardValidatorsPercentageParamName)
+}
+
+func (u *UtilityContext) GetBlockRewardServiceNodesPercentage() (serviceNodesPercentage int, err types.Error) {
+	return u.getIntParam(typesUtil.BlockRewardServiceNodesPercentageParamName)
+}
+
+func (u *UtilityContext) GetDoubleSignReporterRewardPercentage() (rewardPercentage int, err types.Error) {
+	return u.getIntParam(typesUtil.DoubleSignReporterRewardPercentageParamName)
 }
 
 func (u *UtilityContext) GetMissedBlocksBurnPercentage() (burnPercentage int, err types.Error) {
-	store := u.Store()
-	burnPercentage, er := store.GetMissedBlocksBurnPercentage()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.MissedBlocksBurnPercentageParamName, er)
-	}
-	return burnPercentage, nil
+	return u.getIntParam(typesUtil.MissedBlocksBurnPercentageParamName)
 }
 
 func (u *UtilityContext) GetFishermanMinimumStake() (*big.Int, types.Error) {
-	store := u.Store()
-	FishermanMininimumStake, err := store.GetParamFishermanMinimumStake()
-	if err != nil {
-		return nil, types.ErrGetParam(typesUtil.FishermanMinimumStakeParamName, err)
-	}
-	return types.StringToBigInt(FishermanMininimumStake)
+	return u.getBigIntParam(typesUtil.FishermanMinimumStakeParamName)
 }
 
 func (u *UtilityContext) GetFishermanMaxChains() (int, types.Error) {
-	store := u.Store()
-	maxChains, err := store.GetFishermanMaxChains()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.FishermanMaxChainsParamName, err)
-	}
-	return maxChains, nil
+	return u.getIntParam(typesUtil.FishermanMaxChainsParamName)
 }
 
 func (u *UtilityContext) GetFishermanUnstakingBlocks() (int64, types.Error) {
-	store := u.Store()
-	unstakingHeight, err := store.GetFishermanUnstakingBlocks()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.FishermanUnstakingBlocksParamName, err)
-	}
-	return int64(unstakingHeight), nil
+	blocks, err := u.getIntParam(typesUtil.FishermanUnstakingBlocksParamName)
+	return int64(blocks), err
 }
 
 func (u *UtilityContext) GetFishermanMinimumPauseBlocks() (int, types.Error) {
-	store := u.Store()
-	minPauseBlocks, err := store.GetFishermanMinimumPauseBlocks()
-	if err != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.FishermanMinimumPauseBlocksParamName, err)
-	}
-	return minPauseBlocks, nil
+	return u.getIntParam(typesUtil.FishermanMinimumPauseBlocksParamName)
 }
 
 func (u *UtilityContext) GetFishermanMaxPausedBlocks() (maxPausedBlocks int, err types.Error) {
-	store := u.Store()
-	maxPausedBlocks, er := store.GetFishermanMaxPausedBlocks()
-	if er != nil {
-		return typesUtil.ZeroInt, types.ErrGetParam(typesUtil.FishermanMaxPauseBlocksParamName, er)
-	}
-	return maxPausedBlocks, nil
+	return u.getIntParam(typesUtil.FishermanMaxPauseBlocksParamName)
 }
 
 func (u *UtilityContext) GetMessageDoubleSignFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageDoubleSignFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageDoubleSignFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageDoubleSignFee)
 }
 
 func (u *UtilityContext) GetMessageSendFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageSendFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageSendFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageSendFee)
 }
 
 func (u *UtilityContext) GetMessageStakeFishermanFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageStakeFishermanFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageStakeFishermanFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageStakeFishermanFee)
 }
 
 func (u *UtilityContext) GetMessageEditStakeFishermanFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageEditStakeFishermanFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageEditStakeFishermanFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageEditStakeFishermanFee)
 }
 
 func (u *UtilityContext) GetMessageUnstakeFishermanFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageUnstakeFishermanFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageUnstakeFishermanFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageUnstakeFishermanFee)
+}
+
+func (u *UtilityContext) GetMessagePartialUnstakeFishermanFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessagePartialUnstakeFishermanFee)
 }
 
 func (u *UtilityContext) GetMessagePauseFishermanFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessagePauseFishermanFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessagePauseFishermanFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessagePauseFishermanFee)
 }
 
 func (u *UtilityContext) GetMessageUnpauseFishermanFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageUnpauseFishermanFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageUnpauseFishermanFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageUnpauseFishermanFee)
 }
 
 func (u *UtilityContext) GetMessageFishermanPauseServiceNodeFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageFishermanPauseServiceNodeFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageFishermanPauseServiceNodeFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageFishermanPauseServiceNodeFee)
 }
 
 func (u *UtilityContext) GetMessageTestScoreFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageTestScoreFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageTestScoreFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageTestScoreFee)
+}
+
+func (u *UtilityContext) GetMessageClaimFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessageClaimFee)
 }
 
 func (u *UtilityContext) GetMessageProveTestScoreFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageProveTestScoreFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageProveTestScoreFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageProveTestScoreFee)
 }
 
 func (u *UtilityContext) GetMessageStakeAppFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageStakeAppFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageStakeAppFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageStakeAppFee)
 }
 
 func (u *UtilityContext) GetMessageEditStakeAppFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageEditStakeAppFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageEditStakeAppFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageEditStakeAppFee)
 }
 
 func (u *UtilityContext) GetMessageUnstakeAppFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageUnstakeAppFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageUnstakeAppFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageUnstakeAppFee)
+}
+
+func (u *UtilityContext) GetMessagePartialUnstakeAppFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessagePartialUnstakeAppFee)
 }
 
 func (u *UtilityContext) GetMessagePauseAppFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessagePauseAppFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessagePauseAppFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessagePauseAppFee)
 }
 
 func (u *UtilityContext) GetMessageUnpauseAppFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageUnpauseAppFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageUnpauseAppFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageUnpauseAppFee)
 }
 
 func (u *UtilityContext) GetMessageStakeValidatorFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageStakeValidatorFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageStakeValidatorFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageStakeValidatorFee)
 }
 
 func (u *UtilityContext) GetMessageEditStakeValidatorFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageEditStakeValidatorFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageEditStakeValidatorFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageEditStakeValidatorFee)
 }
 
 func (u *UtilityContext) GetMessageUnstakeValidatorFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageUnstakeValidatorFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageUnstakeValidatorFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageUnstakeValidatorFee)
+}
+
+func (u *UtilityContext) GetMessagePartialUnstakeValidatorFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessagePartialUnstakeValidatorFee)
 }
 
 func (u *UtilityContext) GetMessagePauseValidatorFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessagePauseValidatorFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessagePauseValidatorFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessagePauseValidatorFee)
 }
 
 func (u *UtilityContext) GetMessageUnpauseValidatorFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageUnpauseValidatorFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageUnpauseValidatorFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageUnpauseValidatorFee)
 }
 
 func (u *UtilityContext) GetMessageStakeServiceNodeFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageStakeServiceNodeFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageStakeServiceNodeFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageStakeServiceNodeFee)
 }
 
 func (u *UtilityContext) GetMessageEditStakeServiceNodeFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageEditStakeServiceNodeFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageEditStakeServiceNodeFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageEditStakeServiceNodeFee)
 }
 
 func (u *UtilityContext) GetMessageUnstakeServiceNodeFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageUnstakeServiceNodeFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageUnstakeServiceNodeFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageUnstakeServiceNodeFee)
+}
+
+func (u *UtilityContext) GetMessagePartialUnstakeServiceNodeFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessagePartialUnstakeServiceNodeFee)
 }
 
 func (u *UtilityContext) GetMessagePauseServiceNodeFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessagePauseServiceNodeFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessagePauseServiceNodeFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessagePauseServiceNodeFee)
 }
 
 func (u *UtilityContext) GetMessageUnpauseServiceNodeFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageUnpauseServiceNodeFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageUnpauseServiceNodeFee, er)
-	}
-	return types.StringToBigInt(fee)
+	return u.getBigIntParam(typesUtil.MessageUnpauseServiceNodeFee)
 }
 
 func (u *UtilityContext) GetMessageChangeParameterFee() (*big.Int, types.Error) {
-	store := u.Store()
-	fee, er := store.GetMessageChangeParameterFee()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.MessageChangeParameterFee, er)
+	return u.getBigIntParam(typesUtil.MessageChangeParameterFee)
+}
+
+func (u *UtilityContext) GetMessageSubmitProposalFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessageSubmitProposalFee)
+}
+
+func (u *UtilityContext) GetMessageVoteProposalFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessageVoteProposalFee)
+}
+
+func (u *UtilityContext) GetMessageDelegateFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessageDelegateFee)
+}
+
+func (u *UtilityContext) GetMessageUndelegateFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessageUndelegateFee)
+}
+
+func (u *UtilityContext) GetMessageRedelegateFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessageRedelegateFee)
+}
+
+func (u *UtilityContext) GetMessageCreateVestingAccountFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessageCreateVestingAccountFee)
+}
+
+func (u *UtilityContext) GetMessageUpgradeFee() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.MessageUpgradeFee)
+}
+
+func (u *UtilityContext) GetRelayChains() ([]*typesGenesis.RelayChain, types.Error) {
+	value, err := u.GetParam(typesUtil.RelayChainsParamName)
+	if err != nil {
+		return nil, err
+	}
+	chains, ok := value.(*typesGenesis.RelayChains)
+	if !ok {
+		return nil, types.ErrInvalidParamValue(value, chains)
 	}
-	return types.StringToBigInt(fee)
+	return chains.Value, nil
+}
+
+func (u *UtilityContext) GetGovVotingPeriodBlocks() (int64, types.Error) {
+	blocks, err := u.getIntParam(typesUtil.GovVotingPeriodBlocksParamName)
+	return int64(blocks), err
+}
+
+func (u *UtilityContext) GetGovMinimumDeposit() (*big.Int, types.Error) {
+	return u.getBigIntParam(typesUtil.GovMinimumDepositParamName)
+}
+
+func (u *UtilityContext) GetGovQuorumPercentage() (int, types.Error) {
+	return u.getIntParam(typesUtil.GovQuorumPercentageParamName)
+}
+
+func (u *UtilityContext) GetGovPassThresholdPercentage() (int, types.Error) {
+	return u.getIntParam(typesUtil.GovPassThresholdPercentageParamName)
 }
 
 func (u *UtilityContext) GetDoubleSignFeeOwner() (owner []byte, err types.Error) {
-	store := u.Store()
-	owner, er := store.GetMessageDoubleSignFeeOwner()
-	if er != nil {
-		return nil, types.ErrGetParam(typesUtil.DoubleSignBurnPercentageParamName, er)
-	}
-	return owner, nil
+	return u.getBytesParam(typesUtil.MessageDoubleSignFeeOwner)
 }
 
 func (u *UtilityContext) GetParamOwner(paramName string) ([]byte, error) {
-	store := u.Store()
-	switch paramName {
-	case typesUtil.AclOwner:
-		return store.GetAclOwner()
-	case typesUtil.BlocksPerSessionParamName:
-		return store.GetBlocksPerSessionOwner()
-	case typesUtil.AppMaxChainsParamName:
-		return store.GetMaxAppChainsOwner()
-	case typesUtil.AppMinimumStakeParamName:
-		return store.GetAppMinimumStakeOwner()
-	case typesUtil.AppBaselineStakeRateParamName:
-		return store.GetBaselineAppOwner()
-	case typesUtil.AppStabilityAdjustmentParamName:
-		return store.GetStakingAdjustmentOwner()
-	case typesUtil.AppUnstakingBlocksParamName:
-		return store.GetAppUnstakingBlocksOwner()
-	case typesUtil.AppMinimumPauseBlocksParamName:
-		return store.GetAppMinimumPauseBlocksOwner()
-	case typesUtil.AppMaxPauseBlocksParamName:
-		return store.GetAppMaxPausedBlocksOwner()
-	case typesUtil.ServiceNodesPerSessionParamName:
-		return store.GetServiceNodesPerSessionOwner()
-	case typesUtil.ServiceNodeMinimumStakeParamName:
-		return store.GetParamServiceNodeMinimumStakeOwner()
-	case typesUtil.ServiceNodeMaxChainsParamName:
-		return store.GetServiceNodeMaxChainsOwner()
-	case typesUtil.ServiceNodeUnstakingBlocksParamName:
-		return store.GetServiceNodeUnstakingBlocksOwner()
-	case typesUtil.ServiceNodeMinimumPauseBlocksParamName:
-		return store.GetServiceNodeMinimumPauseBlocksOwner()
-	case typesUtil.ServiceNodeMaxPauseBlocksParamName:
-		return store.GetServiceNodeMaxPausedBlocksOwner()
-	case typesUtil.FishermanMinimumStakeParamName:
-		return store.GetFishermanMinimumStakeOwner()
-	case typesUtil.FishermanMaxChainsParamName:
-		return store.GetMaxFishermanChainsOwner()
-	case typesUtil.FishermanUnstakingBlocksParamName:
-		return store.GetFishermanUnstakingBlocksOwner()
-	case typesUtil.FishermanMinimumPauseBlocksParamName:
-		return store.GetFishermanMinimumPauseBlocksOwner()
-	case typesUtil.FishermanMaxPauseBlocksParamName:
-		return store.GetFishermanMaxPausedBlocksOwner()
-	case typesUtil.ValidatorMinimumStakeParamName:
-		return store.GetParamValidatorMinimumStakeOwner()
-	case typesUtil.ValidatorUnstakingBlocksParamName:
-		return store.GetValidatorUnstakingBlocksOwner()
-	case typesUtil.ValidatorMinimumPauseBlocksParamName:
-		return store.GetValidatorMinimumPauseBlocksOwner()
-	case typesUtil.ValidatorMaxPausedBlocksParamName:
-		return store.GetValidatorMaxPausedBlocksOwner()
-	case typesUtil.ValidatorMaximumMissedBlocksParamName:
-		return store.GetValidatorMaximumMissedBlocksOwner()
-	case typesUtil.ProposerPercentageOfFeesParamName:
-		return store.GetProposerPercentageOfFeesOwner()
-	case typesUtil.ValidatorMaxEvidenceAgeInBlocksParamName:
-		return store.GetMaxEvidenceAgeInBlocksOwner()
-	case typesUtil.MissedBlocksBurnPercentageParamName:
-		return store.GetMissedBlocksBurnPercentageOwner()
-	case typesUtil.DoubleSignBurnPercentageParamName:
-		return store.GetDoubleSignBurnPercentageOwner()
-	case typesUtil.MessageDoubleSignFee:
-		return store.GetMessageDoubleSignFeeOwner()
-	case typesUtil.MessageSendFee:
-		return store.GetMessageSendFeeOwner()
-	case typesUtil.MessageStakeFishermanFee:
-		return store.GetMessageStakeFishermanFeeOwner()
-	case typesUtil.MessageEditStakeFishermanFee:
-		return store.GetMessageEditStakeFishermanFeeOwner()
-	case typesUtil.MessageUnstakeFishermanFee:
-		return store.GetMessageUnstakeFishermanFeeOwner()
-	case typesUtil.MessagePauseFishermanFee:
-		return store.GetMessagePauseFishermanFeeOwner()
-	case typesUtil.MessageUnpauseFishermanFee:
-		return store.GetMessageUnpauseFishermanFeeOwner()
-	case typesUtil.MessageFishermanPauseServiceNodeFee:
-		return store.GetMessageFishermanPauseServiceNodeFeeOwner()
-	case typesUtil.MessageTestScoreFee:
-		return store.GetMessageTestScoreFeeOwner()
-	case typesUtil.MessageProveTestScoreFee:
-		return store.GetMessageProveTestScoreFeeOwner()
-	case typesUtil.MessageStakeAppFee:
-		return store.GetMessageStakeAppFeeOwner()
-	case typesUtil.MessageEditStakeAppFee:
-		return store.GetMessageEditStakeAppFeeOwner()
-	case typesUtil.MessageUnstakeAppFee:
-		return store.GetMessageUnstakeAppFeeOwner()
-	case typesUtil.MessagePauseAppFee:
-		return store.GetMessagePauseAppFeeOwner()
-	case typesUtil.MessageUnpauseAppFee:
-		return store.GetMessageUnpauseAppFeeOwner()
-	case typesUtil.MessageStakeValidatorFee:
-		return store.GetMessageStakeValidatorFeeOwner()
-	case typesUtil.MessageEditStakeValidatorFee:
-		return store.GetMessageEditStakeValidatorFeeOwner()
-	case typesUtil.MessageUnstakeValidatorFee:
-		return store.GetMessageUnstakeValidatorFeeOwner()
-	case typesUtil.MessagePauseValidatorFee:
-		return store.GetMessagePauseValidatorFeeOwner()
-	case typesUtil.MessageUnpauseValidatorFee:
-		return store.GetMessageUnpauseValidatorFeeOwner()
-	case typesUtil.MessageStakeServiceNodeFee:
-		return store.GetMessageStakeServiceNodeFeeOwner()
-	case typesUtil.MessageEditStakeServiceNodeFee:
-		return store.GetMessageEditStakeServiceNodeFeeOwner()
-	case typesUtil.MessageUnstakeServiceNodeFee:
-		return store.GetMessageUnstakeServiceNodeFeeOwner()
-	case typesUtil.MessagePauseServiceNodeFee:
-		return store.GetMessagePauseServiceNodeFeeOwner()
-	case typesUtil.MessageUnpauseServiceNodeFee:
-		return store.GetMessageUnpauseServiceNodeFeeOwner()
-	case typesUtil.MessageChangeParameterFee:
-		return store.GetMessageChangeParameterFeeOwner()
-	case typesUtil.BlocksPerSessionOwner:
-		return store.GetAclOwner()
-	case typesUtil.AppMaxChainsOwner:
-		return store.GetAclOwner()
-	case typesUtil.AppMinimumStakeOwner:
-		return store.GetAclOwner()
-	case typesUtil.AppBaselineStakeRateOwner:
-		return store.GetAclOwner()
-	case typesUtil.AppStakingAdjustmentOwner:
-		return store.GetAclOwner()
-	case typesUtil.AppUnstakingBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.AppMinimumPauseBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.AppMaxPausedBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ServiceNodeMinimumStakeOwner:
-		return store.GetAclOwner()
-	case typesUtil.ServiceNodeMaxChainsOwner:
-		return store.GetAclOwner()
-	case typesUtil.ServiceNodeUnstakingBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ServiceNodeMinimumPauseBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ServiceNodeMaxPausedBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ServiceNodesPerSessionOwner:
-		return store.GetAclOwner()
-	case typesUtil.FishermanMinimumStakeOwner:
-		return store.GetAclOwner()
-	case typesUtil.FishermanMaxChainsOwner:
-		return store.GetAclOwner()
-	case typesUtil.FishermanUnstakingBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.FishermanMinimumPauseBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.FishermanMaxPausedBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ValidatorMinimumStakeOwner:
-		return store.GetAclOwner()
-	case typesUtil.ValidatorUnstakingBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ValidatorMinimumPauseBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ValidatorMaxPausedBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ValidatorMaximumMissedBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.ProposerPercentageOfFeesOwner:
-		return store.GetAclOwner()
-	case typesUtil.ValidatorMaxEvidenceAgeInBlocksOwner:
-		return store.GetAclOwner()
-	case typesUtil.MissedBlocksBurnPercentageOwner:
-		return store.GetAclOwner()
-	case typesUtil.DoubleSignBurnPercentageOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageSendFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageStakeFishermanFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageEditStakeFishermanFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageUnstakeFishermanFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessagePauseFishermanFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageUnpauseFishermanFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageFishermanPauseServiceNodeFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageTestScoreFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageProveTestScoreFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageStakeAppFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageEditStakeAppFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageUnstakeAppFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessagePauseAppFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageUnpauseAppFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageStakeValidatorFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageEditStakeValidatorFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageUnstakeValidatorFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessagePauseValidatorFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageUnpauseValidatorFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageStakeServiceNodeFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageEditStakeServiceNodeFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageUnstakeServiceNodeFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessagePauseServiceNodeFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageUnpauseServiceNodeFeeOwner:
-		return store.GetAclOwner()
-	case typesUtil.MessageChangeParameterFeeOwner:
-		return store.GetAclOwner()
-	default:
+	param, found := typesGenesis.ParamByKey(paramName)
+	if !found {
 		return nil, types.ErrUnknownParam(paramName)
 	}
+	return u.getBytesParam(param.Owner)
 }
 
+// GetFee returns the governance fee of the message type; the fee of a batch is the sum of the fees of its messages
 func (u *UtilityContext) GetFee(msg typesUtil.Message) (amount *big.Int, err types.Error) {
+	if batch, ok := msg.(*typesUtil.MessageBatch); ok {
+		return u.GetMessageBatchFee(batch)
+	}
+	paramName, err := feeParamName(msg)
+	if err != nil {
+		return nil, err
+	}
+	return u.getBigIntParam(paramName)
+}
+
+// GetFeeOwner returns the owner of the fee param of the message type, who receives `FeeOwnerPercentageOfFees` of the fee
+func (u *UtilityContext) GetFeeOwner(msg typesUtil.Message) (owner []byte, err types.Error) {
+	paramName, err := feeParamName(msg)
+	if err != nil {
+		return nil, err
+	}
+	param, found := typesGenesis.ParamByKey(paramName)
+	if !found {
+		return nil, types.ErrUnknownParam(paramName)
+	}
+	return u.getBytesParam(param.Owner)
+}
+
+// feeParamName returns the key of the governance param holding the fee of the message type
+func feeParamName(msg typesUtil.Message) (string, types.Error) {
 	switch x := msg.(type) {
 	case *typesUtil.MessageDoubleSign:
-		return u.GetMessageDoubleSignFee()
+		return typesUtil.MessageDoubleSignFee, nil
 	case *typesUtil.MessageSend:
-		return u.GetMessageSendFee()
+		return typesUtil.MessageSendFee, nil
 	case *typesUtil.MessageStakeFisherman:
-		return u.GetMessageStakeFishermanFee()
+		return typesUtil.MessageStakeFishermanFee, nil
 	case *typesUtil.MessageEditStakeFisherman:
-		return u.GetMessageEditStakeFishermanFee()
+		return typesUtil.MessageEditStakeFishermanFee, nil
 	case *typesUtil.MessageUnstakeFisherman:
-		return u.GetMessageUnstakeFishermanFee()
+		return typesUtil.MessageUnstakeFishermanFee, nil
+	case *typesUtil.MessagePartialUnstakeFisherman:
+		return typesUtil.MessagePartialUnstakeFishermanFee, nil
 	case *typesUtil.MessagePauseFisherman:
-		return u.GetMessagePauseFishermanFee()
+		return typesUtil.MessagePauseFishermanFee, nil
 	case *typesUtil.MessageUnpauseFisherman:
-		return u.GetMessageUnpauseFishermanFee()
+		return typesUtil.MessageUnpauseFishermanFee, nil
 	case *typesUtil.MessageFishermanPauseServiceNode:
-		return u.GetMessageFishermanPauseServiceNodeFee()
-	//case *types.MessageTestScore:
-	//	return u.GetMessageTestScoreFee()
+		return typesUtil.MessageFishermanPauseServiceNodeFee, nil
+	case *typesUtil.MessageTestScore:
+		return typesUtil.MessageTestScoreFee, nil
+	case *typesUtil.MessageClaim:
+		return typesUtil.MessageClaimFee, nil
 	//case *types.MessageProveTestScore:
-	//	return u.GetMessageProveTestScoreFee()
+	//	return typesUtil.MessageProveTestScoreFee, nil
 	case *typesUtil.MessageStakeApp:
-		return u.GetMessageStakeAppFee()
+		return typesUtil.MessageStakeAppFee, nil
 	case *typesUtil.MessageEditStakeApp:
-		return u.GetMessageEditStakeAppFee()
+		return typesUtil.MessageEditStakeAppFee, nil
 	case *typesUtil.MessageUnstakeApp:
-		return u.GetMessageUnstakeAppFee()
+		return typesUtil.MessageUnstakeAppFee, nil
+	case *typesUtil.MessagePartialUnstakeApp:
+		return typesUtil.MessagePartialUnstakeAppFee, nil
 	case *typesUtil.MessagePauseApp:
-		return u.GetMessagePauseAppFee()
+		return typesUtil.MessagePauseAppFee, nil
 	case *typesUtil.MessageUnpauseApp:
-		return u.GetMessageUnpauseAppFee()
+		return typesUtil.MessageUnpauseAppFee, nil
 	case *typesUtil.MessageStakeValidator:
-		return u.GetMessageStakeValidatorFee()
+		return typesUtil.MessageStakeValidatorFee, nil
 	case *typesUtil.MessageEditStakeValidator:
-		return u.GetMessageEditStakeValidatorFee()
+		return typesUtil.MessageEditStakeValidatorFee, nil
 	case *typesUtil.MessageUnstakeValidator:
-		return u.GetMessageUnstakeValidatorFee()
+		return typesUtil.MessageUnstakeValidatorFee, nil
+	case *typesUtil.MessagePartialUnstakeValidator:
+		return typesUtil.MessagePartialUnstakeValidatorFee, nil
 	case *typesUtil.MessagePauseValidator:
-		return u.GetMessagePauseValidatorFee()
+		return typesUtil.MessagePauseValidatorFee, nil
 	case *typesUtil.MessageUnpauseValidator:
-		return u.GetMessageUnpauseValidatorFee()
+		return typesUtil.MessageUnpauseValidatorFee, nil
 	case *typesUtil.MessageStakeServiceNode:
-		return u.GetMessageStakeServiceNodeFee()
+		return typesUtil.MessageStakeServiceNodeFee, nil
 	case *typesUtil.MessageEditStakeServiceNode:
-		return u.GetMessageEditStakeServiceNodeFee()
+		return typesUtil.MessageEditStakeServiceNodeFee, nil
 	case *typesUtil.MessageUnstakeServiceNode:
-		return u.GetMessageUnstakeServiceNodeFee()
+		return typesUtil.MessageUnstakeServiceNodeFee, nil
+	case *typesUtil.MessagePartialUnstakeServiceNode:
+		return typesUtil.MessagePartialUnstakeServiceNodeFee, nil
 	case *typesUtil.MessagePauseServiceNode:
-		return u.GetMessagePauseServiceNodeFee()
+		return typesUtil.MessagePauseServiceNodeFee, nil
 	case *typesUtil.MessageUnpauseServiceNode:
-		return u.GetMessageUnpauseServiceNodeFee()
+		return typesUtil.MessageUnpauseServiceNodeFee, nil
 	case *typesUtil.MessageChangeParameter:
-		return u.GetMessageChangeParameterFee()
+		return typesUtil.MessageChangeParameterFee, nil
+	case *typesUtil.MessageSubmitProposal:
+		return typesUtil.MessageSubmitProposalFee, nil
+	case *typesUtil.MessageVoteProposal:
+		return typesUtil.MessageVoteProposalFee, nil
+	case *typesUtil.MessageDelegate:
+		return typesUtil.MessageDelegateFee, nil
+	case *typesUtil.MessageUndelegate:
+		return typesUtil.MessageUndelegateFee, nil
+	case *typesUtil.MessageRedelegate:
+		return typesUtil.MessageRedelegateFee, nil
+	case *typesUtil.MessageCreateVestingAccount:
+		return typesUtil.MessageCreateVestingAccountFee, nil
+	case *typesUtil.MessageUpgrade:
+		return typesUtil.MessageUpgradeFee, nil
 	default:
-		return nil, types.ErrUnknownMessage(x)
+		return "", types.ErrUnknownMessage(x)
 	}
 }
 
@@ -0,0 +1,100 @@
+package utility
+
+import (
+	"log"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// MempoolCounters summarizes a single post-commit reconciliation of the mempool
+type MempoolCounters struct {
+	Height           int64
+	CommittedRemoved int // transactions removed because they were included in the committed block
+	Rechecked        int // transactions re-validated against the newly committed state
+	Evicted          int // transactions dropped because they are no longer valid against the new state
+	Remaining        int
+	RemainingBytes   int
+}
+
+// ReconcileMempool is called by consensus after a block is committed. It opens a throwaway context at
+// the new `height` to re-validate the remaining transactions; any state changes are discarded on release.
+// It also prunes the relay meter of the sessions before the last one.
+func (u *UtilityModule) ReconcileMempool(height int64, committedTransactions [][]byte) error {
+	u.setLatestHeight(height)
+	ctx, err := u.NewContext(height)
+	if err != nil {
+		return err
+	}
+	defer ctx.ReleaseContext()
+	counters, err := ctx.(*UtilityContext).RecheckMempool(committedTransactions)
+	if err != nil {
+		return err
+	}
+	if err := ctx.(*UtilityContext).PruneRelayMeter(u.RelayMeter); err != nil {
+		return err
+	}
+	log.Printf("[MEMPOOL] height %d: %d committed removed, %d rechecked, %d evicted, %d remaining (%d bytes)\n",
+		counters.Height, counters.CommittedRemoved, counters.Rechecked, counters.Evicted, counters.Remaining, counters.RemainingBytes)
+	if u.haltHeight != 0 && height >= u.haltHeight {
+		log.Printf("[HALT] the state at the halt height %d is committed\n", u.haltHeight)
+		u.GetBus().PublishEventToBus(&types.PocketEvent{Topic: types.PocketTopic_NODE_HALTED_TOPIC})
+	}
+	return nil
+}
+
+// RecheckMempool removes the committed transactions from the mempool and re-applies what is left
+// sequentially on top of the context's state, evicting any transaction that fails other than those
+// ahead of their signer's sequence. Sequential
+// application catches transactions invalidated by the block (e.g. a drained balance) as well as
+// transactions invalidated by each other, in the same order they would be proposed.
+func (u *UtilityContext) RecheckMempool(committedTransactions [][]byte) (*MempoolCounters, types.Error) {
+	counters := &MempoolCounters{Height: u.LatestHeight}
+	for _, transaction := range committedTransactions {
+		if !u.Mempool.Contains(typesUtil.TransactionHash(transaction)) {
+			continue
+		}
+		if err := u.Mempool.DeleteTransaction(transaction); err != nil {
+			return nil, err
+		}
+		counters.CommittedRemoved++
+	}
+	for _, transaction := range u.Mempool.GetTransactions() {
+		counters.Rechecked++
+		// transactions ahead of their signer's sequence are kept until their turn
+		if err := u.recheckTransaction(transaction); err != nil && err.Code() != types.CodeSequenceTooHighError {
+			if err := u.Mempool.DeleteTransaction(transaction); err != nil {
+				return nil, err
+			}
+			counters.Evicted++
+		}
+	}
+	counters.Remaining = u.Mempool.Size()
+	counters.RemainingBytes = u.Mempool.TxsBytes()
+	return counters, nil
+}
+
+func (u *UtilityContext) recheckTransaction(transactionProtoBytes []byte) types.Error {
+	store := u.Store()
+	if store.TransactionExists(typesUtil.TransactionHash(transactionProtoBytes)) {
+		return types.ErrTransactionAlreadyCommitted()
+	}
+	tx, err := typesUtil.TransactionFromBytes(transactionProtoBytes)
+	if err != nil {
+		return err
+	}
+	if err := tx.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := u.NewSavePoint(crypto.SHA3Hash(transactionProtoBytes)); err != nil {
+		return err
+	}
+	if err := u.ApplyTransaction(tx); err != nil {
+		if err := u.RevertLastSavePoint(); err != nil {
+			return err
+		}
+		return err
+	}
+	return u.ReleaseLastSavePoint()
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/pokt-network/pocket/shared/config"
 	"github.com/pokt-network/pocket/shared/modules"
 	modulesMock "github.com/pokt-network/pocket/shared/modules/mocks"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 var maxTxBytes = 90000
@@ -23,6 +24,10 @@ func CreateMockedModule(_ *config.Config) (modules.UtilityModule, error) {
 		NewContext(gomock.Any()).
 		Return(utilityContextMock, nil).
 		AnyTimes()
+	utilityMock.EXPECT().
+		ReconcileMempool(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
 
 	utilityContextMock.EXPECT().GetPersistenceContext().Return(persistenceContextMock).AnyTimes()
 	utilityContextMock.EXPECT().ReleaseContext().Return().AnyTimes()
@@ -34,6 +39,7 @@ func CreateMockedModule(_ *config.Config) (modules.UtilityModule, error) {
 		ApplyBlock(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(appHash, nil).
 		AnyTimes()
+	utilityContextMock.EXPECT().GetEvents().Return(&anypb.Any{}, nil).AnyTimes()
 
 	persistenceContextMock.EXPECT().Commit().Return(nil).AnyTimes()
 
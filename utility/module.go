@@ -2,10 +2,12 @@ package utility
 
 import (
 	"log"
+	"sync"
 
 	"github.com/pokt-network/pocket/shared/config"
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
 var _ modules.UtilityModule = &UtilityModule{}
@@ -13,16 +15,44 @@ var _ modules.UtilityModule = &UtilityModule{}
 type UtilityModule struct {
 	bus modules.Bus
 
-	Mempool types.Mempool
+	Mempool         types.Mempool
+	RelayMeter      *RelayMeter // the relays this node served as a service node, by session
+	latestHeight    int64       // the height of the latest committed state, as reported to ReconcileMempool
+	heightMutex     sync.RWMutex
+	checkInvariants bool
+	haltHeight      int64
 }
 
-func Create(_ *config.Config) (modules.UtilityModule, error) {
+func Create(cfg *config.Config) (modules.UtilityModule, error) {
 	return &UtilityModule{
 		// TODO: Add `maxTransactionBytes` and `maxTransactions` to cfg.Utility
-		Mempool: types.NewMempool(1000, 1000),
+		Mempool:         types.NewPriorityMempool(1000, 1000, typesUtil.TransactionFeePriority),
+		RelayMeter:      NewRelayMeter(),
+		checkInvariants: cfg.Utility != nil && cfg.Utility.CheckInvariants,
+		haltHeight:      haltHeight(cfg),
 	}, nil
 }
 
+func haltHeight(cfg *config.Config) int64 {
+	if cfg.Utility == nil {
+		return 0
+	}
+	return cfg.Utility.HaltHeight
+}
+
+// latestHeight is written by ReconcileMempool after each commit while the queries of the node read it
+func (u *UtilityModule) setLatestHeight(height int64) {
+	u.heightMutex.Lock()
+	defer u.heightMutex.Unlock()
+	u.latestHeight = height
+}
+
+func (u *UtilityModule) getLatestHeight() int64 {
+	u.heightMutex.RLock()
+	defer u.heightMutex.RUnlock()
+	return u.latestHeight
+}
+
 func (u *UtilityModule) Start() error {
 	return nil
 }
@@ -0,0 +1,381 @@
+package utility
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+func (u *UtilityContext) HandleMessageSubmitProposal(message *typesUtil.MessageSubmitProposal) types.Error {
+	// ensure above minimum deposit
+	minDeposit, err := u.GetGovMinimumDeposit()
+	if err != nil {
+		return err
+	}
+	deposit, err := types.StringToBigInt(message.Deposit)
+	if err != nil {
+		return err
+	}
+	if types.BigIntLessThan(deposit, minDeposit) {
+		return types.ErrInsufficientDeposit(message.Deposit, types.BigIntToString(minDeposit))
+	}
+	// reject param changes that could never be applied
+	if paramChange := message.Content.GetParamChange(); paramChange != nil {
+		if err := u.validateParamChange(paramChange); err != nil {
+			return err
+		}
+	}
+	// ensure proposer has sufficient spendable funds for the deposit; unvested tokens can't be deposited
+	if err := u.SubtractAccountAmount(message.Proposer, deposit); err != nil {
+		return err
+	}
+	// escrow the deposit until the proposal is tallied
+	if err := u.AddPoolAmount(typesUtil.GovDepositPoolName, deposit); err != nil {
+		return err
+	}
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	votingPeriod, err := u.GetGovVotingPeriodBlocks()
+	if err != nil {
+		return err
+	}
+	proposalID, err := u.GetProposalCount()
+	if err != nil {
+		return err
+	}
+	if err := u.SetProposal(&typesUtil.Proposal{
+		Id:              proposalID,
+		Proposer:        message.Proposer,
+		Deposit:         message.Deposit,
+		SubmitHeight:    latestHeight,
+		VotingEndHeight: latestHeight + votingPeriod,
+		Content:         message.Content,
+		Status:          typesUtil.ProposalStatus_PROPOSAL_STATUS_VOTING,
+	}); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:       typesUtil.EventType_EVENT_TYPE_SUBMIT_PROPOSAL,
+		Address:    message.Proposer,
+		Pool:       typesUtil.GovDepositPoolName,
+		Amount:     types.BigIntToString(deposit),
+		ProposalId: proposalID,
+	})
+	return nil
+}
+
+func (u *UtilityContext) HandleMessageVoteProposal(message *typesUtil.MessageVoteProposal) types.Error {
+	proposal, err := u.GetProposal(message.ProposalId)
+	if err != nil {
+		return err
+	}
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	if proposal.Status != typesUtil.ProposalStatus_PROPOSAL_STATUS_VOTING || latestHeight > proposal.VotingEndHeight {
+		return types.ErrVotingPeriodClosed(message.ProposalId)
+	}
+	if err := u.SetProposalVote(message.ProposalId, &typesUtil.ProposalVote{
+		Voter:  message.Voter,
+		Option: message.Option,
+	}); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:       typesUtil.EventType_EVENT_TYPE_VOTE_PROPOSAL,
+		Address:    message.Voter,
+		ProposalId: message.ProposalId,
+	})
+	return nil
+}
+
+// TallyProposals closes the proposals whose voting period ends at the latest height. Votes are weighted by the
+// stake of the staked validators at tally time rather than when the vote was cast, which is intended: stake that moved
+// to another validator during the voting period (e.g. redelegated, or unstaked and staked again) is counted once,
+// for whoever holds it when the voting period ends. The quorum is reached when the voting stake is at least the quorum
+// percentage of the total stake, and a proposal passes when its yes votes are above the pass threshold percentage
+// of the yes and no votes. The deposit is refunded once the quorum is reached and goes to the DAO otherwise
+func (u *UtilityContext) TallyProposals() types.Error {
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	proposals, err := u.GetProposalsEndingAt(latestHeight)
+	if err != nil {
+		return err
+	}
+	if len(proposals) == typesUtil.ZeroInt {
+		return nil
+	}
+	votingPower, totalVotingPower, err := u.GetValidatorVotingPower()
+	if err != nil {
+		return err
+	}
+	quorumPercentage, err := u.GetGovQuorumPercentage()
+	if err != nil {
+		return err
+	}
+	passThresholdPercentage, err := u.GetGovPassThresholdPercentage()
+	if err != nil {
+		return err
+	}
+	for _, proposal := range proposals {
+		if proposal.Status != typesUtil.ProposalStatus_PROPOSAL_STATUS_VOTING {
+			continue
+		}
+		votes, err := u.GetProposalVotes(proposal.Id)
+		if err != nil {
+			return err
+		}
+		yes, no, voted := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+		for _, vote := range votes {
+			power, ok := votingPower[hex.EncodeToString(vote.Voter)]
+			if !ok {
+				continue // the voter is no longer a staked validator
+			}
+			voted.Add(voted, power)
+			switch vote.Option {
+			case typesUtil.VoteOption_VOTE_OPTION_YES:
+				yes.Add(yes, power)
+			case typesUtil.VoteOption_VOTE_OPTION_NO:
+				no.Add(no, power)
+			}
+		}
+		quorumReached := totalVotingPower.Sign() == 1 && scaledByPercent(voted, 100).Cmp(scaledByPercent(totalVotingPower, quorumPercentage)) >= 0
+		passed := quorumReached && scaledByPercent(yes, 100).Cmp(scaledByPercent(new(big.Int).Add(yes, no), passThresholdPercentage)) == 1
+		if err := u.settleProposalDeposit(proposal, quorumReached); err != nil {
+			return err
+		}
+		proposal.Status = typesUtil.ProposalStatus_PROPOSAL_STATUS_REJECTED
+		if passed {
+			if proposal.Status, err = u.executeProposal(proposal); err != nil {
+				return err
+			}
+		}
+		if err := u.SetProposal(proposal); err != nil {
+			return err
+		}
+		u.emitEvent(&typesUtil.Event{
+			Type:           typesUtil.EventType_EVENT_TYPE_PROPOSAL_RESULT,
+			Address:        proposal.Proposer,
+			ProposalId:     proposal.Id,
+			ProposalStatus: proposal.Status,
+		})
+	}
+	return nil
+}
+
+// GetValidatorVotingPower returns the staked and delegated tokens of every staked validator, keyed by hex address,
+// and their sum
+func (u *UtilityContext) GetValidatorVotingPower() (votingPower map[string]*big.Int, total *big.Int, err types.Error) {
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, nil, types.ErrGetLatestHeight(er)
+	}
+	validators, er := store.GetAllValidators(height)
+	if er != nil {
+		return nil, nil, types.ErrGetAllValidators(er)
+	}
+	votingPower = make(map[string]*big.Int, len(validators))
+	total = big.NewInt(0)
+	for _, validator := range validators {
+		if validator.Status != typesUtil.StakedStatus {
+			continue
+		}
+		stake, err := validator.GetVotingPower()
+		if err != nil {
+			return nil, nil, err
+		}
+		votingPower[hex.EncodeToString(validator.Address)] = stake
+		total.Add(total, stake)
+	}
+	return votingPower, total, nil
+}
+
+func (u *UtilityContext) settleProposalDeposit(proposal *typesUtil.Proposal, quorumReached bool) types.Error {
+	if err := u.SubPoolAmount(typesUtil.GovDepositPoolName, proposal.Deposit); err != nil {
+		return err
+	}
+	if quorumReached {
+		return u.AddAccountAmountString(proposal.Proposer, proposal.Deposit)
+	}
+	deposit, err := types.StringToBigInt(proposal.Deposit)
+	if err != nil {
+		return err
+	}
+	return u.AddPoolAmount(typesUtil.DAOPoolName, deposit)
+}
+
+// executeProposal applies the action of a passed proposal. A failing action is reverted and marks the proposal as
+// failed instead of failing the block
+func (u *UtilityContext) executeProposal(proposal *typesUtil.Proposal) (typesUtil.ProposalStatus, types.Error) {
+	savePoint := []byte(fmt.Sprintf("gov_proposal/%d", proposal.Id))
+	if err := u.NewSavePoint(savePoint); err != nil {
+		return proposal.Status, err
+	}
+	var actionErr types.Error
+	switch action := proposal.Content.GetAction().(type) {
+	case *typesUtil.ProposalContent_ParamChange:
+		actionErr = u.executeParamChange(action.ParamChange)
+	case *typesUtil.ProposalContent_PoolSpend:
+		actionErr = u.executePoolSpend(action.PoolSpend)
+	}
+	// text proposals have nothing to execute
+	if actionErr != nil {
+		if err := u.RevertToSavePoint(savePoint); err != nil {
+			return proposal.Status, err
+		}
+		return typesUtil.ProposalStatus_PROPOSAL_STATUS_FAILED, nil
+	}
+	return typesUtil.ProposalStatus_PROPOSAL_STATUS_PASSED, nil
+}
+
+func (u *UtilityContext) executeParamChange(paramChange *typesUtil.ProposalParamChange) types.Error {
+	value, er := u.Codec().FromAny(paramChange.ParameterValue)
+	if er != nil {
+		return types.ErrProtoFromAny(er)
+	}
+	// passed proposals bypass the owner of the param, which is what allows the DAO to take over the ACL
+	return u.UpdateParam(paramChange.ParameterKey, value)
+}
+
+func (u *UtilityContext) executePoolSpend(poolSpend *typesUtil.ProposalPoolSpend) types.Error {
+	amount, err := types.StringToBigInt(poolSpend.Amount)
+	if err != nil {
+		return err
+	}
+	daoAmount, err := u.GetPoolAmount(typesUtil.DAOPoolName)
+	if err != nil {
+		return err
+	}
+	if types.BigIntLessThan(daoAmount, amount) {
+		return types.ErrInsufficientAmountError()
+	}
+	if err := u.SubPoolAmount(typesUtil.DAOPoolName, poolSpend.Amount); err != nil {
+		return err
+	}
+	return u.AddAccountAmount(poolSpend.Recipient, amount)
+}
+
+func (u *UtilityContext) validateParamChange(paramChange *typesUtil.ProposalParamChange) types.Error {
+	value, er := u.Codec().FromAny(paramChange.ParameterValue)
+	if er != nil {
+		return types.ErrProtoFromAny(er)
+	}
+	return u.validateParamValue(paramChange.ParameterKey, value)
+}
+
+func (u *UtilityContext) GetProposal(proposalID uint64) (*typesUtil.Proposal, types.Error) {
+	store := u.Store()
+	exists, er := store.GetProposalExists(proposalID)
+	if er != nil {
+		return nil, types.ErrGetExists(er)
+	}
+	if !exists {
+		return nil, types.ErrProposalNotFound(proposalID)
+	}
+	bz, er := store.GetProposal(proposalID)
+	if er != nil {
+		return nil, types.ErrGetProposal(er)
+	}
+	proposal := &typesUtil.Proposal{}
+	if er := u.Codec().Unmarshal(bz, proposal); er != nil {
+		return nil, types.ErrProtoUnmarshal(er)
+	}
+	return proposal, nil
+}
+
+func (u *UtilityContext) GetProposalCount() (uint64, types.Error) {
+	store := u.Store()
+	count, er := store.GetProposalCount()
+	if er != nil {
+		return 0, types.ErrGetProposal(er)
+	}
+	return count, nil
+}
+
+func (u *UtilityContext) SetProposal(proposal *typesUtil.Proposal) types.Error {
+	store := u.Store()
+	bz, er := u.Codec().Marshal(proposal)
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.SetProposal(proposal.Id, proposal.VotingEndHeight, bz); er != nil {
+		return types.ErrSetProposal(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) GetProposalsEndingAt(height int64) ([]*typesUtil.Proposal, types.Error) {
+	store := u.Store()
+	proposalsBz, er := store.GetProposalsEndingAt(height)
+	if er != nil {
+		return nil, types.ErrGetProposal(er)
+	}
+	proposals := make([]*typesUtil.Proposal, 0, len(proposalsBz))
+	for _, bz := range proposalsBz {
+		proposal := &typesUtil.Proposal{}
+		if er := u.Codec().Unmarshal(bz, proposal); er != nil {
+			return nil, types.ErrProtoUnmarshal(er)
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, nil
+}
+
+func (u *UtilityContext) SetProposalVote(proposalID uint64, vote *typesUtil.ProposalVote) types.Error {
+	store := u.Store()
+	bz, er := u.Codec().Marshal(vote)
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.SetProposalVote(proposalID, vote.Voter, bz); er != nil {
+		return types.ErrSetProposal(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) GetProposalVotes(proposalID uint64) ([]*typesUtil.ProposalVote, types.Error) {
+	store := u.Store()
+	votesBz, er := store.GetProposalVotes(proposalID)
+	if er != nil {
+		return nil, types.ErrGetProposal(er)
+	}
+	votes := make([]*typesUtil.ProposalVote, 0, len(votesBz))
+	for _, bz := range votesBz {
+		vote := &typesUtil.ProposalVote{}
+		if er := u.Codec().Unmarshal(bz, vote); er != nil {
+			return nil, types.ErrProtoUnmarshal(er)
+		}
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+func (u *UtilityContext) GetMessageSubmitProposalSignerCandidates(msg *typesUtil.MessageSubmitProposal) ([][]byte, types.Error) {
+	return [][]byte{msg.Proposer}, nil
+}
+
+func (u *UtilityContext) GetMessageVoteProposalSignerCandidates(msg *typesUtil.MessageVoteProposal) ([][]byte, types.Error) {
+	output, err := u.GetValidatorOutputAddress(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Voter)
+	return candidates, nil
+}
+
+// scaledByPercent returns `amount` multiplied by `percentage`, i.e. `percentage`% of `amount` scaled by 100, so two
+// percentages compare without the rounding of `types.PercentageOf`
+func scaledByPercent(amount *big.Int, percentage int) *big.Int {
+	return new(big.Int).Mul(amount, big.NewInt(int64(percentage)))
+}
@@ -0,0 +1,162 @@
+package utility
+
+import (
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// Fishermen test the service nodes of their sessions and report the results: a `MessageTestScore` of the fisherman of
+// the session adds the percentage of samples the service node didn't fail to its rolling QoS score, once per session.
+// A service node whose score is under the ServiceNodeMinimumQoSScore over a whole ServiceNodeQoSWindow of samples is
+// jailed (excluded from sessions) for ServiceNodeJailBlocks and slashed by the ServiceNodeSlashPercentage, and the
+// fisherman whose report jailed it earns the FishermanBountyPercentage of the slash
+
+// HandleServiceNodeTestScore adds `score`, reported by the fisherman `reporter`, to the QoS score of the service node
+// and jails the service node if its score is persistently under the minimum
+func (u *UtilityContext) HandleServiceNodeTestScore(address, reporter []byte, score int) types.Error {
+	serviceNode, err := u.GetServiceNode(address)
+	if err != nil {
+		return err
+	}
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	if IsServiceNodeJailed(serviceNode, latestHeight) {
+		return types.ErrServiceNodeJailed(address, serviceNode.JailedUntilHeight)
+	}
+	window, err := u.GetServiceNodeQoSWindow()
+	if err != nil {
+		return err
+	}
+	minimumScore, err := u.GetServiceNodeMinimumQoSScore()
+	if err != nil {
+		return err
+	}
+	// the new score weighs as one of the last `window` samples
+	samples := int(serviceNode.QosSamples)
+	if samples >= window {
+		samples = window - 1
+	}
+	qosScore := (int(serviceNode.QosScore)*samples + score) / (samples + 1)
+	samples++
+	if samples < window || qosScore >= minimumScore {
+		return u.SetServiceNodeQoSScore(address, qosScore, samples)
+	}
+	return u.JailServiceNode(address, reporter)
+}
+
+// JailServiceNode excludes the service node from sessions for ServiceNodeJailBlocks, slashes it and pays the bounty
+// to the fisherman `reporter`. Its QoS score starts over once it is released
+func (u *UtilityContext) JailServiceNode(address, reporter []byte) types.Error {
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	jailBlocks, err := u.GetServiceNodeJailBlocks()
+	if err != nil {
+		return err
+	}
+	jailedUntilHeight := latestHeight + jailBlocks
+	if err := u.SetServiceNodeJailedUntilHeight(address, jailedUntilHeight); err != nil {
+		return err
+	}
+	if err := u.SetServiceNodeQoSScore(address, typesUtil.ZeroInt, typesUtil.ZeroInt); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:              typesUtil.EventType_EVENT_TYPE_JAIL,
+		ActorType:         typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE,
+		Address:           address,
+		JailedUntilHeight: jailedUntilHeight,
+		StatusCause:       typesUtil.StatusCause_STATUS_CAUSE_FISHERMAN,
+	})
+	slashPercentage, err := u.GetServiceNodeSlashPercentage()
+	if err != nil {
+		return err
+	}
+	// the bounty is paid out of the slashed stake
+	bountyPercentage, err := u.GetFishermanBountyPercentage()
+	if err != nil {
+		return err
+	}
+	_, err = u.slashServiceNode(address, slashPercentage, &slashReward{recipient: reporter, percentage: bountyPercentage})
+	return err
+}
+
+// slashServiceNode takes `percentage` of the service node's stake, pays the `reward` out of it and burns the rest. It
+// returns the amount slashed. A service node left under the minimum stake begins unstaking
+func (u *UtilityContext) slashServiceNode(address []byte, percentage int, reward *slashReward) (*big.Int, types.Error) {
+	tokens, err := u.GetServiceNodeStakedTokens(address)
+	if err != nil {
+		return nil, err
+	}
+	burned := types.PercentageOf(tokens, percentage)
+	if err := u.slashFromPool(typesUtil.ServiceNodeStakePoolName, address, burned, reward); err != nil {
+		return nil, err
+	}
+	remaining := big.NewInt(0).Sub(tokens, burned)
+	if err := u.SetServiceNodeStakedTokens(address, remaining); err != nil {
+		return nil, err
+	}
+	minStake, err := u.GetServiceNodeMinimumStake()
+	if err != nil {
+		return nil, err
+	}
+	status, err := u.GetServiceNodeStatus(address)
+	if err != nil {
+		return nil, err
+	}
+	if status == typesUtil.StakedStatus && minStake.Cmp(remaining) == 1 {
+		unstakingHeight, err := u.CalculateServiceNodeUnstakingHeight()
+		if err != nil {
+			return nil, err
+		}
+		if err := u.SetServiceNodeUnstakingHeightAndStatus(address, unstakingHeight); err != nil {
+			return nil, err
+		}
+		u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_BEGIN_UNSTAKE, typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, address, nil, typesUtil.StatusCause_STATUS_CAUSE_SLASH)
+	}
+	return burned, nil
+}
+
+// IsServiceNodeJailed returns true if the service node is excluded from the sessions at `height`
+func IsServiceNodeJailed(serviceNode *typesGenesis.ServiceNode, height int64) bool {
+	return height < serviceNode.JailedUntilHeight
+}
+
+func (u *UtilityContext) GetTestScoreReported(sessionKey, address []byte) (bool, types.Error) {
+	store := u.Store()
+	reported, er := store.GetTestScoreReported(sessionKey, address)
+	if er != nil {
+		return false, types.ErrGetTestScoreReported(er)
+	}
+	return reported, nil
+}
+
+func (u *UtilityContext) SetTestScoreReported(sessionKey, address []byte) types.Error {
+	store := u.Store()
+	if er := store.SetTestScoreReported(sessionKey, address); er != nil {
+		return types.ErrSetTestScoreReported(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) SetServiceNodeQoSScore(address []byte, score, samples int) types.Error {
+	store := u.Store()
+	if er := store.SetServiceNodeQoSScore(address, score, samples); er != nil {
+		return types.ErrSetQoSScore(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) SetServiceNodeJailedUntilHeight(address []byte, height int64) types.Error {
+	store := u.Store()
+	if er := store.SetServiceNodeJailedUntilHeight(address, height); er != nil {
+		return types.ErrSetJailedUntilHeight(er)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package utility
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sync"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// An application's MaxRelays are its relays per session, split evenly between its chains and then between the
+// service nodes of the session on each chain. The service nodes meter the relays they serve against their budget and
+// refuse the relays past it, and a `MessageClaim` of relays past the budget is rejected, so an application can't get
+// more service than its stake pays for
+
+// GetSessionRelayBudget returns the relays each service node of the session may serve to the application
+func (u *UtilityContext) GetSessionRelayBudget(session *typesUtil.Session) (uint64, types.Error) {
+	publicKey, er := crypto.NewPublicKeyFromBytes(session.SessionHeader.AppPublicKey)
+	if er != nil {
+		return typesUtil.ZeroInt, types.ErrNewPublicKeyFromBytes(er)
+	}
+	app, err := u.GetApp(publicKey.Address())
+	if err != nil {
+		return typesUtil.ZeroInt, err
+	}
+	if !containsChain(app.Chains, session.SessionHeader.Chain) || len(session.ServiceNodes) == typesUtil.ZeroInt {
+		return typesUtil.ZeroInt, nil
+	}
+	maxRelays, err := types.StringToBigInt(app.MaxRelays)
+	if err != nil {
+		return typesUtil.ZeroInt, err
+	}
+	return maxRelays.Uint64() / uint64(len(app.Chains)) / uint64(len(session.ServiceNodes)), nil
+}
+
+// HandleMessageClaim adds the claimed relays to the relays the service node claimed in the session, as long as they
+// stay within its relay budget
+func (u *UtilityContext) HandleMessageClaim(message *typesUtil.MessageClaim) types.Error {
+	session, err := u.GetSessionOfHeader(message.SessionHeader)
+	if err != nil {
+		return err
+	}
+	if !inSession(session, message.Address) {
+		return types.ErrNotInSession(message.Address)
+	}
+	budget, err := u.GetSessionRelayBudget(session)
+	if err != nil {
+		return err
+	}
+	claimed, err := u.GetClaimedRelays(session.SessionKey, message.Address)
+	if err != nil {
+		return err
+	}
+	if claimed > budget || message.Relays > budget-claimed {
+		return types.ErrRelayBudgetExceeded(claimed+message.Relays, budget)
+	}
+	if err := u.SetClaimedRelays(session.SessionKey, message.Address, claimed+message.Relays); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_CLAIM,
+		ActorType: typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE,
+		Address:   message.Address,
+		Relays:    message.Relays,
+	})
+	return nil
+}
+
+func (u *UtilityContext) GetMessageClaimSignerCandidates(msg *typesUtil.MessageClaim) ([][]byte, types.Error) {
+	output, err := u.GetServiceNodeOutputAddress(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Address)
+	return candidates, nil
+}
+
+// ServeRelay meters a relay of the application on `chain` that the service node `servicer` is about to serve. It
+// returns an error if the service node isn't in the current session of the application or is out of relay budget
+func (u *UtilityModule) ServeRelay(servicer, appPublicKey []byte, chain string) error {
+	ctx, err := u.NewContext(u.getLatestHeight())
+	if err != nil {
+		return err
+	}
+	defer ctx.ReleaseContext()
+	if _, er := ctx.(*UtilityContext).MeterRelay(u.RelayMeter, servicer, appPublicKey, chain); er != nil {
+		return er
+	}
+	return nil
+}
+
+// MeterRelay counts a relay served by `servicer` in the current session of the application on `chain` and returns
+// the session, which the service node claims its relays in
+func (u *UtilityContext) MeterRelay(meter *RelayMeter, servicer, appPublicKey []byte, chain string) (*typesUtil.Session, types.Error) {
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return nil, err
+	}
+	session, err := u.GetSession(appPublicKey, chain, latestHeight)
+	if err != nil {
+		return nil, err
+	}
+	if !inSession(session, servicer) {
+		return nil, types.ErrNotInSession(servicer)
+	}
+	budget, err := u.GetSessionRelayBudget(session)
+	if err != nil {
+		return nil, err
+	}
+	if err := meter.Consume(session, budget); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// PruneRelayMeter forgets the relays of the sessions before the last one, so the meter of a service node doesn't grow
+// without bound. The relays of the last session are kept for its claim
+func (u *UtilityContext) PruneRelayMeter(meter *RelayMeter) types.Error {
+	blocksPerSession, err := u.GetBlocksPerSession()
+	if err != nil {
+		return err
+	}
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	sessionHeight := latestHeight - latestHeight%int64(blocksPerSession)
+	meter.Prune(sessionHeight - int64(blocksPerSession))
+	return nil
+}
+
+// RelayMeter counts the relays a service node served in each session; it is local to the service node
+type RelayMeter struct {
+	mutex    sync.Mutex
+	sessions map[string]*meteredSession // by hex session key
+}
+
+type meteredSession struct {
+	height int64
+	relays uint64
+}
+
+func NewRelayMeter() *RelayMeter {
+	return &RelayMeter{sessions: make(map[string]*meteredSession)}
+}
+
+// Consume counts a relay of the session, unless the session already used up the relay budget
+func (m *RelayMeter) Consume(session *typesUtil.Session, budget uint64) types.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	key := hex.EncodeToString(session.SessionKey)
+	metered, ok := m.sessions[key]
+	if !ok {
+		metered = &meteredSession{height: session.SessionHeader.SessionBlockHeight}
+		m.sessions[key] = metered
+	}
+	if metered.relays >= budget {
+		return types.ErrRelayBudgetExceeded(metered.relays+1, budget)
+	}
+	metered.relays++
+	return nil
+}
+
+// Relays returns the relays served in the session, which is what the service node claims
+func (m *RelayMeter) Relays(sessionKey []byte) uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if metered, ok := m.sessions[hex.EncodeToString(sessionKey)]; ok {
+		return metered.relays
+	}
+	return 0
+}
+
+// Delete forgets the session once its relays are claimed
+func (m *RelayMeter) Delete(sessionKey []byte) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.sessions, hex.EncodeToString(sessionKey))
+}
+
+// Prune forgets the sessions that began before `sessionHeight`
+func (m *RelayMeter) Prune(sessionHeight int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for key, metered := range m.sessions {
+		if metered.height < sessionHeight {
+			delete(m.sessions, key)
+		}
+	}
+}
+
+func inSession(session *typesUtil.Session, serviceNode []byte) bool {
+	for _, address := range session.ServiceNodes {
+		if bytes.Equal(address, serviceNode) {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *UtilityContext) GetClaimedRelays(sessionKey, address []byte) (uint64, types.Error) {
+	store := u.Store()
+	relays, er := store.GetClaimedRelays(sessionKey, address)
+	if er != nil {
+		return typesUtil.ZeroInt, types.ErrGetClaimedRelays(er)
+	}
+	return relays, nil
+}
+
+func (u *UtilityContext) SetClaimedRelays(sessionKey, address []byte, relays uint64) types.Error {
+	store := u.Store()
+	if er := store.SetClaimedRelays(sessionKey, address, relays); er != nil {
+		return types.ErrSetClaimedRelays(er)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package utility
+
+import (
+	"github.com/pokt-network/pocket/shared/types"
+)
+
+// The `RelayChains` param is the registry of the chains that may be served: apps, service nodes and fishermen can
+// only stake for a chain that is registered and enabled. Disabling a chain doesn't unstake the actors staked for it
+
+// checkRelayChains returns an error unless every chain is enabled in the registry
+func (u *UtilityContext) checkRelayChains(chains []string) types.Error {
+	registry, err := u.GetRelayChains()
+	if err != nil {
+		return err
+	}
+	enabled := make(map[string]bool, len(registry))
+	for _, chain := range registry {
+		enabled[chain.Id] = chain.Enabled
+	}
+	for _, chain := range chains {
+		isEnabled, registered := enabled[chain]
+		if !registered {
+			return types.ErrUnknownRelayChain(chain)
+		}
+		if !isEnabled {
+			return types.ErrDisabledRelayChain(chain)
+		}
+	}
+	return nil
+}
+
+// RelayChainActorCount is the number of actors of each type staked for a relay chain
+type RelayChainActorCount struct {
+	Apps         int
+	ServiceNodes int
+	Fishermen    int
+}
+
+// GetRelayChainActorCounts returns the actor counts of every chain of the registry, and of the chains that are no
+// longer registered but still have actors staked for them
+func (u *UtilityContext) GetRelayChainActorCounts() (map[string]*RelayChainActorCount, types.Error) {
+	registry, err := u.GetRelayChains()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]*RelayChainActorCount, len(registry))
+	for _, chain := range registry {
+		counts[chain.Id] = &RelayChainActorCount{}
+	}
+	count := func(chain string) *RelayChainActorCount {
+		if _, ok := counts[chain]; !ok {
+			counts[chain] = &RelayChainActorCount{}
+		}
+		return counts[chain]
+	}
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, types.ErrGetLatestHeight(er)
+	}
+	apps, er := store.GetAllApps(height)
+	if er != nil {
+		return nil, types.ErrGetAllApps(er)
+	}
+	for _, app := range apps {
+		for _, chain := range app.Chains {
+			count(chain).Apps++
+		}
+	}
+	serviceNodes, er := store.GetAllServiceNodes(height)
+	if er != nil {
+		return nil, types.ErrGetAllServiceNodes(er)
+	}
+	for _, serviceNode := range serviceNodes {
+		for _, chain := range serviceNode.Chains {
+			count(chain).ServiceNodes++
+		}
+	}
+	fishermen, er := store.GetAllFishermen(height)
+	if er != nil {
+		return nil, types.ErrGetAllFishermen(er)
+	}
+	for _, fisherman := range fishermen {
+		for _, chain := range fisherman.Chains {
+			count(chain).Fishermen++
+		}
+	}
+	return counts, nil
+}
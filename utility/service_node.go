@@ -1,8 +1,11 @@
 package utility
 
 import (
+	"math/big"
+
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
@@ -32,6 +35,10 @@ func (u *UtilityContext) HandleMessageStakeServiceNode(message *typesUtil.Messag
 	if signerAccountAmount.Sign() == -1 {
 		return types.ErrInsufficientAmountError()
 	}
+	// locked tokens may be staked if the stake returns to the vesting account
+	if err := u.checkLockedStake(message.Signer, message.OutputAddress, amount); err != nil {
+		return err
+	}
 	maxChains, err := u.GetServiceNodeMaxChains()
 	if err != nil {
 		return err
@@ -40,6 +47,10 @@ func (u *UtilityContext) HandleMessageStakeServiceNode(message *typesUtil.Messag
 	if len(message.Chains) > maxChains {
 		return types.ErrMaxChains(maxChains)
 	}
+	// the chains must be enabled in the relay chain registry
+	if err := u.checkRelayChains(message.Chains); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.SetAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -60,6 +71,7 @@ func (u *UtilityContext) HandleMessageStakeServiceNode(message *typesUtil.Messag
 	if err := u.InsertServiceNode(publicKey.Address(), message.PublicKey, message.OutputAddress, message.ServiceUrl, message.Amount, message.Chains); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_STAKE, typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, publicKey.Address(), amount, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -84,6 +96,14 @@ func (u *UtilityContext) HandleMessageEditStakeServiceNode(message *typesUtil.Me
 	if signerAccountAmount.Sign() == -1 {
 		return types.ErrInsufficientAmountError()
 	}
+	// locked tokens may be staked if the stake returns to the vesting account
+	output, err := u.GetServiceNodeOutputAddress(message.Address)
+	if err != nil {
+		return err
+	}
+	if err := u.checkLockedStake(message.Signer, output, amountToAdd); err != nil {
+		return err
+	}
 	maxChains, err := u.GetServiceNodeMaxChains()
 	if err != nil {
 		return err
@@ -92,6 +112,10 @@ func (u *UtilityContext) HandleMessageEditStakeServiceNode(message *typesUtil.Me
 	if len(message.Chains) > maxChains {
 		return types.ErrMaxChains(maxChains)
 	}
+	// the chains must be enabled in the relay chain registry
+	if err := u.checkRelayChains(message.Chains); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.SetAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -104,6 +128,7 @@ func (u *UtilityContext) HandleMessageEditStakeServiceNode(message *typesUtil.Me
 	if err := u.UpdateServiceNode(message.Address, message.ServiceUrl, message.AmountToAdd, message.Chains); err != nil {
 		return err
 	}
+	u.emitActorEvent(typesUtil.EventType_EVENT_TYPE_EDIT_STAKE, typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, message.Address, amountToAdd)
 	return nil
 }
 
@@ -123,6 +148,55 @@ func (u *UtilityContext) HandleMessageUnstakeServiceNode(message *typesUtil.Mess
 	if err := u.SetServiceNodeUnstakingHeightAndStatus(message.Address, unstakingHeight); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_BEGIN_UNSTAKE, typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
+	return nil
+}
+
+// HandleMessagePartialUnstakeServiceNode lowers the stake of the service node, which stays staked, and queues the
+// difference for release to the output address after the unstaking period
+func (u *UtilityContext) HandleMessagePartialUnstakeServiceNode(message *typesUtil.MessagePartialUnstakeServiceNode) types.Error {
+	status, err := u.GetServiceNodeStatus(message.Address)
+	if err != nil {
+		return err
+	}
+	// validate is staked
+	if status != typesUtil.StakedStatus {
+		return types.ErrInvalidStatus(status, typesUtil.StakedStatus)
+	}
+	stakedTokens, err := u.GetServiceNodeStakedTokens(message.Address)
+	if err != nil {
+		return err
+	}
+	minStake, err := u.GetServiceNodeMinimumStake()
+	if err != nil {
+		return err
+	}
+	amount, remaining, err := partialUnstakeAmounts(stakedTokens, message.Amount, minStake)
+	if err != nil {
+		return err
+	}
+	if err := u.SetServiceNodeStakedTokens(message.Address, remaining); err != nil {
+		return err
+	}
+	unstakingHeight, err := u.CalculateServiceNodeUnstakingHeight()
+	if err != nil {
+		return err
+	}
+	output, err := u.GetServiceNodeOutputAddress(message.Address)
+	if err != nil {
+		return err
+	}
+	if err := u.QueueUnbondingStake(message.Address, output, nil, amount, unstakingHeight, typesUtil.ServiceNodeStakePoolName); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_PARTIAL_UNSTAKE,
+		ActorType: typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE,
+		Address:   message.Address,
+		Recipient: output,
+		Pool:      typesUtil.ServiceNodeStakePoolName,
+		Amount:    types.BigIntToString(amount),
+	})
 	return nil
 }
 
@@ -141,6 +215,15 @@ func (u *UtilityContext) UnstakeServiceNodesThatAreReady() types.Error {
 		if err := u.DeleteServiceNode(serviceNode.GetAddress()); err != nil {
 			return err
 		}
+		u.emitEvent(&typesUtil.Event{
+			Type:        typesUtil.EventType_EVENT_TYPE_UNSTAKE,
+			ActorType:   typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE,
+			Address:     serviceNode.GetAddress(),
+			Recipient:   serviceNode.GetOutputAddress(),
+			Pool:        typesUtil.ServiceNodeStakePoolName,
+			Amount:      serviceNode.GetStakeAmount(),
+			StatusCause: typesUtil.StatusCause_STATUS_CAUSE_UNSTAKING_PERIOD,
+		})
 	}
 	return nil
 }
@@ -179,6 +262,7 @@ func (u *UtilityContext) HandleMessagePauseServiceNode(message *typesUtil.Messag
 	if err := u.SetServiceNodePauseHeight(message.Address, height); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_PAUSE, typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -204,9 +288,22 @@ func (u *UtilityContext) HandleMessageUnpauseServiceNode(message *typesUtil.Mess
 	if err := u.SetServiceNodePauseHeight(message.Address, typesUtil.ZeroInt); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_UNPAUSE, typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
+func (u *UtilityContext) GetServiceNode(address []byte) (*typesGenesis.ServiceNode, types.Error) {
+	store := u.Store()
+	serviceNode, exists, er := store.GetServiceNode(address)
+	if er != nil {
+		return nil, types.ErrGetExists(er)
+	}
+	if !exists {
+		return nil, types.ErrNotExists()
+	}
+	return serviceNode, nil
+}
+
 func (u *UtilityContext) GetServiceNodeExists(address []byte) (bool, types.Error) {
 	store := u.Store()
 	exists, er := store.GetServiceNodeExists(address)
@@ -261,13 +358,42 @@ func (u *UtilityContext) UnstakeServiceNodesPausedBefore(pausedBeforeHeight int6
 	if err != nil {
 		return err
 	}
+	wasUnstaking, err := u.getUnstakingServiceNodes()
+	if err != nil {
+		return err
+	}
 	er := store.SetServiceNodesStatusAndUnstakingHeightPausedBefore(pausedBeforeHeight, unstakingHeight, typesUtil.UnstakingStatus)
 	if er != nil {
 		return types.ErrSetStatusPausedBefore(er, pausedBeforeHeight)
 	}
+	unstaking, err := u.getUnstakingServiceNodes()
+	if err != nil {
+		return err
+	}
+	u.emitBeginUnstakeEvents(typesUtil.ActorType_ACTOR_TYPE_SERVICE_NODE, wasUnstaking, unstaking)
 	return nil
 }
 
+// getUnstakingServiceNodes returns the addresses of the unstaking service nodes, in the order of the store
+func (u *UtilityContext) getUnstakingServiceNodes() ([][]byte, types.Error) {
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, types.ErrGetLatestHeight(er)
+	}
+	serviceNodes, er := store.GetAllServiceNodes(height)
+	if er != nil {
+		return nil, types.ErrGetAllServiceNodes(er)
+	}
+	var unstaking [][]byte
+	for _, serviceNode := range serviceNodes {
+		if serviceNode.Status == typesUtil.UnstakingStatus {
+			unstaking = append(unstaking, serviceNode.Address)
+		}
+	}
+	return unstaking, nil
+}
+
 func (u *UtilityContext) GetServiceNodeStatus(address []byte) (int, types.Error) {
 	store := u.Store()
 	status, er := store.GetServiceNodeStatus(address)
@@ -365,6 +491,17 @@ func (u *UtilityContext) GetMessageUnstakeServiceNodeSignerCandidates(msg *types
 	return candidates, nil
 }
 
+func (u *UtilityContext) GetMessagePartialUnstakeServiceNodeSignerCandidates(msg *typesUtil.MessagePartialUnstakeServiceNode) ([][]byte, types.Error) {
+	output, err := u.GetServiceNodeOutputAddress(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Address)
+	return candidates, nil
+}
+
 func (u *UtilityContext) GetMessageUnpauseServiceNodeSignerCandidates(msg *typesUtil.MessageUnpauseServiceNode) ([][]byte, types.Error) {
 	output, err := u.GetServiceNodeOutputAddress(msg.Address)
 	if err != nil {
@@ -395,3 +532,21 @@ func (u *UtilityContext) GetServiceNodeOutputAddress(operator []byte) ([]byte, t
 	}
 	return output, nil
 }
+
+func (u *UtilityContext) GetServiceNodeStakedTokens(address []byte) (*big.Int, types.Error) {
+	store := u.Store()
+	stakedTokens, er := store.GetServiceNodeStakedTokens(address)
+	if er != nil {
+		return nil, types.ErrGetStakedTokens(er)
+	}
+	return types.StringToBigInt(stakedTokens)
+}
+
+func (u *UtilityContext) SetServiceNodeStakedTokens(address []byte, tokens *big.Int) types.Error {
+	store := u.Store()
+	er := store.SetServiceNodeStakedTokens(address, types.BigIntToString(tokens))
+	if er != nil {
+		return types.ErrSetStakedTokens(er)
+	}
+	return nil
+}
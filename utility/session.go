@@ -0,0 +1,167 @@
+package utility
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// A session pairs an application with the service nodes that serve its relays on a chain for BlocksPerSession blocks.
+// The service nodes are drawn without replacement from the staked, unpaused and unjailed service nodes of the chain,
+// each weighted by its stake times its QoS score. The score counts as at least the ServiceNodeSessionQoSFloor, so
+// new service nodes, which haven't been tested yet, and poorly scored ones still get traffic. Every draw is derived
+// from the session key and the state at the session height, so every node computes the same session
+
+// GetSession returns the session of the application on `chain` that `height` belongs to
+func (u *UtilityContext) GetSession(appPublicKey []byte, chain string, height int64) (*typesUtil.Session, types.Error) {
+	blocksPerSession, err := u.GetBlocksPerSession()
+	if err != nil {
+		return nil, err
+	}
+	sessionHeight := height - height%int64(blocksPerSession)
+	sessionKey := GetSessionKey(appPublicKey, chain, sessionHeight)
+	// the session is computed from the state at its height, so it stays the same while it lasts
+	store := u.Store()
+	params, er := store.GetParams(sessionHeight)
+	if er != nil {
+		return nil, types.ErrGetParam(typesUtil.ServiceNodesPerSessionParamName, er)
+	}
+	if params == nil {
+		return nil, types.ErrInvalidSessionHeight(sessionHeight)
+	}
+	numOfServiceNodes, floor := int(params.GetServiceNodesPerSession()), int(params.GetServiceNodeSessionQosFloor())
+	allServiceNodes, er := store.GetAllServiceNodes(sessionHeight)
+	if er != nil {
+		return nil, types.ErrGetAllServiceNodes(er)
+	}
+	var candidates []*typesGenesis.ServiceNode
+	for _, serviceNode := range allServiceNodes {
+		if serviceNode.Status != typesUtil.StakedStatus || serviceNode.Paused || IsServiceNodeJailed(serviceNode, sessionHeight) {
+			continue
+		}
+		if containsChain(serviceNode.Chains, chain) {
+			candidates = append(candidates, serviceNode)
+		}
+	}
+	serviceNodes, err := SelectSessionServiceNodes(sessionKey, candidates, floor, numOfServiceNodes)
+	if err != nil {
+		return nil, err
+	}
+	session := &typesUtil.Session{
+		SessionHeader: &typesUtil.SessionHeader{
+			AppPublicKey:       appPublicKey,
+			Chain:              chain,
+			SessionBlockHeight: sessionHeight,
+		},
+		SessionKey: sessionKey,
+	}
+	for _, serviceNode := range serviceNodes {
+		session.ServiceNodes = append(session.ServiceNodes, serviceNode.Address)
+	}
+	allFishermen, er := store.GetAllFishermen(sessionHeight)
+	if er != nil {
+		return nil, types.ErrGetAllFishermen(er)
+	}
+	var fishermen [][]byte
+	for _, fisherman := range allFishermen {
+		if fisherman.Status == typesUtil.StakedStatus && !fisherman.Paused && containsChain(fisherman.Chains, chain) {
+			fishermen = append(fishermen, fisherman.Address)
+		}
+	}
+	if len(fishermen) != typesUtil.ZeroInt {
+		// the draw after the service nodes picks the fisherman uniformly
+		i := sessionDraw(sessionKey, numOfServiceNodes, big.NewInt(int64(len(fishermen))))
+		session.Fishermen = fishermen[i.Int64()]
+	}
+	return session, nil
+}
+
+// GetSessionOfHeader returns the session of a header in a message, which must be the header of a session that has
+// begun
+func (u *UtilityContext) GetSessionOfHeader(header *typesUtil.SessionHeader) (*typesUtil.Session, types.Error) {
+	blocksPerSession, err := u.GetBlocksPerSession()
+	if err != nil {
+		return nil, err
+	}
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return nil, err
+	}
+	if header.SessionBlockHeight < 0 || header.SessionBlockHeight > latestHeight || header.SessionBlockHeight%int64(blocksPerSession) != 0 {
+		return nil, types.ErrInvalidSessionHeight(header.SessionBlockHeight)
+	}
+	return u.GetSession(header.AppPublicKey, header.Chain, header.SessionBlockHeight)
+}
+
+// GetSessionKey returns the seed of the draws of the session
+// TODO (Team) seed with the block hash at `sessionHeight` once blocks are persisted, so the key isn't known in advance
+func GetSessionKey(appPublicKey []byte, chain string, sessionHeight int64) []byte {
+	return crypto.SHA3Hash(bytes.Join([][]byte{appPublicKey, []byte(chain), types.Int64ToBytes(sessionHeight)}, nil))
+}
+
+// SelectSessionServiceNodes draws up to `n` of the candidates without replacement, weighted by stake times QoS score,
+// with the score raised to `floor` if it is lower or the service node has no score yet. The result only depends on
+// the session key and the set of candidates, not on their order
+func SelectSessionServiceNodes(sessionKey []byte, candidates []*typesGenesis.ServiceNode, floor, n int) ([]*typesGenesis.ServiceNode, types.Error) {
+	remaining := make([]*typesGenesis.ServiceNode, len(candidates))
+	copy(remaining, candidates)
+	sort.Slice(remaining, func(i, j int) bool {
+		return bytes.Compare(remaining[i].Address, remaining[j].Address) < 0
+	})
+	weights := make([]*big.Int, len(remaining))
+	totalWeight := big.NewInt(0)
+	for i, serviceNode := range remaining {
+		weight, err := sessionWeight(serviceNode, floor)
+		if err != nil {
+			return nil, err
+		}
+		weights[i] = weight
+		totalWeight.Add(totalWeight, weight)
+	}
+	var selected []*typesGenesis.ServiceNode
+	for draw := 0; draw < n && totalWeight.Sign() == 1; draw++ {
+		r := sessionDraw(sessionKey, draw, totalWeight)
+		i := 0
+		for ; r.Cmp(weights[i]) >= 0; i++ {
+			r.Sub(r, weights[i])
+		}
+		selected = append(selected, remaining[i])
+		totalWeight.Sub(totalWeight, weights[i])
+		remaining = append(remaining[:i], remaining[i+1:]...)
+		weights = append(weights[:i], weights[i+1:]...)
+	}
+	return selected, nil
+}
+
+// sessionWeight returns the stake of the service node times its QoS score, floored at `floor`
+func sessionWeight(serviceNode *typesGenesis.ServiceNode, floor int) (*big.Int, types.Error) {
+	stake, err := types.StringToBigInt(serviceNode.StakedTokens)
+	if err != nil {
+		return nil, err
+	}
+	score := int64(serviceNode.QosScore)
+	if serviceNode.QosSamples == typesUtil.ZeroInt || score < int64(floor) {
+		score = int64(floor)
+	}
+	return stake.Mul(stake, big.NewInt(score)), nil
+}
+
+// sessionDraw returns the `draw`th pseudo-random number of the session, in [0, max)
+func sessionDraw(sessionKey []byte, draw int, max *big.Int) *big.Int {
+	hash := crypto.SHA3Hash(bytes.Join([][]byte{sessionKey, types.Int64ToBytes(int64(draw))}, nil))
+	return big.NewInt(0).Mod(big.NewInt(0).SetBytes(hash), max)
+}
+
+func containsChain(chains []string, chain string) bool {
+	for _, c := range chains {
+		if c == chain {
+			return true
+		}
+	}
+	return false
+}
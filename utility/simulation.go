@@ -0,0 +1,86 @@
+package utility
+
+import (
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+var simulationSavePointKey = []byte("simulation")
+
+// SimulateTransaction dry-runs the transaction on a throwaway context at the latest height without adding it to the
+// mempool. When `skipSignatureCheck` is set only the public key of the signer is required, so wallets can simulate
+// a transaction before signing it.
+func (u *UtilityModule) SimulateTransaction(transactionProtoBytes []byte, skipSignatureCheck bool) (*types.SimulationResult, error) {
+	ctx, err := u.NewContext(u.getLatestHeight())
+	if err != nil {
+		return nil, err
+	}
+	defer ctx.ReleaseContext()
+	result, er := ctx.(*UtilityContext).SimulateTransaction(transactionProtoBytes, skipSignatureCheck)
+	if er != nil {
+		return nil, er
+	}
+	return result, nil
+}
+
+// SimulateTransaction applies the transaction under a save point and reverts it, leaving the context state untouched.
+// Errors that make the transaction invalid before it is applied are returned, while errors of the application itself
+// are part of the result. As in a block, a transaction whose message fails still pays its fee and uses up its sequence,
+// so those changes are reported, while a transaction that fails the ante handler changes nothing
+func (u *UtilityContext) SimulateTransaction(transactionProtoBytes []byte, skipSignatureCheck bool) (*types.SimulationResult, types.Error) {
+	tx, err := typesUtil.TransactionFromBytes(transactionProtoBytes)
+	if err != nil {
+		return nil, err
+	}
+	if skipSignatureCheck {
+		err = tx.ValidateBasicWithoutSignature()
+	} else {
+		err = tx.ValidateBasic()
+	}
+	if err != nil {
+		return nil, err
+	}
+	msg, err := tx.Message()
+	if err != nil {
+		return nil, err
+	}
+	fee, err := u.GetFee(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.NewSavePoint(simulationSavePointKey); err != nil {
+		return nil, err
+	}
+	stateChanges := make([]*types.StateChange, 0)
+	events := make([]*typesUtil.Event, 0)
+	eventsBefore := len(u.Context.Events)
+	msg, txErr := u.AnteHandleMessage(tx)
+	if txErr == nil {
+		txErr = u.applyMessage(tx, msg)
+		store := u.Store()
+		changes, er := store.GetStateChangesSinceSavePoint(simulationSavePointKey)
+		if er != nil {
+			return nil, types.ErrGetStateChanges(er)
+		}
+		stateChanges = changes
+		events = u.EventsSince(eventsBefore)
+	}
+	if err := u.RevertToSavePoint(simulationSavePointKey); err != nil {
+		return nil, err
+	}
+	result, err := tx.Result(u.LatestHeight, 0, txErr)
+	if err != nil {
+		return nil, err
+	}
+	result.Events = events
+	resultAny, err := u.Codec().ToAny(result)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SimulationResult{
+		Result:       resultAny,
+		Error:        txErr,
+		Fee:          fee,
+		StateChanges: stateChanges,
+	}, nil
+}
@@ -0,0 +1,251 @@
+package utility
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// Tokens only move between accounts and pools, so the total supply may only change through the mint and burn
+// functions below. The token invariants check this after every block when enabled (see `UtilityConfig`)
+
+func (u *UtilityContext) GetTotalSupply() (*big.Int, types.Error) {
+	store := u.Store()
+	amount, er := store.GetTotalSupply()
+	if er != nil {
+		return nil, types.ErrGetTotalSupply(er)
+	}
+	return types.StringToBigInt(amount)
+}
+
+func (u *UtilityContext) addTotalSupply(amount *big.Int) types.Error {
+	totalSupply, err := u.GetTotalSupply()
+	if err != nil {
+		return err
+	}
+	store := u.Store()
+	if er := store.SetTotalSupply(types.BigIntToString(totalSupply.Add(totalSupply, amount))); er != nil {
+		return types.ErrSetTotalSupply(er)
+	}
+	return nil
+}
+
+// MintToAccount creates `amount` new tokens in the account
+func (u *UtilityContext) MintToAccount(address []byte, amount *big.Int) types.Error {
+	if err := u.AddAccountAmount(address, amount); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{Type: typesUtil.EventType_EVENT_TYPE_MINT, Recipient: address, Amount: eventAmount(amount)})
+	return u.addTotalSupply(amount)
+}
+
+// MintToPool creates `amount` new tokens in the pool
+func (u *UtilityContext) MintToPool(name string, amount *big.Int) types.Error {
+	if err := u.AddPoolAmount(name, amount); err != nil {
+		return err
+	}
+	u.emitPoolEvent(typesUtil.EventType_EVENT_TYPE_MINT, name, nil, amount)
+	return u.addTotalSupply(amount)
+}
+
+// BurnFromPool destroys `amount` tokens of the pool, held for the actor at `address`
+func (u *UtilityContext) BurnFromPool(name string, address []byte, amount *big.Int) types.Error {
+	if err := u.SubPoolAmount(name, types.BigIntToString(amount)); err != nil {
+		return err
+	}
+	u.emitPoolEvent(typesUtil.EventType_EVENT_TYPE_BURN, name, address, amount)
+	return u.addTotalSupply(new(big.Int).Neg(amount))
+}
+
+// slashReward is the share of a slash paid to whoever reported the infraction instead of being burned
+type slashReward struct {
+	recipient  []byte
+	percentage int
+}
+
+// slashFromPool takes `amount` tokens of the pool, held for the actor at `address`: the share of the `reward`, if any,
+// is paid to its recipient out of the slashed tokens and the rest is burned
+func (u *UtilityContext) slashFromPool(name string, address []byte, amount *big.Int, reward *slashReward) types.Error {
+	if reward != nil {
+		paid := types.PercentageOf(amount, reward.percentage)
+		if err := u.SubPoolAmount(name, types.BigIntToString(paid)); err != nil {
+			return err
+		}
+		if err := u.AddAccountAmount(reward.recipient, paid); err != nil {
+			return err
+		}
+		u.emitEvent(&typesUtil.Event{Type: typesUtil.EventType_EVENT_TYPE_REWARD, Recipient: reward.recipient, Amount: eventAmount(paid)})
+		amount = new(big.Int).Sub(amount, paid)
+	}
+	return u.BurnFromPool(name, address, amount)
+}
+
+// TokenInvariantReport is the diagnostic of a token invariant check
+type TokenInvariantReport struct {
+	Height      int64
+	TotalSupply *big.Int
+	Accounts    *big.Int            // the sum of every account
+	Pools       map[string]*big.Int // the amount of every pool
+	Stakes      map[string]*big.Int // the sum of the staked tokens of the actors of each stake pool and of the unbonding stakes
+	Violations  []string
+}
+
+func (r *TokenInvariantReport) Holds() bool {
+	return len(r.Violations) == 0
+}
+
+func (r *TokenInvariantReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "height: %d\ntotal supply: %s\naccounts: %s\n", r.Height, r.TotalSupply, r.Accounts)
+	for _, name := range sortedKeys(r.Pools) {
+		fmt.Fprintf(&sb, "pool %s: %s\n", name, r.Pools[name])
+	}
+	for _, name := range sortedKeys(r.Stakes) {
+		fmt.Fprintf(&sb, "stakes of %s: %s\n", name, r.Stakes[name])
+	}
+	for _, violation := range r.Violations {
+		fmt.Fprintf(&sb, "violation: %s\n", violation)
+	}
+	return sb.String()
+}
+
+// CheckTokenInvariants confirms that the accounts and pools add up to the total supply, and that each stake pool
+// holds exactly the tokens staked by its actors and the unbonding pool exactly the unbonding stakes
+func (u *UtilityContext) CheckTokenInvariants() (*TokenInvariantReport, types.Error) {
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, types.ErrGetLatestHeight(er)
+	}
+	totalSupply, err := u.GetTotalSupply()
+	if err != nil {
+		return nil, err
+	}
+	report := &TokenInvariantReport{
+		Height:      height,
+		TotalSupply: totalSupply,
+		Accounts:    big.NewInt(0),
+		Pools:       make(map[string]*big.Int),
+		Stakes:      make(map[string]*big.Int),
+		Violations:  make([]string, 0),
+	}
+	accounts, er := store.GetAllAccounts(height)
+	if er != nil {
+		return nil, types.ErrGetAllAccounts(er)
+	}
+	for _, account := range accounts {
+		if err := addAmountString(report.Accounts, account.Amount); err != nil {
+			return nil, err
+		}
+	}
+	pools, er := store.GetAllPools(height)
+	if er != nil {
+		return nil, types.ErrGetAllPools(er)
+	}
+	sum := new(big.Int).Set(report.Accounts)
+	for _, pool := range pools {
+		amount, err := types.StringToBigInt(pool.Account.Amount)
+		if err != nil {
+			return nil, err
+		}
+		report.Pools[pool.Name] = amount
+		sum.Add(sum, amount)
+	}
+	if sum.Cmp(totalSupply) != 0 {
+		report.Violations = append(report.Violations, fmt.Sprintf("accounts and pools add up to %s, expected the total supply of %s", sum, totalSupply))
+	}
+	if err := u.sumStakes(report.Stakes, height); err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(report.Stakes) {
+		staked := report.Stakes[name]
+		pooled, ok := report.Pools[name]
+		if !ok {
+			pooled = big.NewInt(0)
+		}
+		if staked.Cmp(pooled) != 0 {
+			report.Violations = append(report.Violations, fmt.Sprintf("actors of %s stake %s, but the pool holds %s", name, staked, pooled))
+		}
+	}
+	return report, nil
+}
+
+func (u *UtilityContext) sumStakes(stakes map[string]*big.Int, height int64) types.Error {
+	store := u.Store()
+	for _, name := range []string{typesUtil.AppStakePoolName, typesUtil.ServiceNodeStakePoolName, typesUtil.FishermanStakePoolName, typesUtil.ValidatorStakePoolName} {
+		stakes[name] = big.NewInt(0)
+	}
+	apps, er := store.GetAllApps(height)
+	if er != nil {
+		return types.ErrGetAllApps(er)
+	}
+	for _, app := range apps {
+		if err := addAmountString(stakes[typesUtil.AppStakePoolName], app.StakedTokens); err != nil {
+			return err
+		}
+	}
+	serviceNodes, er := store.GetAllServiceNodes(height)
+	if er != nil {
+		return types.ErrGetAllServiceNodes(er)
+	}
+	for _, serviceNode := range serviceNodes {
+		if err := addAmountString(stakes[typesUtil.ServiceNodeStakePoolName], serviceNode.StakedTokens); err != nil {
+			return err
+		}
+	}
+	fishermen, er := store.GetAllFishermen(height)
+	if er != nil {
+		return types.ErrGetAllFishermen(er)
+	}
+	for _, fisherman := range fishermen {
+		if err := addAmountString(stakes[typesUtil.FishermanStakePoolName], fisherman.StakedTokens); err != nil {
+			return err
+		}
+	}
+	validators, er := store.GetAllValidators(height)
+	if er != nil {
+		return types.ErrGetAllValidators(er)
+	}
+	// the validator stake pool also holds the tokens delegated to the validators
+	for _, validator := range validators {
+		votingPower, err := validator.GetVotingPower()
+		if err != nil {
+			return err
+		}
+		stakes[typesUtil.ValidatorStakePoolName].Add(stakes[typesUtil.ValidatorStakePoolName], votingPower)
+	}
+	// the unbonding pool holds the partially unstaked tokens until they are released
+	stakes[typesUtil.UnbondingPoolName] = big.NewInt(0)
+	unbondingStakes, err := u.GetAllUnbondingStakes()
+	if err != nil {
+		return err
+	}
+	for _, unbondingStake := range unbondingStakes {
+		if err := addAmountString(stakes[typesUtil.UnbondingPoolName], unbondingStake.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addAmountString(sum *big.Int, amount string) types.Error {
+	a, err := types.StringToBigInt(amount)
+	if err != nil {
+		return err
+	}
+	sum.Add(sum, a)
+	return nil
+}
+
+func sortedKeys(amounts map[string]*big.Int) []string {
+	keys := make([]string, 0, len(amounts))
+	for key := range amounts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
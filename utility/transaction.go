@@ -2,18 +2,48 @@ package utility
 
 import (
 	"bytes"
+	"math/big"
 
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
+var proposalSavePointKey = []byte("proposal")
+
+const (
+	batchSavePointPrefix   = "batch/"
+	messageSavePointPrefix = "message/"
+)
+
 func (u *UtilityContext) ApplyTransaction(tx *typesUtil.Transaction) types.Error {
 	msg, err := u.AnteHandleMessage(tx)
 	if err != nil {
 		return err
 	}
-	return u.HandleMessage(msg)
+	return u.applyMessage(tx, msg)
+}
+
+// applyMessage handles the message of a transaction that passed the ante handler under a save point of its own, so a
+// failed message is reverted without reverting the fee and the sequence of the transaction
+func (u *UtilityContext) applyMessage(tx *typesUtil.Transaction, msg typesUtil.Message) types.Error {
+	if batch, ok := msg.(*typesUtil.MessageBatch); ok {
+		return u.applyBatch(tx, batch)
+	}
+	hash, err := tx.Hash()
+	if err != nil {
+		return err
+	}
+	if err := u.NewSavePoint([]byte(messageSavePointPrefix + hash)); err != nil {
+		return err
+	}
+	if err := u.HandleMessage(msg); err != nil {
+		if er := u.RevertLastSavePoint(); er != nil {
+			return er
+		}
+		return err
+	}
+	return u.ReleaseLastSavePoint()
 }
 
 func (u *UtilityContext) CheckTransaction(transactionProtoBytes []byte) error {
@@ -23,7 +53,7 @@ func (u *UtilityContext) CheckTransaction(transactionProtoBytes []byte) error {
 		return types.ErrDuplicateTransaction()
 	}
 	store := u.Store()
-	if store.TransactionExists(txHash) { // TODO non-ordered nonce requires non-pruned tx indexer
+	if store.TransactionExists(txHash) { // replays are prevented by the signer's sequence; this only rejects them early
 		return types.ErrTransactionAlreadyCommitted()
 	}
 	cdc := u.Codec()
@@ -34,25 +64,65 @@ func (u *UtilityContext) CheckTransaction(transactionProtoBytes []byte) error {
 	if err := transaction.ValidateBasic(); err != nil {
 		return err
 	}
+	// transactions ahead of the signer's sequence are held in the mempool until their turn
+	signer, err := transaction.Signer()
+	if err != nil {
+		return err
+	}
+	sequence, err := u.GetAccountSequence(signer)
+	if err != nil {
+		return err
+	}
+	if transaction.Sequence < sequence {
+		return types.ErrSequenceTooLow(sequence, transaction.Sequence)
+	}
+	// the fee is checked against the current state, as in `AnteHandleMessage`, so a transaction that can't pay isn't
+	// admitted only to be evicted on the next recheck
+	msg, err := transaction.Message()
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	minimumFee, err := u.GetFee(msg)
+	if err != nil {
+		return err
+	}
+	fee, err := types.StringToBigInt(transaction.Fee)
+	if err != nil {
+		return err
+	}
+	if fee.Cmp(minimumFee) < 0 {
+		return types.ErrInsufficientFee(transaction.Fee, types.BigIntToString(minimumFee))
+	}
+	spendable, err := u.GetSpendableAmount(signer)
+	if err != nil {
+		return err
+	}
+	if spendable.Cmp(fee) < 0 {
+		return types.ErrInsufficientAmountError()
+	}
 	// store in mempool
 	return u.Mempool.AddTransaction(transactionProtoBytes)
 }
 
 func (u *UtilityContext) GetTransactionsForProposal(proposer []byte, maxTransactionBytes int, lastBlockByzantineValidators [][]byte) ([][]byte, error) {
+	// the proposal is a dry run used to select the valid transactions; `ApplyBlock` executes them against the reverted state
+	if err := u.NewSavePoint(proposalSavePointKey); err != nil {
+		return nil, err
+	}
 	if err := u.BeginBlock(lastBlockByzantineValidators); err != nil {
 		return nil, err
 	}
 	transactions := make([][]byte, 0)
+	held := make([][]byte, 0) // transactions ahead of their signer's sequence
 	totalSizeInBytes := 0
 	for u.Mempool.Size() != typesUtil.ZeroInt {
 		txBytes, err := u.Mempool.PopTransaction()
 		if err != nil {
 			return nil, err
 		}
-		transaction, err := typesUtil.TransactionFromBytes(txBytes)
-		if err != nil {
-			return nil, err
-		}
 		txSizeInBytes := len(txBytes)
 		totalSizeInBytes += txSizeInBytes
 		if totalSizeInBytes >= maxTransactionBytes {
@@ -63,67 +133,200 @@ func (u *UtilityContext) GetTransactionsForProposal(proposer []byte, maxTransact
 			}
 			break // we've reached our max
 		}
-		err = u.ApplyTransaction(transaction)
+		txErr, err := u.tryApplyTransaction(txBytes)
 		if err != nil {
-			if err := u.RevertLastSavePoint(); err != nil { // TODO (Andrew) Properly implement 'unhappy path' for save points
-				return nil, err
-			}
+			return nil, err
+		}
+		if txErr != nil {
 			totalSizeInBytes -= txSizeInBytes
+			if txErr.Code() == types.CodeSequenceTooHighError {
+				held = append(held, txBytes)
+			}
+			continue
 		}
 		transactions = append(transactions, txBytes)
 	}
+	// a held transaction becomes valid once the transactions before it in its signer's sequence are applied
+	for applied := true; applied; {
+		applied = false
+		for i := 0; i < len(held); i++ {
+			txBytes := held[i]
+			if totalSizeInBytes+len(txBytes) >= maxTransactionBytes {
+				continue
+			}
+			txErr, err := u.tryApplyTransaction(txBytes)
+			if err != nil {
+				return nil, err
+			}
+			if txErr != nil && txErr.Code() == types.CodeSequenceTooHighError {
+				continue
+			}
+			held = append(held[:i], held[i+1:]...)
+			i--
+			if txErr != nil {
+				continue
+			}
+			totalSizeInBytes += len(txBytes)
+			transactions = append(transactions, txBytes)
+			applied = true
+		}
+	}
+	// the transactions still ahead of their signer's sequence wait in the mempool for a future block
+	for _, txBytes := range held {
+		if err := u.Mempool.AddTransaction(txBytes); err != nil {
+			return nil, err
+		}
+	}
 	if err := u.EndBlock(proposer); err != nil {
 		return nil, err
 	}
+	if err := u.RevertToSavePoint(proposalSavePointKey); err != nil {
+		return nil, err
+	}
 	return transactions, nil
 }
 
+// tryApplyTransaction applies the transaction under its own save point and reverts it if it fails.
+// `txErr` is the error of the transaction itself, while `err` is fatal to the caller
+func (u *UtilityContext) tryApplyTransaction(txBytes []byte) (txErr types.Error, err types.Error) {
+	transaction, err := typesUtil.TransactionFromBytes(txBytes)
+	if err != nil {
+		return err, nil
+	}
+	if err := u.NewSavePoint(crypto.SHA3Hash(txBytes)); err != nil {
+		return nil, err
+	}
+	if txErr = u.ApplyTransaction(transaction); txErr != nil {
+		if err := u.RevertLastSavePoint(); err != nil {
+			return nil, err
+		}
+		return txErr, nil
+	}
+	return nil, u.ReleaseLastSavePoint()
+}
+
 func (u *UtilityContext) AnteHandleMessage(tx *typesUtil.Transaction) (typesUtil.Message, types.Error) {
 	msg, err := tx.Message()
 	if err != nil {
 		return nil, err
 	}
-	fee, err := u.GetFee(msg) // TODO this enforces exact fee spent regardless of what's put in field... should we remove the fee field from transaction?
+	// the transaction only decodes its message, so the message (and each message of a batch) is validated here
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// the governance fee of the message is the minimum; anything paid above it is a tip for the block proposer
+	minimumFee, err := u.GetFee(msg)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := types.StringToBigInt(tx.Fee)
 	if err != nil {
 		return nil, err
 	}
-	pubKey, er := crypto.NewPublicKeyFromBytes(tx.Signature.PublicKey)
-	if er != nil {
-		return nil, types.ErrNewPublicKeyFromBytes(er)
+	if fee.Cmp(minimumFee) < 0 {
+		return nil, types.ErrInsufficientFee(tx.Fee, types.BigIntToString(minimumFee))
+	}
+	tip := new(big.Int).Sub(fee, minimumFee)
+	// the address of the signing key, or of the multisig whose keys signed
+	address, err := tx.Signer()
+	if err != nil {
+		return nil, err
+	}
+	// the sequence prevents replays without keeping every transaction in the index
+	sequence, err := u.GetAccountSequence(address)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Sequence < sequence {
+		return nil, types.ErrSequenceTooLow(sequence, tx.Sequence)
+	}
+	if tx.Sequence > sequence {
+		return nil, types.ErrSequenceTooHigh(sequence, tx.Sequence)
 	}
-	address := pubKey.Address()
 	accountAmount, err := u.GetAccountAmount(address)
 	if err != nil {
 		return nil, types.ErrGetAccountAmount(err)
 	}
-	accountAmount.Sub(accountAmount, fee)
-	if accountAmount.Sign() == -1 {
-		return nil, types.ErrInsufficientAmountError()
-	}
-	signerCandidates, err := u.GetSignerCandidates(msg)
+	// fees are paid from the tokens that aren't locked by a vesting schedule
+	spendable, err := u.GetSpendableAmount(address)
 	if err != nil {
 		return nil, err
 	}
-	var isValidSigner bool
-	for _, candidate := range signerCandidates {
-		if bytes.Equal(candidate, address) {
-			isValidSigner = true
-			break
-		}
+	if spendable.Cmp(fee) < 0 {
+		return nil, types.ErrInsufficientAmountError()
 	}
-	if !isValidSigner {
-		return nil, types.ErrInvalidSigner()
+	accountAmount.Sub(accountAmount, fee)
+	if err := u.checkSigner(msg, address); err != nil {
+		return nil, err
+	}
+	if err := u.SetAccountSequence(address, sequence+1); err != nil {
+		return nil, err
 	}
 	if err := u.SetAccountAmount(address, accountAmount); err != nil {
 		return nil, err
 	}
-	if err := u.AddPoolAmount(typesUtil.FeePoolName, fee); err != nil {
+	if batch, ok := msg.(*typesUtil.MessageBatch); ok {
+		err = u.distributeBatchFee(batch, tip)
+	} else {
+		err = u.DistributeFee(msg, minimumFee, tip)
+	}
+	if err != nil {
 		return nil, err
 	}
 	msg.SetSigner(address)
 	return msg, nil
 }
 
+// checkSigner returns an error if `address` isn't a signer candidate of the message in the current state
+func (u *UtilityContext) checkSigner(msg typesUtil.Message, address []byte) types.Error {
+	signerCandidates, err := u.GetSignerCandidates(msg)
+	if err != nil {
+		return err
+	}
+	for _, candidate := range signerCandidates {
+		if bytes.Equal(candidate, address) {
+			return nil
+		}
+	}
+	return types.ErrInvalidSigner()
+}
+
+// DistributeFee splits the minimum fee of the message between the owner of its fee param, the DAO and the block
+// proposer by `FeeOwnerPercentageOfFees` and `ProposerPercentageOfFees`, with the DAO receiving the rest including
+// any rounding. The proposer's share and the whole tip accrue in the FEE_POOL until `HandleProposalRewards`. The two
+// percentages add up to at most 100, which is checked whenever the params change (see `ValidateParamLimits`)
+func (u *UtilityContext) DistributeFee(msg typesUtil.Message, minimumFee, tip *big.Int) types.Error {
+	proposerPercentage, err := u.GetProposerPercentageOfFees()
+	if err != nil {
+		return err
+	}
+	feeOwnerPercentage, err := u.GetFeeOwnerPercentageOfFees()
+	if err != nil {
+		return err
+	}
+	feeOwner, err := u.GetFeeOwner(msg)
+	if err != nil {
+		return err
+	}
+	amountToProposer := types.PercentageOf(minimumFee, proposerPercentage)
+	amountToFeeOwner := types.PercentageOf(minimumFee, feeOwnerPercentage)
+	amountToDAO := new(big.Int).Sub(minimumFee, amountToProposer)
+	amountToDAO.Sub(amountToDAO, amountToFeeOwner)
+	if err := u.AddAccountAmount(feeOwner, amountToFeeOwner); err != nil {
+		return err
+	}
+	if err := u.AddPoolAmount(typesUtil.DAOPoolName, amountToDAO); err != nil {
+		return err
+	}
+	if err := u.AddPoolAmount(typesUtil.FeePoolName, amountToProposer.Add(amountToProposer, tip)); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{Type: typesUtil.EventType_EVENT_TYPE_FEE, Recipient: feeOwner, Amount: eventAmount(amountToFeeOwner)})
+	u.emitPoolEvent(typesUtil.EventType_EVENT_TYPE_FEE, typesUtil.DAOPoolName, nil, amountToDAO)
+	u.emitPoolEvent(typesUtil.EventType_EVENT_TYPE_FEE, typesUtil.FeePoolName, nil, amountToProposer)
+	return nil
+}
+
 func (u *UtilityContext) HandleMessage(msg typesUtil.Message) types.Error {
 	switch x := msg.(type) {
 	case *typesUtil.MessageDoubleSign:
@@ -136,14 +339,18 @@ func (u *UtilityContext) HandleMessage(msg typesUtil.Message) types.Error {
 		return u.HandleMessageEditStakeFisherman(x)
 	case *typesUtil.MessageUnstakeFisherman:
 		return u.HandleMessageUnstakeFisherman(x)
+	case *typesUtil.MessagePartialUnstakeFisherman:
+		return u.HandleMessagePartialUnstakeFisherman(x)
 	case *typesUtil.MessagePauseFisherman:
 		return u.HandleMessagePauseFisherman(x)
 	case *typesUtil.MessageUnpauseFisherman:
 		return u.HandleMessageUnpauseFisherman(x)
 	case *typesUtil.MessageFishermanPauseServiceNode:
 		return u.HandleMessageFishermanPauseServiceNode(x)
-	//case *types.MessageTestScore:
-	//	return u.HandleMessageTestScore(x)
+	case *typesUtil.MessageTestScore:
+		return u.HandleMessageTestScore(x)
+	case *typesUtil.MessageClaim:
+		return u.HandleMessageClaim(x)
 	//case *types.MessageProveTestScore:
 	//	return u.HandleMessageProveTestScore(x)
 	case *typesUtil.MessageStakeApp:
@@ -152,6 +359,8 @@ func (u *UtilityContext) HandleMessage(msg typesUtil.Message) types.Error {
 		return u.HandleMessageEditStakeApp(x)
 	case *typesUtil.MessageUnstakeApp:
 		return u.HandleMessageUnstakeApp(x)
+	case *typesUtil.MessagePartialUnstakeApp:
+		return u.HandleMessagePartialUnstakeApp(x)
 	case *typesUtil.MessagePauseApp:
 		return u.HandleMessagePauseApp(x)
 	case *typesUtil.MessageUnpauseApp:
@@ -162,22 +371,40 @@ func (u *UtilityContext) HandleMessage(msg typesUtil.Message) types.Error {
 		return u.HandleMessageEditStakeValidator(x)
 	case *typesUtil.MessageUnstakeValidator:
 		return u.HandleMessageUnstakeValidator(x)
+	case *typesUtil.MessagePartialUnstakeValidator:
+		return u.HandleMessagePartialUnstakeValidator(x)
 	case *typesUtil.MessagePauseValidator:
 		return u.HandleMessagePauseValidator(x)
 	case *typesUtil.MessageUnpauseValidator:
 		return u.HandleMessageUnpauseValidator(x)
+	case *typesUtil.MessageDelegate:
+		return u.HandleMessageDelegate(x)
+	case *typesUtil.MessageUndelegate:
+		return u.HandleMessageUndelegate(x)
+	case *typesUtil.MessageRedelegate:
+		return u.HandleMessageRedelegate(x)
+	case *typesUtil.MessageCreateVestingAccount:
+		return u.HandleMessageCreateVestingAccount(x)
+	case *typesUtil.MessageUpgrade:
+		return u.HandleMessageUpgrade(x)
 	case *typesUtil.MessageStakeServiceNode:
 		return u.HandleMessageStakeServiceNode(x)
 	case *typesUtil.MessageEditStakeServiceNode:
 		return u.HandleMessageEditStakeServiceNode(x)
 	case *typesUtil.MessageUnstakeServiceNode:
 		return u.HandleMessageUnstakeServiceNode(x)
+	case *typesUtil.MessagePartialUnstakeServiceNode:
+		return u.HandleMessagePartialUnstakeServiceNode(x)
 	case *typesUtil.MessagePauseServiceNode:
 		return u.HandleMessagePauseServiceNode(x)
 	case *typesUtil.MessageUnpauseServiceNode:
 		return u.HandleMessageUnpauseServiceNode(x)
 	case *typesUtil.MessageChangeParameter:
 		return u.HandleMessageChangeParameter(x)
+	case *typesUtil.MessageSubmitProposal:
+		return u.HandleMessageSubmitProposal(x)
+	case *typesUtil.MessageVoteProposal:
+		return u.HandleMessageVoteProposal(x)
 	default:
 		return types.ErrUnknownMessage(x)
 	}
@@ -195,14 +422,18 @@ func (u *UtilityContext) GetSignerCandidates(msg typesUtil.Message) ([][]byte, t
 		return u.GetMessageEditStakeFishermanSignerCandidates(x)
 	case *typesUtil.MessageUnstakeFisherman:
 		return u.GetMessageUnstakeFishermanSignerCandidates(x)
+	case *typesUtil.MessagePartialUnstakeFisherman:
+		return u.GetMessagePartialUnstakeFishermanSignerCandidates(x)
 	case *typesUtil.MessagePauseFisherman:
 		return u.GetMessagePauseFishermanSignerCandidates(x)
 	case *typesUtil.MessageUnpauseFisherman:
 		return u.GetMessageUnpauseFishermanSignerCandidates(x)
 	case *typesUtil.MessageFishermanPauseServiceNode:
 		return u.GetMessageFishermanPauseServiceNodeSignerCandidates(x)
-	//case *types.MessageTestScore:
-	//	return u.GetMessageTestScoreSignerCandidates(x)
+	case *typesUtil.MessageTestScore:
+		return u.GetMessageTestScoreSignerCandidates(x)
+	case *typesUtil.MessageClaim:
+		return u.GetMessageClaimSignerCandidates(x)
 	//case *types.MessageProveTestScore:
 	//	return u.GetMessageProveTestScoreSignerCandidates(x)
 	case *typesUtil.MessageStakeApp:
@@ -211,6 +442,8 @@ func (u *UtilityContext) GetSignerCandidates(msg typesUtil.Message) ([][]byte, t
 		return u.GetMessageEditStakeAppSignerCandidates(x)
 	case *typesUtil.MessageUnstakeApp:
 		return u.GetMessageUnstakeAppSignerCandidates(x)
+	case *typesUtil.MessagePartialUnstakeApp:
+		return u.GetMessagePartialUnstakeAppSignerCandidates(x)
 	case *typesUtil.MessagePauseApp:
 		return u.GetMessagePauseAppSignerCandidates(x)
 	case *typesUtil.MessageUnpauseApp:
@@ -221,23 +454,118 @@ func (u *UtilityContext) GetSignerCandidates(msg typesUtil.Message) ([][]byte, t
 		return u.GetMessageEditStakeValidatorSignerCandidates(x)
 	case *typesUtil.MessageUnstakeValidator:
 		return u.GetMessageUnstakeValidatorSignerCandidates(x)
+	case *typesUtil.MessagePartialUnstakeValidator:
+		return u.GetMessagePartialUnstakeValidatorSignerCandidates(x)
 	case *typesUtil.MessagePauseValidator:
 		return u.GetMessagePauseValidatorSignerCandidates(x)
 	case *typesUtil.MessageUnpauseValidator:
 		return u.GetMessageUnpauseValidatorSignerCandidates(x)
+	case *typesUtil.MessageDelegate:
+		return u.GetMessageDelegateSignerCandidates(x)
+	case *typesUtil.MessageUndelegate:
+		return u.GetMessageUndelegateSignerCandidates(x)
+	case *typesUtil.MessageRedelegate:
+		return u.GetMessageRedelegateSignerCandidates(x)
 	case *typesUtil.MessageStakeServiceNode:
 		return u.GetMessageStakeServiceNodeSignerCandidates(x)
 	case *typesUtil.MessageEditStakeServiceNode:
 		return u.GetMessageEditStakeServiceNodeSignerCandidates(x)
 	case *typesUtil.MessageUnstakeServiceNode:
 		return u.GetMessageUnstakeServiceNodeSignerCandidates(x)
+	case *typesUtil.MessagePartialUnstakeServiceNode:
+		return u.GetMessagePartialUnstakeServiceNodeSignerCandidates(x)
 	case *typesUtil.MessagePauseServiceNode:
 		return u.GetMessagePauseServiceNodeSignerCandidates(x)
 	case *typesUtil.MessageUnpauseServiceNode:
 		return u.GetMessageUnpauseServiceNodeSignerCandidates(x)
 	case *typesUtil.MessageChangeParameter:
 		return u.GetMessageChangeParameterSignerCandidates(x)
+	case *typesUtil.MessageSubmitProposal:
+		return u.GetMessageSubmitProposalSignerCandidates(x)
+	case *typesUtil.MessageVoteProposal:
+		return u.GetMessageVoteProposalSignerCandidates(x)
+	case *typesUtil.MessageBatch:
+		return u.GetMessageBatchSignerCandidates(x)
+	case *typesUtil.MessageCreateVestingAccount:
+		return u.GetMessageCreateVestingAccountSignerCandidates(x)
+	case *typesUtil.MessageUpgrade:
+		return u.GetMessageUpgradeSignerCandidates(x)
 	default:
 		return nil, types.ErrUnknownMessage(x)
 	}
 }
+
+// StoreTransaction indexes the result of the transaction at position `index` of the block being applied.
+// `txErr` is the error returned while applying the transaction, if any, and `events` the events it recorded
+func (u *UtilityContext) StoreTransaction(transactionHash string, tx *typesUtil.Transaction, index int, txErr types.Error, events []*typesUtil.Event) types.Error {
+	store := u.Store()
+	result, err := tx.Result(u.LatestHeight, index, txErr)
+	if err != nil {
+		return err
+	}
+	result.Events = events
+	bz, er := u.Codec().Marshal(result)
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.StoreTransaction(transactionHash, result.Height, index, result.Signer, result.Recipient, bz); er != nil {
+		return types.ErrStoreTransaction(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) GetTransactionByHash(transactionHash string) (*typesUtil.TransactionResult, types.Error) {
+	store := u.Store()
+	bz, er := store.GetTransactionByHash(transactionHash)
+	if er != nil {
+		return nil, types.ErrGetTransaction(er)
+	}
+	return u.transactionResultFromBytes(bz)
+}
+
+func (u *UtilityContext) GetTransactionsBySigner(signer []byte, page, perPage int) ([]*typesUtil.TransactionResult, int, types.Error) {
+	store := u.Store()
+	results, totalCount, er := store.GetTransactionsBySigner(signer, page, perPage)
+	if er != nil {
+		return nil, 0, types.ErrGetTransaction(er)
+	}
+	return u.transactionResultsFromBytes(results, totalCount)
+}
+
+func (u *UtilityContext) GetTransactionsByRecipient(recipient []byte, page, perPage int) ([]*typesUtil.TransactionResult, int, types.Error) {
+	store := u.Store()
+	results, totalCount, er := store.GetTransactionsByRecipient(recipient, page, perPage)
+	if er != nil {
+		return nil, 0, types.ErrGetTransaction(er)
+	}
+	return u.transactionResultsFromBytes(results, totalCount)
+}
+
+func (u *UtilityContext) GetTransactionsByHeight(height int64, page, perPage int) ([]*typesUtil.TransactionResult, int, types.Error) {
+	store := u.Store()
+	results, totalCount, er := store.GetTransactionsByHeight(height, page, perPage)
+	if er != nil {
+		return nil, 0, types.ErrGetTransaction(er)
+	}
+	return u.transactionResultsFromBytes(results, totalCount)
+}
+
+func (u *UtilityContext) transactionResultsFromBytes(resultsBz [][]byte, totalCount int) ([]*typesUtil.TransactionResult, int, types.Error) {
+	results := make([]*typesUtil.TransactionResult, 0, len(resultsBz))
+	for _, bz := range resultsBz {
+		result, err := u.transactionResultFromBytes(bz)
+		if err != nil {
+			return nil, 0, err
+		}
+		results = append(results, result)
+	}
+	return results, totalCount, nil
+}
+
+func (u *UtilityContext) transactionResultFromBytes(bz []byte) (*typesUtil.TransactionResult, types.Error) {
+	result := &typesUtil.TransactionResult{}
+	if err := u.Codec().Unmarshal(bz, result); err != nil {
+		return nil, types.ErrProtoUnmarshal(err)
+	}
+	return result, nil
+}
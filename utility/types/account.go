@@ -11,6 +11,8 @@ const (
 	FishermanStakePoolName   = "FISHERMAN_STAKE_POOL"
 	DAOPoolName              = "DAO_POOL"
 	FeePoolName              = "FEE_POOL"
+	GovDepositPoolName       = "GOV_DEPOSIT_POOL"
+	UnbondingPoolName        = "UNBONDING_POOL"
 	UnstakingStatus          = 1
 	StakedStatus             = 2
 )
\ No newline at end of file
@@ -1,120 +1,207 @@
 package types
 
+import typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+
+// the governance param keys are declared alongside the param registry in the genesis package
 const (
-	BlocksPerSessionParamName = "BlocksPerSession"
-
-	AppMinimumStakeParamName        = "AppMinimumStake"
-	AppMaxChainsParamName           = "AppMaximumChains"
-	AppBaselineStakeRateParamName   = "AppStakeRate"
-	AppStabilityAdjustmentParamName = "AppStakingAdjustment"
-	AppUnstakingBlocksParamName     = "AppUnstakingBlocks"
-	AppMinimumPauseBlocksParamName  = "AppMinimumPauseBlocks"
-	AppMaxPauseBlocksParamName      = "AppMaxPauseBlocks"
-
-	ServiceNodeMinimumStakeParamName       = "ServiceNodeMinimumStake"
-	ServiceNodeMaxChainsParamName          = "ServiceNodeMaximumChains"
-	ServiceNodeUnstakingBlocksParamName    = "ServiceNodeUnstakingBlocks"
-	ServiceNodeMinimumPauseBlocksParamName = "ServiceNodeMinimumPauseBlocks"
-	ServiceNodeMaxPauseBlocksParamName     = "ServiceNodeMaxPauseBlocks"
-	ServiceNodesPerSessionParamName        = "ServiceNodesPerSession"
-
-	FishermanMinimumStakeParamName       = "FishermanMinimumStake"
-	FishermanMaxChainsParamName          = "FishermanMaximumChains"
-	FishermanUnstakingBlocksParamName    = "FishermanUnstakingBlocks"
-	FishermanMinimumPauseBlocksParamName = "FishermanMinimumPauseBlocks"
-	FishermanMaxPauseBlocksParamName     = "FishermanMaxPauseBlocks"
-
-	ValidatorMinimumStakeParamName        = "ValidatorMinimumStake"
-	ValidatorUnstakingBlocksParamName     = "ValidatorUnstakingBlocks"
-	ValidatorMinimumPauseBlocksParamName  = "ValidatorMinimumPauseBlocks"
-	ValidatorMaxPausedBlocksParamName     = "ValidatorMaxPauseBlocks"
-	ValidatorMaximumMissedBlocksParamName = "ValidatorMaximumMissedBlocks"
-
-	ValidatorMaxEvidenceAgeInBlocksParamName = "ValidatorMaxEvidenceAgeInBlocks"
-	ProposerPercentageOfFeesParamName        = "ProposerPercentageOfFees"
-	MissedBlocksBurnPercentageParamName      = "MissedBlocksBurnPercentage"
-	DoubleSignBurnPercentageParamName        = "DoubleSignPercentage"
-
-	MessageDoubleSignFee                = "MessageDoubleSignFee"
-	MessageSendFee                      = "MessageSendFee"
-	MessageStakeFishermanFee            = "MessageStakeFishermanFee"
-	MessageEditStakeFishermanFee        = "MessageEditStakeFishermanFee"
-	MessageUnstakeFishermanFee          = "MessageUnstakeFishermanFee"
-	MessagePauseFishermanFee            = "MessagePauseFishermanFee"
-	MessageUnpauseFishermanFee          = "MessageUnpauseFishermanFee"
-	MessageFishermanPauseServiceNodeFee = "MessageFishermanPauseServiceNodeFee"
-	MessageTestScoreFee                 = "MessageTestScoreFee"
-	MessageProveTestScoreFee            = "MessageProveTestScoreFee"
-	MessageStakeAppFee                  = "MessageStakeAppFee"
-	MessageEditStakeAppFee              = "MessageEditStakeAppFee"
-	MessageUnstakeAppFee                = "MessageUnstakeAppFee"
-	MessagePauseAppFee                  = "MessagePauseAppFee"
-	MessageUnpauseAppFee                = "MessageUnpauseAppFee"
-	MessageStakeValidatorFee            = "MessageStakeValidatorFee"
-	MessageEditStakeValidatorFee        = "MessageEditStakeValidatorFee"
-	MessageUnstakeValidatorFee          = "MessageUnstakeValidatorFee"
-	MessagePauseValidatorFee            = "MessagePauseValidatorFee"
-	MessageUnpauseValidatorFee          = "MessageUnpauseValidatorFee"
-	MessageStakeServiceNodeFee          = "MessageStakeServiceNodeFee"
-	MessageEditStakeServiceNodeFee      = "MessageEditStakeServiceNodeFee"
-	MessageUnstakeServiceNodeFee        = "MessageUnstakeServiceNodeFee"
-	MessagePauseServiceNodeFee          = "MessagePauseServiceNodeFee"
-	MessageUnpauseServiceNodeFee        = "MessageUnpauseServiceNodeFee"
-	MessageChangeParameterFee           = "MessageChangeParameterFee"
-
-	AclOwner                                 = "AclOwner"
-	BlocksPerSessionOwner                    = "BlocksPerSessionOwner"
-	AppMinimumStakeOwner                     = "AppMinimumStakeOwner"
-	AppMaxChainsOwner                        = "AppMaxChainsOwner"
-	AppBaselineStakeRateOwner                = "AppBaselineStakeRateOwner"
-	AppStakingAdjustmentOwner                = "AppStakingAdjustmentOwner"
-	AppUnstakingBlocksOwner                  = "AppUnstakingBlocksOwner"
-	AppMinimumPauseBlocksOwner               = "AppMinimumPauseBlocksOwner"
-	AppMaxPausedBlocksOwner                  = "AppMaxPausedBlocksOwner"
-	ServiceNodeMinimumStakeOwner             = "ServiceNodeMinimumStakeOwner"
-	ServiceNodeMaxChainsOwner                = "ServiceNodeMaxChainsOwner"
-	ServiceNodeUnstakingBlocksOwner          = "ServiceNodeUnstakingBlocksOwner"
-	ServiceNodeMinimumPauseBlocksOwner       = "ServiceNodeMinimumPauseBlocksOwner"
-	ServiceNodeMaxPausedBlocksOwner          = "ServiceNodeMaxPausedBlocksOwner"
-	ServiceNodesPerSessionOwner              = "ServiceNodesPerSessionOwner"
-	FishermanMinimumStakeOwner               = "FishermanMinimumStakeOwner"
-	FishermanMaxChainsOwner                  = "FishermanMaxChainsOwner"
-	FishermanUnstakingBlocksOwner            = "FishermanUnstakingBlocksOwner"
-	FishermanMinimumPauseBlocksOwner         = "FishermanMinimumPauseBlocksOwner"
-	FishermanMaxPausedBlocksOwner            = "FishermanMaxPausedBlocksOwner"
-	ValidatorMinimumStakeOwner               = "ValidatorMinimumStakeOwner"
-	ValidatorUnstakingBlocksOwner            = "ValidatorUnstakingBlocksOwner"
-	ValidatorMinimumPauseBlocksOwner         = "ValidatorMinimumPauseBlocksOwner"
-	ValidatorMaxPausedBlocksOwner            = "ValidatorMaxPausedBlocksOwner"
-	ValidatorMaximumMissedBlocksOwner        = "ValidatorMaximumMissedBlocksOwner"
-	ValidatorMaxEvidenceAgeInBlocksOwner     = "ValidatorMaxEvidenceAgeInBlocksOwner"
-	ProposerPercentageOfFeesOwner            = "ProposerPercentageOfFeesOwner"
-	MissedBlocksBurnPercentageOwner          = "MissedBlocksBurnPercentageOwner"
-	DoubleSignBurnPercentageOwner            = "DoubleSignBurnPercentageOwner"
-	MessageDoubleSignFeeOwner                = "MessageDoubleSignFeeOwner"
-	MessageSendFeeOwner                      = "MessageSendFeeOwner"
-	MessageStakeFishermanFeeOwner            = "MessageStakeFishermanFeeOwner"
-	MessageEditStakeFishermanFeeOwner        = "MessageEditStakeFishermanFeeOwner"
-	MessageUnstakeFishermanFeeOwner          = "MessageUnstakeFishermanFeeOwner"
-	MessagePauseFishermanFeeOwner            = "MessagePauseFishermanFeeOwner"
-	MessageUnpauseFishermanFeeOwner          = "MessageUnpauseFishermanFeeOwner"
-	MessageFishermanPauseServiceNodeFeeOwner = "MessageFishermanPauseServiceNodeFeeOwner"
-	MessageTestScoreFeeOwner                 = "MessageTestScoreFeeOwner"
-	MessageProveTestScoreFeeOwner            = "MessageProveTestScoreFeeOwner"
-	MessageStakeAppFeeOwner                  = "MessageStakeAppFeeOwner"
-	MessageEditStakeAppFeeOwner              = "MessageEditStakeAppFeeOwner"
-	MessageUnstakeAppFeeOwner                = "MessageUnstakeAppFeeOwner"
-	MessagePauseAppFeeOwner                  = "MessagePauseAppFeeOwner"
-	MessageUnpauseAppFeeOwner                = "MessageUnpauseAppFeeOwner"
-	MessageStakeValidatorFeeOwner            = "MessageStakeValidatorFeeOwner"
-	MessageEditStakeValidatorFeeOwner        = "MessageEditStakeValidatorFeeOwner"
-	MessageUnstakeValidatorFeeOwner          = "MessageUnstakeValidatorFeeOwner"
-	MessagePauseValidatorFeeOwner            = "MessagePauseValidatorFeeOwner"
-	MessageUnpauseValidatorFeeOwner          = "MessageUnpauseValidatorFeeOwner"
-	MessageStakeServiceNodeFeeOwner          = "MessageStakeServiceNodeFeeOwner"
-	MessageEditStakeServiceNodeFeeOwner      = "MessageEditStakeServiceNodeFeeOwner"
-	MessageUnstakeServiceNodeFeeOwner        = "MessageUnstakeServiceNodeFeeOwner"
-	MessagePauseServiceNodeFeeOwner          = "MessagePauseServiceNodeFeeOwner"
-	MessageUnpauseServiceNodeFeeOwner        = "MessageUnpauseServiceNodeFeeOwner"
-	MessageChangeParameterFeeOwner           = "MessageChangeParameterFeeOwner"
+	BlocksPerSessionParamName = typesGenesis.BlocksPerSessionParamName
+
+	AppMinimumStakeParamName        = typesGenesis.AppMinimumStakeParamName
+	AppMaxChainsParamName           = typesGenesis.AppMaxChainsParamName
+	AppBaselineStakeRateParamName   = typesGenesis.AppBaselineStakeRateParamName
+	AppStabilityAdjustmentParamName = typesGenesis.AppStabilityAdjustmentParamName
+	AppUnstakingBlocksParamName     = typesGenesis.AppUnstakingBlocksParamName
+	AppMinimumPauseBlocksParamName  = typesGenesis.AppMinimumPauseBlocksParamName
+	AppMaxPauseBlocksParamName      = typesGenesis.AppMaxPauseBlocksParamName
+
+	ServiceNodeMinimumStakeParamName       = typesGenesis.ServiceNodeMinimumStakeParamName
+	ServiceNodeMaxChainsParamName          = typesGenesis.ServiceNodeMaxChainsParamName
+	ServiceNodeUnstakingBlocksParamName    = typesGenesis.ServiceNodeUnstakingBlocksParamName
+	ServiceNodeMinimumPauseBlocksParamName = typesGenesis.ServiceNodeMinimumPauseBlocksParamName
+	ServiceNodeMaxPauseBlocksParamName     = typesGenesis.ServiceNodeMaxPauseBlocksParamName
+	ServiceNodesPerSessionParamName        = typesGenesis.ServiceNodesPerSessionParamName
+
+	FishermanMinimumStakeParamName       = typesGenesis.FishermanMinimumStakeParamName
+	FishermanMaxChainsParamName          = typesGenesis.FishermanMaxChainsParamName
+	FishermanUnstakingBlocksParamName    = typesGenesis.FishermanUnstakingBlocksParamName
+	FishermanMinimumPauseBlocksParamName = typesGenesis.FishermanMinimumPauseBlocksParamName
+	FishermanMaxPauseBlocksParamName     = typesGenesis.FishermanMaxPauseBlocksParamName
+
+	ValidatorMinimumStakeParamName        = typesGenesis.ValidatorMinimumStakeParamName
+	ValidatorUnstakingBlocksParamName     = typesGenesis.ValidatorUnstakingBlocksParamName
+	ValidatorMinimumPauseBlocksParamName  = typesGenesis.ValidatorMinimumPauseBlocksParamName
+	ValidatorMaxPausedBlocksParamName     = typesGenesis.ValidatorMaxPausedBlocksParamName
+	ValidatorMaximumMissedBlocksParamName = typesGenesis.ValidatorMaximumMissedBlocksParamName
+
+	ValidatorMaxEvidenceAgeInBlocksParamName = typesGenesis.ValidatorMaxEvidenceAgeInBlocksParamName
+	ProposerPercentageOfFeesParamName        = typesGenesis.ProposerPercentageOfFeesParamName
+	MissedBlocksBurnPercentageParamName      = typesGenesis.MissedBlocksBurnPercentageParamName
+	DoubleSignBurnPercentageParamName        = typesGenesis.DoubleSignBurnPercentageParamName
+
+	MessageDoubleSignFee                = typesGenesis.MessageDoubleSignFee
+	MessageSendFee                      = typesGenesis.MessageSendFee
+	MessageStakeFishermanFee            = typesGenesis.MessageStakeFishermanFee
+	MessageEditStakeFishermanFee        = typesGenesis.MessageEditStakeFishermanFee
+	MessageUnstakeFishermanFee          = typesGenesis.MessageUnstakeFishermanFee
+	MessagePauseFishermanFee            = typesGenesis.MessagePauseFishermanFee
+	MessageUnpauseFishermanFee          = typesGenesis.MessageUnpauseFishermanFee
+	MessageFishermanPauseServiceNodeFee = typesGenesis.MessageFishermanPauseServiceNodeFee
+	MessageTestScoreFee                 = typesGenesis.MessageTestScoreFee
+	MessageProveTestScoreFee            = typesGenesis.MessageProveTestScoreFee
+	MessageStakeAppFee                  = typesGenesis.MessageStakeAppFee
+	MessageEditStakeAppFee              = typesGenesis.MessageEditStakeAppFee
+	MessageUnstakeAppFee                = typesGenesis.MessageUnstakeAppFee
+	MessagePauseAppFee                  = typesGenesis.MessagePauseAppFee
+	MessageUnpauseAppFee                = typesGenesis.MessageUnpauseAppFee
+	MessageStakeValidatorFee            = typesGenesis.MessageStakeValidatorFee
+	MessageEditStakeValidatorFee        = typesGenesis.MessageEditStakeValidatorFee
+	MessageUnstakeValidatorFee          = typesGenesis.MessageUnstakeValidatorFee
+	MessagePauseValidatorFee            = typesGenesis.MessagePauseValidatorFee
+	MessageUnpauseValidatorFee          = typesGenesis.MessageUnpauseValidatorFee
+	MessageStakeServiceNodeFee          = typesGenesis.MessageStakeServiceNodeFee
+	MessageEditStakeServiceNodeFee      = typesGenesis.MessageEditStakeServiceNodeFee
+	MessageUnstakeServiceNodeFee        = typesGenesis.MessageUnstakeServiceNodeFee
+	MessagePauseServiceNodeFee          = typesGenesis.MessagePauseServiceNodeFee
+	MessageUnpauseServiceNodeFee        = typesGenesis.MessageUnpauseServiceNodeFee
+	MessageChangeParameterFee           = typesGenesis.MessageChangeParameterFee
+
+	AclOwner                                 = typesGenesis.AclOwner
+	BlocksPerSessionOwner                    = typesGenesis.BlocksPerSessionOwner
+	AppMinimumStakeOwner                     = typesGenesis.AppMinimumStakeOwner
+	AppMaxChainsOwner                        = typesGenesis.AppMaxChainsOwner
+	AppBaselineStakeRateOwner                = typesGenesis.AppBaselineStakeRateOwner
+	AppStakingAdjustmentOwner                = typesGenesis.AppStakingAdjustmentOwner
+	AppUnstakingBlocksOwner                  = typesGenesis.AppUnstakingBlocksOwner
+	AppMinimumPauseBlocksOwner               = typesGenesis.AppMinimumPauseBlocksOwner
+	AppMaxPausedBlocksOwner                  = typesGenesis.AppMaxPausedBlocksOwner
+	ServiceNodeMinimumStakeOwner             = typesGenesis.ServiceNodeMinimumStakeOwner
+	ServiceNodeMaxChainsOwner                = typesGenesis.ServiceNodeMaxChainsOwner
+	ServiceNodeUnstakingBlocksOwner          = typesGenesis.ServiceNodeUnstakingBlocksOwner
+	ServiceNodeMinimumPauseBlocksOwner       = typesGenesis.ServiceNodeMinimumPauseBlocksOwner
+	ServiceNodeMaxPausedBlocksOwner          = typesGenesis.ServiceNodeMaxPausedBlocksOwner
+	ServiceNodesPerSessionOwner              = typesGenesis.ServiceNodesPerSessionOwner
+	FishermanMinimumStakeOwner               = typesGenesis.FishermanMinimumStakeOwner
+	FishermanMaxChainsOwner                  = typesGenesis.FishermanMaxChainsOwner
+	FishermanUnstakingBlocksOwner            = typesGenesis.FishermanUnstakingBlocksOwner
+	FishermanMinimumPauseBlocksOwner         = typesGenesis.FishermanMinimumPauseBlocksOwner
+	FishermanMaxPausedBlocksOwner            = typesGenesis.FishermanMaxPausedBlocksOwner
+	ValidatorMinimumStakeOwner               = typesGenesis.ValidatorMinimumStakeOwner
+	ValidatorUnstakingBlocksOwner            = typesGenesis.ValidatorUnstakingBlocksOwner
+	ValidatorMinimumPauseBlocksOwner         = typesGenesis.ValidatorMinimumPauseBlocksOwner
+	ValidatorMaxPausedBlocksOwner            = typesGenesis.ValidatorMaxPausedBlocksOwner
+	ValidatorMaximumMissedBlocksOwner        = typesGenesis.ValidatorMaximumMissedBlocksOwner
+	ValidatorMaxEvidenceAgeInBlocksOwner     = typesGenesis.ValidatorMaxEvidenceAgeInBlocksOwner
+	ProposerPercentageOfFeesOwner            = typesGenesis.ProposerPercentageOfFeesOwner
+	MissedBlocksBurnPercentageOwner          = typesGenesis.MissedBlocksBurnPercentageOwner
+	DoubleSignBurnPercentageOwner            = typesGenesis.DoubleSignBurnPercentageOwner
+	MessageDoubleSignFeeOwner                = typesGenesis.MessageDoubleSignFeeOwner
+	MessageSendFeeOwner                      = typesGenesis.MessageSendFeeOwner
+	MessageStakeFishermanFeeOwner            = typesGenesis.MessageStakeFishermanFeeOwner
+	MessageEditStakeFishermanFeeOwner        = typesGenesis.MessageEditStakeFishermanFeeOwner
+	MessageUnstakeFishermanFeeOwner          = typesGenesis.MessageUnstakeFishermanFeeOwner
+	MessagePauseFishermanFeeOwner            = typesGenesis.MessagePauseFishermanFeeOwner
+	MessageUnpauseFishermanFeeOwner          = typesGenesis.MessageUnpauseFishermanFeeOwner
+	MessageFishermanPauseServiceNodeFeeOwner = typesGenesis.MessageFishermanPauseServiceNodeFeeOwner
+	MessageTestScoreFeeOwner                 = typesGenesis.MessageTestScoreFeeOwner
+	MessageProveTestScoreFeeOwner            = typesGenesis.MessageProveTestScoreFeeOwner
+	MessageStakeAppFeeOwner                  = typesGenesis.MessageStakeAppFeeOwner
+	MessageEditStakeAppFeeOwner              = typesGenesis.MessageEditStakeAppFeeOwner
+	MessageUnstakeAppFeeOwner                = typesGenesis.MessageUnstakeAppFeeOwner
+	MessagePauseAppFeeOwner                  = typesGenesis.MessagePauseAppFeeOwner
+	MessageUnpauseAppFeeOwner                = typesGenesis.MessageUnpauseAppFeeOwner
+	MessageStakeValidatorFeeOwner            = typesGenesis.MessageStakeValidatorFeeOwner
+	MessageEditStakeValidatorFeeOwner        = typesGenesis.MessageEditStakeValidatorFeeOwner
+	MessageUnstakeValidatorFeeOwner          = typesGenesis.MessageUnstakeValidatorFeeOwner
+	MessagePauseValidatorFeeOwner            = typesGenesis.MessagePauseValidatorFeeOwner
+	MessageUnpauseValidatorFeeOwner          = typesGenesis.MessageUnpauseValidatorFeeOwner
+	MessageStakeServiceNodeFeeOwner          = typesGenesis.MessageStakeServiceNodeFeeOwner
+	MessageEditStakeServiceNodeFeeOwner      = typesGenesis.MessageEditStakeServiceNodeFeeOwner
+	MessageUnstakeServiceNodeFeeOwner        = typesGenesis.MessageUnstakeServiceNodeFeeOwner
+	MessagePauseServiceNodeFeeOwner          = typesGenesis.MessagePauseServiceNodeFeeOwner
+	MessageUnpauseServiceNodeFeeOwner        = typesGenesis.MessageUnpauseServiceNodeFeeOwner
+	MessageChangeParameterFeeOwner           = typesGenesis.MessageChangeParameterFeeOwner
+
+	GovVotingPeriodBlocksParamName      = typesGenesis.GovVotingPeriodBlocksParamName
+	GovMinimumDepositParamName          = typesGenesis.GovMinimumDepositParamName
+	GovQuorumPercentageParamName        = typesGenesis.GovQuorumPercentageParamName
+	GovPassThresholdPercentageParamName = typesGenesis.GovPassThresholdPercentageParamName
+	MessageSubmitProposalFee            = typesGenesis.MessageSubmitProposalFee
+	MessageVoteProposalFee              = typesGenesis.MessageVoteProposalFee
+
+	GovVotingPeriodBlocksOwner      = typesGenesis.GovVotingPeriodBlocksOwner
+	GovMinimumDepositOwner          = typesGenesis.GovMinimumDepositOwner
+	GovQuorumPercentageOwner        = typesGenesis.GovQuorumPercentageOwner
+	GovPassThresholdPercentageOwner = typesGenesis.GovPassThresholdPercentageOwner
+	MessageSubmitProposalFeeOwner   = typesGenesis.MessageSubmitProposalFeeOwner
+	MessageVoteProposalFeeOwner     = typesGenesis.MessageVoteProposalFeeOwner
+
+	DoubleSignReporterRewardPercentageParamName = typesGenesis.DoubleSignReporterRewardPercentageParamName
+
+	DoubleSignReporterRewardPercentageOwner = typesGenesis.DoubleSignReporterRewardPercentageOwner
+
+	FeeOwnerPercentageOfFeesParamName = typesGenesis.FeeOwnerPercentageOfFeesParamName
+
+	FeeOwnerPercentageOfFeesOwner = typesGenesis.FeeOwnerPercentageOfFeesOwner
+
+	BlockRewardAmountParamName                 = typesGenesis.BlockRewardAmountParamName
+	BlockRewardProposerPercentageParamName     = typesGenesis.BlockRewardProposerPercentageParamName
+	BlockRewardValidatorsPercentageParamName   = typesGenesis.BlockRewardValidatorsPercentageParamName
+	BlockRewardServiceNodesPercentageParamName = typesGenesis.BlockRewardServiceNodesPercentageParamName
+
+	BlockRewardAmountOwner                 = typesGenesis.BlockRewardAmountOwner
+	BlockRewardProposerPercentageOwner     = typesGenesis.BlockRewardProposerPercentageOwner
+	BlockRewardValidatorsPercentageOwner   = typesGenesis.BlockRewardValidatorsPercentageOwner
+	BlockRewardServiceNodesPercentageOwner = typesGenesis.BlockRewardServiceNodesPercentageOwner
+
+	MessagePartialUnstakeAppFee         = typesGenesis.MessagePartialUnstakeAppFee
+	MessagePartialUnstakeServiceNodeFee = typesGenesis.MessagePartialUnstakeServiceNodeFee
+	MessagePartialUnstakeFishermanFee   = typesGenesis.MessagePartialUnstakeFishermanFee
+	MessagePartialUnstakeValidatorFee   = typesGenesis.MessagePartialUnstakeValidatorFee
+
+	MessagePartialUnstakeAppFeeOwner         = typesGenesis.MessagePartialUnstakeAppFeeOwner
+	MessagePartialUnstakeServiceNodeFeeOwner = typesGenesis.MessagePartialUnstakeServiceNodeFeeOwner
+	MessagePartialUnstakeFishermanFeeOwner   = typesGenesis.MessagePartialUnstakeFishermanFeeOwner
+	MessagePartialUnstakeValidatorFeeOwner   = typesGenesis.MessagePartialUnstakeValidatorFeeOwner
+
+	MessageDelegateFee   = typesGenesis.MessageDelegateFee
+	MessageUndelegateFee = typesGenesis.MessageUndelegateFee
+	MessageRedelegateFee = typesGenesis.MessageRedelegateFee
+
+	MessageDelegateFeeOwner   = typesGenesis.MessageDelegateFeeOwner
+	MessageUndelegateFeeOwner = typesGenesis.MessageUndelegateFeeOwner
+	MessageRedelegateFeeOwner = typesGenesis.MessageRedelegateFeeOwner
+
+	MessageCreateVestingAccountFee = typesGenesis.MessageCreateVestingAccountFee
+
+	MessageCreateVestingAccountFeeOwner = typesGenesis.MessageCreateVestingAccountFeeOwner
+
+	RelayChainsParamName = typesGenesis.RelayChainsParamName
+
+	RelayChainsOwner = typesGenesis.RelayChainsOwner
+
+	MessageUpgradeFee = typesGenesis.MessageUpgradeFee
+
+	MessageUpgradeFeeOwner = typesGenesis.MessageUpgradeFeeOwner
+
+	UpgradeOwner = typesGenesis.UpgradeOwner
+
+	ServiceNodeQoSWindowParamName       = typesGenesis.ServiceNodeQoSWindowParamName
+	ServiceNodeMinimumQoSScoreParamName = typesGenesis.ServiceNodeMinimumQoSScoreParamName
+	ServiceNodeJailBlocksParamName      = typesGenesis.ServiceNodeJailBlocksParamName
+	ServiceNodeSlashPercentageParamName = typesGenesis.ServiceNodeSlashPercentageParamName
+	FishermanBountyPercentageParamName  = typesGenesis.FishermanBountyPercentageParamName
+
+	ServiceNodeQoSWindowOwner       = typesGenesis.ServiceNodeQoSWindowOwner
+	ServiceNodeMinimumQoSScoreOwner = typesGenesis.ServiceNodeMinimumQoSScoreOwner
+	ServiceNodeJailBlocksOwner      = typesGenesis.ServiceNodeJailBlocksOwner
+	ServiceNodeSlashPercentageOwner = typesGenesis.ServiceNodeSlashPercentageOwner
+	FishermanBountyPercentageOwner  = typesGenesis.FishermanBountyPercentageOwner
+
+	ServiceNodeSessionQoSFloorParamName = typesGenesis.ServiceNodeSessionQoSFloorParamName
+
+	ServiceNodeSessionQoSFloorOwner = typesGenesis.ServiceNodeSessionQoSFloorOwner
+
+	MessageClaimFee = typesGenesis.MessageClaimFee
+
+	MessageClaimFeeOwner = typesGenesis.MessageClaimFeeOwner
 )
@@ -63,6 +63,17 @@ func (msg *MessageUnstakeApp) SetSigner(signer []byte) {
 	msg.Signer = signer
 }
 
+func (msg *MessagePartialUnstakeApp) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	return ValidatePositiveAmount(msg.Amount)
+}
+
+func (msg *MessagePartialUnstakeApp) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
 func (msg *MessageUnpauseApp) ValidateBasic() types.Error {
 	return ValidateAddress(msg.Address)
 }
@@ -127,6 +138,17 @@ func (msg *MessageUnstakeServiceNode) SetSigner(signer []byte) {
 	msg.Signer = signer
 }
 
+func (msg *MessagePartialUnstakeServiceNode) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	return ValidatePositiveAmount(msg.Amount)
+}
+
+func (msg *MessagePartialUnstakeServiceNode) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
 func (msg *MessageUnpauseServiceNode) ValidateBasic() types.Error {
 	return ValidateAddress(msg.Address)
 }
@@ -170,6 +192,9 @@ func (msg *MessageChangeParameter) ValidateBasic() types.Error {
 	if msg.ParameterValue == nil {
 		return types.ErrEmptyParamValue()
 	}
+	if msg.ActivationHeight < 0 {
+		return types.ErrInvalidActivationHeight(msg.ActivationHeight)
+	}
 	if err := ValidateAddress(msg.Owner); err != nil {
 		return err
 	}
@@ -208,6 +233,17 @@ func (msg *MessageUnstakeFisherman) SetSigner(signer []byte) {
 	msg.Signer = signer
 }
 
+func (msg *MessagePartialUnstakeFisherman) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	return ValidatePositiveAmount(msg.Amount)
+}
+
+func (msg *MessagePartialUnstakeFisherman) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
 func (msg *MessageUnpauseFisherman) ValidateBasic() types.Error {
 	return ValidateAddress(msg.Address)
 }
@@ -235,6 +271,69 @@ func (msg *MessageFishermanPauseServiceNode) SetSigner(signer []byte) {
 	msg.Signer = signer
 }
 
+func (msg *MessageTestScore) ValidateBasic() types.Error {
+	if err := ValidatePublicKey(msg.GetSessionHeader().GetAppPublicKey()); err != nil {
+		return err
+	}
+	chain := RelayChain(msg.GetSessionHeader().GetChain())
+	if err := chain.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.Reporter); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	if msg.NumberOfSamples == 0 {
+		return types.ErrEmptyTestScore()
+	}
+	nullIndices := make(map[uint32]bool, len(msg.NullIndicies))
+	for _, index := range msg.NullIndicies {
+		if index >= msg.NumberOfSamples || nullIndices[index] {
+			return types.ErrInvalidNullIndex(index)
+		}
+		nullIndices[index] = true
+	}
+	return nil
+}
+
+// Score is the percentage of the samples the service node didn't fail
+func (msg *MessageTestScore) Score() (int, types.Error) {
+	if msg.NumberOfSamples == 0 {
+		return 0, types.ErrEmptyTestScore()
+	}
+	if uint32(len(msg.NullIndicies)) > msg.NumberOfSamples {
+		return 0, types.ErrInvalidNullIndex(msg.NumberOfSamples)
+	}
+	return int(msg.NumberOfSamples-uint32(len(msg.NullIndicies))) * 100 / int(msg.NumberOfSamples), nil
+}
+
+func (msg *MessageTestScore) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
+func (msg *MessageClaim) ValidateBasic() types.Error {
+	if err := ValidatePublicKey(msg.GetSessionHeader().GetAppPublicKey()); err != nil {
+		return err
+	}
+	chain := RelayChain(msg.GetSessionHeader().GetChain())
+	if err := chain.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	if msg.Relays == 0 {
+		return types.ErrZeroRelays()
+	}
+	return nil
+}
+
+func (msg *MessageClaim) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
 func (msg *MessageStakeValidator) ValidateBasic() types.Error {
 	if err := ValidateAmount(msg.Amount); err != nil {
 		return err
@@ -245,6 +344,9 @@ func (msg *MessageStakeValidator) ValidateBasic() types.Error {
 	if err := ValidateServiceUrl(msg.ServiceUrl); err != nil {
 		return err
 	}
+	if err := ValidateCommission(msg.Commission); err != nil {
+		return err
+	}
 	return ValidateOutputAddress(msg.OutputAddress)
 }
 
@@ -263,6 +365,9 @@ func (msg *MessageEditStakeValidator) ValidateBasic() types.Error {
 	if err := ValidateServiceUrl(msg.ServiceUrl); err != nil {
 		return err
 	}
+	if msg.Commission != nil {
+		return ValidateCommission(*msg.Commission)
+	}
 	return nil
 }
 
@@ -278,6 +383,17 @@ func (msg *MessageUnstakeValidator) SetSigner(signer []byte) {
 	msg.Signer = signer
 }
 
+func (msg *MessagePartialUnstakeValidator) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	return ValidatePositiveAmount(msg.Amount)
+}
+
+func (msg *MessagePartialUnstakeValidator) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
 func (msg *MessageUnpauseValidator) ValidateBasic() types.Error {
 	return ValidateAddress(msg.Address)
 }
@@ -294,6 +410,90 @@ func (msg *MessagePauseValidator) SetSigner(signer []byte) {
 	msg.Signer = signer
 }
 
+func (msg *MessageDelegate) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Delegator); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.Validator); err != nil {
+		return err
+	}
+	return ValidatePositiveAmount(msg.Amount)
+}
+
+func (msg *MessageDelegate) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
+func (msg *MessageUndelegate) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Delegator); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.Validator); err != nil {
+		return err
+	}
+	return ValidatePositiveAmount(msg.Shares)
+}
+
+func (msg *MessageUndelegate) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
+func (msg *MessageRedelegate) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Delegator); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.SourceValidator); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.DestinationValidator); err != nil {
+		return err
+	}
+	if bytes.Equal(msg.SourceValidator, msg.DestinationValidator) {
+		return types.ErrSelfRedelegation()
+	}
+	return ValidatePositiveAmount(msg.Shares)
+}
+
+func (msg *MessageRedelegate) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
+// Messages decodes the messages of the batch in order
+func (msg *MessageBatch) Messages() ([]Message, types.Error) {
+	messages := make([]Message, 0, len(msg.Msgs))
+	for _, any := range msg.Msgs {
+		message, err := MessageFromAny(any)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func (msg *MessageBatch) ValidateBasic() types.Error {
+	if len(msg.Msgs) == 0 {
+		return types.ErrEmptyBatch()
+	}
+	messages, err := msg.Messages()
+	if err != nil {
+		return err
+	}
+	for _, message := range messages {
+		if _, ok := message.(*MessageBatch); ok {
+			return types.ErrNestedBatch()
+		}
+		if err := message.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg *MessageBatch) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
 func (msg *MessageDoubleSign) ValidateBasic() types.Error {
 	if err := msg.VoteA.ValidateBasic(); err != nil {
 		return err
@@ -313,10 +513,14 @@ func (msg *MessageDoubleSign) ValidateBasic() types.Error {
 	if msg.VoteA.Round != msg.VoteB.Round {
 		return types.ErrUnequalRounds()
 	}
-	if bytes.Equal(msg.VoteA.BlockHash, msg.VoteB.BlockHash) {
+	if proto.Equal(msg.VoteA.Block, msg.VoteB.Block) {
 		return types.ErrEqualVotes()
 	}
-	return nil
+	// both votes must be signed by the validator for the evidence to prove the double sign
+	if err := msg.VoteA.VerifySignature(); err != nil {
+		return err
+	}
+	return msg.VoteB.VerifySignature()
 }
 
 func (msg *MessageDoubleSign) SetSigner(signer []byte) {
@@ -340,6 +544,68 @@ func (msg *MessageSend) SetSigner(signer []byte) {
 	log.Println("[NOOP] SetSigner on MessageSend")
 }
 
+func (msg *MessageCreateVestingAccount) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.FromAddress); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.ToAddress); err != nil {
+		return err
+	}
+	if err := ValidatePositiveAmount(msg.Amount); err != nil {
+		return err
+	}
+	if msg.VestingBlocks <= 0 {
+		return types.ErrInvalidVesting("the vesting blocks must be positive")
+	}
+	if msg.CliffBlocks < 0 || msg.CliffBlocks > msg.VestingBlocks {
+		return types.ErrInvalidVesting("the cliff must be within the vesting blocks")
+	}
+	return nil
+}
+
+func (msg *MessageCreateVestingAccount) SetSigner(signer []byte) {
+	log.Println("[NOOP] SetSigner on MessageCreateVestingAccount")
+}
+
+func (msg *MessageUpgrade) ValidateBasic() types.Error {
+	if msg.Version == "" {
+		return types.ErrEmptyUpgradeVersion()
+	}
+	if msg.Height <= 0 {
+		return types.ErrInvalidActivationHeight(msg.Height)
+	}
+	return nil
+}
+
+func (msg *MessageUpgrade) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
+func (msg *MessageSubmitProposal) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Proposer); err != nil {
+		return err
+	}
+	if err := ValidatePositiveAmount(msg.Deposit); err != nil {
+		return err
+	}
+	return msg.Content.ValidateBasic()
+}
+
+func (msg *MessageSubmitProposal) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
+func (msg *MessageVoteProposal) ValidateBasic() types.Error {
+	if err := ValidateAddress(msg.Voter); err != nil {
+		return err
+	}
+	return ValidateVoteOption(msg.Option)
+}
+
+func (msg *MessageVoteProposal) SetSigner(signer []byte) {
+	msg.Signer = signer
+}
+
 func ValidateAddress(address []byte) types.Error {
 	if address == nil {
 		return types.ErrEmptyAddress()
@@ -407,6 +673,28 @@ func ValidateAmount(amount string) types.Error {
 	return nil
 }
 
+// ValidatePositiveAmount is ValidateAmount for amounts that must be above zero
+func ValidatePositiveAmount(amount string) types.Error {
+	if err := ValidateAmount(amount); err != nil {
+		return err
+	}
+	a, err := types.StringToBigInt(amount)
+	if err != nil {
+		return err
+	}
+	if a.Sign() <= 0 {
+		return types.ErrInvalidAmount()
+	}
+	return nil
+}
+
+func ValidateCommission(commission int32) types.Error {
+	if commission < 0 || commission > 100 {
+		return types.ErrInvalidCommission(commission)
+	}
+	return nil
+}
+
 func ValidateServiceUrl(uri string) types.Error {
 	uri = strings.ToLower(uri)
 	_, err := url.ParseRequestURI(uri)
@@ -4,8 +4,10 @@ import (
 	"math/big"
 	"testing"
 
+	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -19,6 +21,44 @@ var (
 	defaultUnusedLength  = -1
 )
 
+func TestMessageBatch_ValidateBasic(t *testing.T) {
+	codec := types.GetCodec()
+	from, _ := crypto.GenerateAddress()
+	to, _ := crypto.GenerateAddress()
+	send, err := codec.ToAny(&MessageSend{
+		FromAddress: from,
+		ToAddress:   to,
+		Amount:      defaultAmount,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := MessageBatch{Msgs: []*anypb.Any{send, send}}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	msgEmpty := MessageBatch{}
+	if err := msgEmpty.ValidateBasic(); err.Code() != types.ErrEmptyBatch().Code() {
+		t.Fatal(err)
+	}
+	nested, err := codec.ToAny(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgNested := MessageBatch{Msgs: []*anypb.Any{send, nested}}
+	if err := msgNested.ValidateBasic(); err.Code() != types.ErrNestedBatch().Code() {
+		t.Fatal(err)
+	}
+	invalidSend, err := codec.ToAny(&MessageSend{FromAddress: from, ToAddress: to})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgInvalid := MessageBatch{Msgs: []*anypb.Any{send, invalidSend}}
+	if err := msgInvalid.ValidateBasic(); err.Code() != types.ErrEmptyAmount().Code() {
+		t.Fatal(err)
+	}
+}
+
 func TestMessageChangeParameter_ValidateBasic(t *testing.T) {
 	codec := types.GetCodec()
 	owner, _ := crypto.GenerateAddress()
@@ -51,26 +91,84 @@ func TestMessageChangeParameter_ValidateBasic(t *testing.T) {
 	if err := msgMissingParamValue.ValidateBasic(); err.Code() != types.ErrEmptyParamValue().Code() {
 		t.Fatal(err)
 	}
+	msgNegativeActivationHeight := msg
+	msgNegativeActivationHeight.ActivationHeight = -1
+	if err := msgNegativeActivationHeight.ValidateBasic(); err.Code() != types.CodeInvalidActivationHeightError {
+		t.Fatal(err)
+	}
 }
 
-func TestMessageDoubleSign_ValidateBasic(t *testing.T) {
+func TestMessageClaim_ValidateBasic(t *testing.T) {
+	addr, _ := crypto.GenerateAddress()
 	pk, _ := crypto.GeneratePublicKey()
-	hashA := crypto.SHA3Hash(pk.Bytes())
-	hashB := crypto.SHA3Hash(pk.Address())
-	voteA := &Vote{
-		PublicKey: pk.Bytes(),
-		Height:    1,
-		Round:     2,
-		Type:      DoubleSignEvidenceType,
-		BlockHash: hashA,
-	}
-	voteB := &Vote{
-		PublicKey: pk.Bytes(),
-		Height:    1,
-		Round:     2,
-		Type:      DoubleSignEvidenceType,
-		BlockHash: hashB,
+	msg := MessageClaim{
+		SessionHeader: &SessionHeader{
+			AppPublicKey: pk.Bytes(),
+			Chain:        "0001",
+		},
+		Address: addr,
+		Relays:  1,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	msgMissingSessionHeader := msg
+	msgMissingSessionHeader.SessionHeader = nil
+	if err := msgMissingSessionHeader.ValidateBasic(); err.Code() != types.ErrEmptyPublicKey().Code() {
+		t.Fatal(err)
+	}
+	msgInvalidChain := msg
+	msgInvalidChain.SessionHeader = &SessionHeader{AppPublicKey: pk.Bytes(), Chain: "1"}
+	if err := msgInvalidChain.ValidateBasic(); err.Code() != types.ErrInvalidRelayChainLength(0, RelayChainLength).Code() {
+		t.Fatal(err)
 	}
+	msgMissingAddress := msg
+	msgMissingAddress.Address = nil
+	if err := msgMissingAddress.ValidateBasic(); err.Code() != types.ErrEmptyAddress().Code() {
+		t.Fatal(err)
+	}
+	msgNoRelays := msg
+	msgNoRelays.Relays = 0
+	if err := msgNoRelays.ValidateBasic(); err.Code() != types.ErrZeroRelays().Code() {
+		t.Fatal(err)
+	}
+}
+
+func TestMessageCreateVestingAccount_ValidateBasic(t *testing.T) {
+	addr1, _ := crypto.GenerateAddress()
+	addr2, _ := crypto.GenerateAddress()
+	msg := MessageCreateVestingAccount{
+		FromAddress:   addr1,
+		ToAddress:     addr2,
+		Amount:        defaultAmount,
+		CliffBlocks:   10,
+		VestingBlocks: 100,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	msgMissingToAddress := msg
+	msgMissingToAddress.ToAddress = nil
+	if err := msgMissingToAddress.ValidateBasic(); err.Code() != types.ErrEmptyAddress().Code() {
+		t.Fatal(err)
+	}
+	msgNoVestingBlocks := msg
+	msgNoVestingBlocks.CliffBlocks = 0
+	msgNoVestingBlocks.VestingBlocks = 0
+	if err := msgNoVestingBlocks.ValidateBasic(); err.Code() != types.CodeInvalidVestingError {
+		t.Fatal(err)
+	}
+	msgCliffAfterEnd := msg
+	msgCliffAfterEnd.CliffBlocks = 101
+	if err := msgCliffAfterEnd.ValidateBasic(); err.Code() != types.CodeInvalidVestingError {
+		t.Fatal(err)
+	}
+}
+
+func TestMessageDoubleSign_ValidateBasic(t *testing.T) {
+	privateKey, _ := crypto.GeneratePrivateKey()
+	voteA := newTestingVote(t, privateKey, "blockA")
+	voteB := newTestingVote(t, privateKey, "blockB")
 	reporter, _ := crypto.GenerateAddress()
 	msg := &MessageDoubleSign{
 		VoteA:           voteA,
@@ -108,24 +206,42 @@ func TestMessageDoubleSign_ValidateBasic(t *testing.T) {
 	if err := msgUnequalRounds.ValidateBasic(); err.Code() != types.ErrUnequalRounds().Code() {
 		t.Fatal(err)
 	}
-	//msgUnequalVoteTypes := new(MessageDoubleSign) TODO only one type of evidence right now
-	//msgUnequalVoteTypes.VoteA = new(Vote)
-	//msgUnequalVoteTypes.VoteB = new(Vote)
-	//*msgUnequalVoteTypes.VoteA = *msg.VoteA
-	//*msgUnequalVoteTypes.VoteB = *msg.VoteB
-	//msgUnequalVoteTypes.VoteA.Type = 0
-	//if err := msgUnequalVoteTypes.ValidateBasic(); err.Code() != types.ErrUnequalVoteTypes().Code() {
-	//	t.Fatal(err)
-	//}
+	msgUnequalVoteTypes := new(MessageDoubleSign)
+	msgUnequalVoteTypes.VoteA = new(Vote)
+	msgUnequalVoteTypes.VoteB = new(Vote)
+	*msgUnequalVoteTypes.VoteA = *msg.VoteA
+	*msgUnequalVoteTypes.VoteB = *msg.VoteB
+	msgUnequalVoteTypes.VoteA.Type = uint32(typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT)
+	if err := msgUnequalVoteTypes.ValidateBasic(); err.Code() != types.ErrUnequalVoteTypes().Code() {
+		t.Fatal(err)
+	}
 	msgEqualVoteHash := new(MessageDoubleSign)
 	msgEqualVoteHash.VoteA = new(Vote)
 	msgEqualVoteHash.VoteB = new(Vote)
 	*msgEqualVoteHash.VoteA = *msg.VoteA
 	*msgEqualVoteHash.VoteB = *msg.VoteB
-	msgEqualVoteHash.VoteB.BlockHash = hashA
+	msgEqualVoteHash.VoteB.Block = msgEqualVoteHash.VoteA.Block
 	if err := msgEqualVoteHash.ValidateBasic(); err.Code() != types.ErrEqualVotes().Code() {
 		t.Fatal(err)
 	}
+	msgUnsignedVote := new(MessageDoubleSign)
+	msgUnsignedVote.VoteA = new(Vote)
+	msgUnsignedVote.VoteB = new(Vote)
+	*msgUnsignedVote.VoteA = *msg.VoteA
+	*msgUnsignedVote.VoteB = *msg.VoteB
+	msgUnsignedVote.VoteB.Signature = nil
+	if err := msgUnsignedVote.ValidateBasic(); err.Code() != types.ErrEmptySignature().Code() {
+		t.Fatal(err)
+	}
+	msgForgedVote := new(MessageDoubleSign)
+	msgForgedVote.VoteA = new(Vote)
+	msgForgedVote.VoteB = new(Vote)
+	*msgForgedVote.VoteA = *msg.VoteA
+	*msgForgedVote.VoteB = *msg.VoteB
+	msgForgedVote.VoteB.Block = newTestingVoteBlock("blockC")
+	if err := msgForgedVote.ValidateBasic(); err.Code() != types.ErrSignatureVerificationFailed().Code() {
+		t.Fatal(err)
+	}
 }
 
 func TestMessageEditStakeApp_ValidateBasic(t *testing.T) {
@@ -312,6 +428,11 @@ func TestMessageFishermanPauseServiceNode_ValidateBasic(t *testing.T) {
 	if err := msg.ValidateBasic(); err != nil {
 		t.Fatal(err)
 	}
+	msgMissingSessionHeader := msg
+	msgMissingSessionHeader.SessionHeader = nil
+	if err := msgMissingSessionHeader.ValidateBasic(); err.Code() != types.ErrEmptyPublicKey().Code() {
+		t.Fatal(err)
+	}
 	msgMissingReporter := msg
 	msgMissingReporter.Reporter = nil
 	if err := msgMissingReporter.ValidateBasic(); err.Code() != types.ErrEmptyAddress().Code() {
@@ -324,6 +445,32 @@ func TestMessageFishermanPauseServiceNode_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMessagePartialUnstakeValidator_ValidateBasic(t *testing.T) {
+	addr, _ := crypto.GenerateAddress()
+	msg := MessagePartialUnstakeValidator{
+		Address: addr,
+		Amount:  defaultAmount,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	msgMissingAmount := msg
+	msgMissingAmount.Amount = ""
+	if err := msgMissingAmount.ValidateBasic(); err.Code() != types.ErrEmptyAmount().Code() {
+		t.Fatal(err)
+	}
+	msgZeroAmount := msg
+	msgZeroAmount.Amount = "0"
+	if err := msgZeroAmount.ValidateBasic(); err.Code() != types.ErrInvalidAmount().Code() {
+		t.Fatal(err)
+	}
+	msgEmptyAddress := msg
+	msgEmptyAddress.Address = nil
+	if err := msgEmptyAddress.ValidateBasic(); err.Code() != types.ErrEmptyAddress().Code() {
+		t.Fatal(err)
+	}
+}
+
 func TestMessagePauseApp_ValidateBasic(t *testing.T) {
 	addr, _ := crypto.GenerateAddress()
 	msg := MessagePauseApp{
@@ -384,6 +531,31 @@ func TestMessagePauseValidator_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMessageRedelegate_ValidateBasic(t *testing.T) {
+	delegator, _ := crypto.GenerateAddress()
+	source, _ := crypto.GenerateAddress()
+	destination, _ := crypto.GenerateAddress()
+	msg := MessageRedelegate{
+		Delegator:            delegator,
+		SourceValidator:      source,
+		DestinationValidator: destination,
+		Shares:               defaultAmount,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	msgSameValidator := msg
+	msgSameValidator.DestinationValidator = source
+	if err := msgSameValidator.ValidateBasic(); err.Code() != types.ErrSelfRedelegation().Code() {
+		t.Fatal(err)
+	}
+	msgZeroShares := msg
+	msgZeroShares.Shares = "0"
+	if err := msgZeroShares.ValidateBasic(); err.Code() != types.ErrInvalidAmount().Code() {
+		t.Fatal(err)
+	}
+}
+
 func TestMessageSend_ValidateBasic(t *testing.T) {
 	addr1, _ := crypto.GenerateAddress()
 	addr2, _ := crypto.GenerateAddress()
@@ -561,6 +733,55 @@ func TestMessageStakeValidator_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMessageTestScore_ValidateBasic(t *testing.T) {
+	addr, _ := crypto.GenerateAddress()
+	pk, _ := crypto.GeneratePublicKey()
+	msg := MessageTestScore{
+		SessionHeader: &SessionHeader{
+			AppPublicKey: pk.Bytes(),
+			Chain:        "0001",
+		},
+		NumberOfSamples: 4,
+		NullIndicies:    []uint32{1},
+		Address:         addr,
+		Reporter:        addr,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	if score, err := msg.Score(); err != nil || score != 75 {
+		t.Fatalf("unexpected score: expected %d got %d (%v)", 75, score, err)
+	}
+	msgMissingSessionHeader := msg
+	msgMissingSessionHeader.SessionHeader = nil
+	if err := msgMissingSessionHeader.ValidateBasic(); err.Code() != types.ErrEmptyPublicKey().Code() {
+		t.Fatal(err)
+	}
+	msgMissingReporter := msg
+	msgMissingReporter.Reporter = nil
+	if err := msgMissingReporter.ValidateBasic(); err.Code() != types.ErrEmptyAddress().Code() {
+		t.Fatal(err)
+	}
+	msgNoSamples := msg
+	msgNoSamples.NumberOfSamples = 0
+	if err := msgNoSamples.ValidateBasic(); err.Code() != types.ErrEmptyTestScore().Code() {
+		t.Fatal(err)
+	}
+	if _, err := msgNoSamples.Score(); err == nil || err.Code() != types.ErrEmptyTestScore().Code() {
+		t.Fatal(err)
+	}
+	msgOutOfSamples := msg
+	msgOutOfSamples.NullIndicies = []uint32{4}
+	if err := msgOutOfSamples.ValidateBasic(); err.Code() != types.ErrInvalidNullIndex(4).Code() {
+		t.Fatal(err)
+	}
+	msgRepeatedIndex := msg
+	msgRepeatedIndex.NullIndicies = []uint32{1, 1}
+	if err := msgRepeatedIndex.ValidateBasic(); err.Code() != types.ErrInvalidNullIndex(1).Code() {
+		t.Fatal(err)
+	}
+}
+
 func TestMessageUnpauseApp_ValidateBasic(t *testing.T) {
 	addr, _ := crypto.GenerateAddress()
 	msg := MessageUnpauseApp{
@@ -680,3 +901,23 @@ func TestMessageUnstakeValidator_ValidateBasic(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMessageUpgrade_ValidateBasic(t *testing.T) {
+	msg := MessageUpgrade{
+		Version: "1.0.0",
+		Height:  100,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	msgMissingVersion := msg
+	msgMissingVersion.Version = ""
+	if err := msgMissingVersion.ValidateBasic(); err.Code() != types.CodeEmptyUpgradeVersionError {
+		t.Fatal(err)
+	}
+	msgMissingHeight := msg
+	msgMissingHeight.Height = 0
+	if err := msgMissingHeight.ValidateBasic(); err.Code() != types.CodeInvalidActivationHeightError {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import "github.com/pokt-network/pocket/shared/types"
+
+func (c *ProposalContent) ValidateBasic() types.Error {
+	if c == nil {
+		return types.ErrEmptyProposalContent()
+	}
+	if c.Title == "" {
+		return types.ErrEmptyProposalTitle()
+	}
+	switch action := c.Action.(type) {
+	case *ProposalContent_ParamChange:
+		if action.ParamChange.GetParameterKey() == "" {
+			return types.ErrEmptyParamKey()
+		}
+		if action.ParamChange.GetParameterValue() == nil {
+			return types.ErrEmptyParamValue()
+		}
+	case *ProposalContent_PoolSpend:
+		if err := ValidateAddress(action.PoolSpend.GetRecipient()); err != nil {
+			return err
+		}
+		if err := ValidateAmount(action.PoolSpend.GetAmount()); err != nil {
+			return err
+		}
+		amount, _ := types.StringToBigInt(action.PoolSpend.GetAmount())
+		if amount.Sign() == -1 {
+			return types.ErrNegativeAmountError()
+		}
+	}
+	return nil
+}
+
+func ValidateVoteOption(option VoteOption) types.Error {
+	switch option {
+	case VoteOption_VOTE_OPTION_YES, VoteOption_VOTE_OPTION_NO, VoteOption_VOTE_OPTION_ABSTAIN:
+		return nil
+	default:
+		return types.ErrUnknownVoteOption(int32(option))
+	}
+}
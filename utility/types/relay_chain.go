@@ -1,14 +1,17 @@
 package types
 
-import "github.com/pokt-network/pocket/shared/types"
+import (
+	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+)
 
 const (
-	RelayChainLength = 4 // pre-determined length that strikes a balance between combination possibilities & storage
+	RelayChainLength = typesGenesis.RelayChainLength
 )
 
 type RelayChain string
 
-// TODO: Consider adding a governance parameter for a list of valid relay chains
+// Validate only checks the format of the id; whether the chain may be staked for depends on the `RelayChains` param
 func (rc *RelayChain) Validate() types.Error {
 	if rc == nil || *rc == "" {
 		return types.ErrEmptyRelayChain()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 func TransactionFromBytes(transaction []byte) (*Transaction, types.Error) {
@@ -18,6 +19,16 @@ func TransactionFromBytes(transaction []byte) (*Transaction, types.Error) {
 }
 
 func (tx *Transaction) ValidateBasic() types.Error {
+	return tx.validateBasic(true)
+}
+
+// ValidateBasicWithoutSignature runs the checks of ValidateBasic except the signature verification; only the
+// public key of the signer is required. Used to simulate transactions that are not signed yet
+func (tx *Transaction) ValidateBasicWithoutSignature() types.Error {
+	return tx.validateBasic(false)
+}
+
+func (tx *Transaction) validateBasic(verifySignature bool) types.Error {
 	fee := big.Int{}
 	if _, ok := fee.SetString(tx.Fee, 10); tx.Fee == "" || !ok {
 		return types.ErrNewFeeFromString(tx.Fee)
@@ -28,32 +39,88 @@ func (tx *Transaction) ValidateBasic() types.Error {
 	if _, err := types.GetCodec().FromAny(tx.Msg); err != nil {
 		return types.ErrProtoFromAny(err)
 	}
-	if tx.Signature == nil || tx.Signature.Signature == nil {
+	if multiSignature := tx.Signature.GetMultiSignature(); multiSignature != nil {
+		if err := tx.validateMultiSignature(multiSignature, verifySignature); err != nil {
+			return err
+		}
+	} else if err := tx.validateSignature(verifySignature); err != nil {
+		return err
+	}
+	if _, err := tx.Message(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *Transaction) validateSignature(verifySignature bool) types.Error {
+	if verifySignature && (tx.Signature == nil || tx.Signature.Signature == nil) {
 		return types.ErrEmptySignature()
 	}
-	if tx.Signature.PublicKey == nil {
+	if tx.Signature.GetPublicKey() == nil {
 		return types.ErrEmptyPublicKey()
 	}
 	publicKey, err := crypto.NewPublicKeyFromBytes(tx.Signature.PublicKey)
 	if err != nil {
 		return types.ErrNewPublicKeyFromBytes(err)
 	}
-	signBytes, err := tx.SignBytes()
+	if verifySignature {
+		signBytes, err := tx.SignBytes()
+		if err != nil {
+			return types.ErrProtoMarshal(err)
+		}
+		if ok := publicKey.Verify(signBytes, tx.Signature.Signature); !ok {
+			return types.ErrSignatureVerificationFailed()
+		}
+	}
+	return nil
+}
+
+// validateMultiSignature checks that the multisig is valid and, when verifying, that at least its threshold of keys
+// signed the transaction
+func (tx *Transaction) validateMultiSignature(multiSignature *MultiSignature, verifySignature bool) types.Error {
+	multisig, err := multiSignature.MultisigPublicKey()
 	if err != nil {
-		return types.ErrProtoMarshal(err)
+		return err
+	}
+	if !verifySignature {
+		return nil
 	}
-	if ok := publicKey.Verify(signBytes, tx.Signature.Signature); !ok {
+	if len(multiSignature.Signatures) != len(multiSignature.PublicKeys) {
 		return types.ErrSignatureVerificationFailed()
 	}
-	if _, err := tx.Message(); err != nil {
+	signers := make([]crypto.PublicKey, 0, len(multiSignature.PublicKeys))
+	signatures := make([][]byte, 0, len(multiSignature.Signatures))
+	for i, signature := range multiSignature.Signatures {
+		if len(signature) == 0 {
+			continue
+		}
+		signer, er := crypto.NewPublicKeyFromBytes(multiSignature.PublicKeys[i])
+		if er != nil {
+			return types.ErrNewPublicKeyFromBytes(er)
+		}
+		signers = append(signers, signer)
+		signatures = append(signatures, signature)
+	}
+	if len(signers) == 0 {
+		return types.ErrEmptySignature()
+	}
+	signBytes, err := tx.SignBytes()
+	if err != nil {
 		return err
 	}
+	if ok := multisig.Verify(signBytes, signers, signatures); !ok {
+		return types.ErrSignatureVerificationFailed()
+	}
 	return nil
 }
 
 func (tx *Transaction) Message() (Message, types.Error) {
+	return MessageFromAny(tx.Msg)
+}
+
+func MessageFromAny(any *anypb.Any) (Message, types.Error) {
 	codec := types.GetCodec()
-	msg, er := codec.FromAny(tx.Msg)
+	msg, er := codec.FromAny(any)
 	if er != nil {
 		return nil, er
 	}
@@ -81,6 +148,40 @@ func (tx *Transaction) Sign(privateKey crypto.PrivateKey) types.Error {
 	return nil
 }
 
+// SetMultisig prepares the transaction to be signed by the keys of `multisig` with SignMultisig
+func (tx *Transaction) SetMultisig(multisig *crypto.MultisigPublicKey) {
+	multiSignature := &MultiSignature{Threshold: uint32(multisig.Threshold())}
+	for _, publicKey := range multisig.PublicKeys() {
+		multiSignature.PublicKeys = append(multiSignature.PublicKeys, publicKey.Bytes())
+		multiSignature.Signatures = append(multiSignature.Signatures, nil)
+	}
+	tx.Signature = &Signature{MultiSignature: multiSignature}
+}
+
+// SignMultisig adds the signature of `privateKey`, which must be one of the keys of the multisig set with SetMultisig
+func (tx *Transaction) SignMultisig(privateKey crypto.PrivateKey) types.Error {
+	multiSignature := tx.Signature.GetMultiSignature()
+	if multiSignature == nil {
+		return types.ErrEmptySignature()
+	}
+	bz, err := tx.SignBytes()
+	if err != nil {
+		return err
+	}
+	for i, publicKey := range multiSignature.PublicKeys {
+		if !bytes.Equal(publicKey, privateKey.PublicKey().Bytes()) {
+			continue
+		}
+		signature, er := privateKey.Sign(bz)
+		if er != nil {
+			return types.ErrTransactionSign(er)
+		}
+		multiSignature.Signatures[i] = signature
+		return nil
+	}
+	return types.ErrInvalidSigner()
+}
+
 func (tx *Transaction) Hash() (string, types.Error) {
 	b, err := tx.Bytes()
 	if err != nil {
@@ -114,6 +215,80 @@ func (tx *Transaction) Equals(tx2 *Transaction) bool {
 	return bytes.Equal(b, b1)
 }
 
+// Result builds the indexed result of the transaction at position `index` of the block at `height`.
+// `txErr` is the error returned while applying the transaction, if any, and is recorded as the result code
+func (tx *Transaction) Result(height int64, index int, txErr types.Error) (*TransactionResult, types.Error) {
+	msg, err := tx.Message()
+	if err != nil {
+		return nil, err
+	}
+	signer, err := tx.Signer()
+	if err != nil {
+		return nil, err
+	}
+	code := uint32(0)
+	if txErr != nil {
+		code = uint32(txErr.Code())
+	}
+	return &TransactionResult{
+		Code:        code,
+		Signer:      signer,
+		Recipient:   MessageRecipient(msg),
+		MessageType: string(msg.ProtoReflect().Descriptor().Name()),
+		Height:      height,
+		Index:       uint32(index),
+		Transaction: tx,
+	}, nil
+}
+
+// Signer returns the address of the public key that signed the transaction, or of the multisig that signed it
+func (tx *Transaction) Signer() ([]byte, types.Error) {
+	if multiSignature := tx.Signature.GetMultiSignature(); multiSignature != nil {
+		multisig, err := multiSignature.MultisigPublicKey()
+		if err != nil {
+			return nil, err
+		}
+		return multisig.Address(), nil
+	}
+	publicKey, er := crypto.NewPublicKeyFromBytes(tx.Signature.GetPublicKey())
+	if er != nil {
+		return nil, types.ErrNewPublicKeyFromBytes(er)
+	}
+	return publicKey.Address(), nil
+}
+
+func (m *MultiSignature) MultisigPublicKey() (*crypto.MultisigPublicKey, types.Error) {
+	multisig, er := crypto.NewMultisigPublicKeyFromBytes(int(m.Threshold), m.PublicKeys)
+	if er != nil {
+		return nil, types.ErrNewMultisigPublicKey(er)
+	}
+	return multisig, nil
+}
+
+// MessageRecipient returns the address receiving tokens from the message, or nil if the message has no recipient
+func MessageRecipient(msg Message) []byte {
+	switch x := msg.(type) {
+	case *MessageSend:
+		return x.ToAddress
+	default:
+		return nil
+	}
+}
+
 func TransactionHash(transactionProtoBytes []byte) string {
 	return hex.EncodeToString(crypto.SHA3Hash(transactionProtoBytes))
 }
+
+// TransactionFeePriority orders the mempool by the fee of the transaction, so the transactions paying more are
+// proposed first. Transactions that cannot be decoded have no priority
+func TransactionFeePriority(transactionProtoBytes []byte) *big.Int {
+	tx, err := TransactionFromBytes(transactionProtoBytes)
+	if err != nil {
+		return big.NewInt(0)
+	}
+	fee, err := types.StringToBigInt(tx.Fee)
+	if err != nil {
+		return big.NewInt(0)
+	}
+	return fee
+}
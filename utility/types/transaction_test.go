@@ -135,3 +135,76 @@ func TestTransaction_ValidateBasic(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTransaction_ValidateBasicWithoutSignature(t *testing.T) {
+	tx := NewUnsignedTestingTransaction(t)
+	tx.Signature = &Signature{PublicKey: testingSenderPublicKey.Bytes()}
+	if err := tx.ValidateBasicWithoutSignature(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.ValidateBasic(); err.Code() != types.ErrEmptySignature().Code() {
+		t.Fatal(err)
+	}
+	txEmptySig := tx
+	txEmptySig.Signature = nil
+	if err := txEmptySig.ValidateBasicWithoutSignature(); err.Code() != types.ErrEmptyPublicKey().Code() {
+		t.Fatal(err)
+	}
+}
+
+func TestTransaction_ValidateBasicMultisig(t *testing.T) {
+	signers := make([]crypto.PrivateKey, 3)
+	publicKeys := make([]crypto.PublicKey, 3)
+	for i := range signers {
+		signers[i], _ = crypto.GeneratePrivateKey()
+		publicKeys[i] = signers[i].PublicKey()
+	}
+	multisig, err := crypto.NewMultisigPublicKey(2, publicKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the address only depends on the set of keys and the threshold
+	reordered, err := crypto.NewMultisigPublicKey(2, []crypto.PublicKey{publicKeys[2], publicKeys[0], publicKeys[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(multisig.Address(), reordered.Address()) {
+		t.Fatal("the multisig address depends on the order of the keys")
+	}
+	otherThreshold, err := crypto.NewMultisigPublicKey(3, publicKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(multisig.Address(), otherThreshold.Address()) {
+		t.Fatal("the multisig address doesn't depend on the threshold")
+	}
+	tx := NewUnsignedTestingTransaction(t)
+	tx.SetMultisig(multisig)
+	if err := tx.SignMultisig(signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.ValidateBasic(); err.Code() != types.ErrSignatureVerificationFailed().Code() {
+		t.Fatal(err)
+	}
+	if err := tx.SignMultisig(signers[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := tx.Signer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(signer, multisig.Address()) {
+		t.Fatal("the signer isn't the multisig address")
+	}
+	outsider, _ := crypto.GeneratePrivateKey()
+	if err := tx.SignMultisig(outsider); err.Code() != types.ErrInvalidSigner().Code() {
+		t.Fatal(err)
+	}
+	tx.Signature.MultiSignature.Threshold = 4
+	if err := tx.ValidateBasic(); err.Code() != types.ErrNewMultisigPublicKey(err).Code() {
+		t.Fatal(err)
+	}
+}
@@ -1,25 +1,78 @@
 package types
 
-import "github.com/pokt-network/pocket/shared/types"
-
-const (
-	DoubleSignEvidenceType = 1
+import (
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
 )
 
-// TODO NOTE: there's no signature validation on the vote because we are unsure the current mode of vote signing
-// TODO *Needs to add signatures to vote structure*
 func (v *Vote) ValidateBasic() types.Error {
 	if err := ValidatePublicKey(v.PublicKey); err != nil {
 		return err
 	}
-	if err := ValidateHash(v.BlockHash); err != nil {
-		return err
-	}
 	if v.Height < 0 {
 		return types.ErrInvalidBlockHeight()
 	}
-	if v.Type != DoubleSignEvidenceType {
+	if v.Block == nil || v.Block.BlockHeader == nil || v.Block.BlockHeader.Height != v.Height {
+		return types.ErrInvalidVoteBlock()
+	}
+	// the validators only vote in these steps of a round
+	switch v.Step() {
+	case typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT, typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT:
+	default:
 		return types.ErrInvalidEvidenceType()
 	}
 	return nil
 }
+
+// Step returns the hotstuff step of the vote, which is its type
+func (v *Vote) Step() typesCons.HotstuffStep {
+	return typesCons.HotstuffStep(v.Type)
+}
+
+// ChainId returns the chain of the block voted for
+func (v *Vote) ChainId() string {
+	return v.Block.GetBlockHeader().GetNetworkId()
+}
+
+// SignBytes returns the bytes signed by the validator, which are those of the consensus message of the vote, so a
+// validator's consensus votes are evidence of its double signs
+func (v *Vote) SignBytes() ([]byte, types.Error) {
+	bz, err := typesCons.SignableBytes(uint64(v.Height), v.Step(), uint64(v.Round), v.Block)
+	if err != nil {
+		return nil, types.ErrProtoMarshal(err)
+	}
+	return bz, nil
+}
+
+func (v *Vote) Sign(privateKey crypto.PrivateKey) types.Error {
+	v.PublicKey = privateKey.PublicKey().Bytes()
+	bz, err := v.SignBytes()
+	if err != nil {
+		return err
+	}
+	signature, er := privateKey.Sign(bz)
+	if er != nil {
+		return types.ErrTransactionSign(er)
+	}
+	v.Signature = signature
+	return nil
+}
+
+func (v *Vote) VerifySignature() types.Error {
+	if len(v.Signature) == 0 {
+		return types.ErrEmptySignature()
+	}
+	publicKey, er := crypto.NewPublicKeyFromBytes(v.PublicKey)
+	if er != nil {
+		return types.ErrNewPublicKeyFromBytes(er)
+	}
+	bz, err := v.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !publicKey.Verify(bz, v.Signature) {
+		return types.ErrSignatureVerificationFailed()
+	}
+	return nil
+}
@@ -1,7 +1,97 @@
 package types
 
-import "testing"
+import (
+	"testing"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/types"
+)
 
 func TestVoteValidateBasic(t *testing.T) {
-	// TODO: Implement me.
+	privateKey, _ := crypto.GeneratePrivateKey()
+	vote := newTestingVote(t, privateKey, "block")
+	if err := vote.ValidateBasic(); err != nil {
+		t.Fatal(err)
+	}
+	vote.Type = uint32(typesCons.HotstuffStep_HOTSTUFF_STEP_NEWROUND)
+	if err := vote.ValidateBasic(); err.Code() != types.CodeInvalidEvidenceTypeError {
+		t.Fatal(err)
+	}
+	vote = newTestingVote(t, privateKey, "block")
+	vote.Block.BlockHeader.Height = 2
+	if err := vote.ValidateBasic(); err.Code() != types.CodeInvalidVoteBlockError {
+		t.Fatal(err)
+	}
+	vote.Block = nil
+	if err := vote.ValidateBasic(); err.Code() != types.CodeInvalidVoteBlockError {
+		t.Fatal(err)
+	}
+}
+
+func TestVote_Sign(t *testing.T) {
+	privateKey, _ := crypto.GeneratePrivateKey()
+	vote := newTestingVote(t, privateKey, "block")
+	if err := vote.VerifySignature(); err != nil {
+		t.Fatal(err)
+	}
+	otherKey, _ := crypto.GeneratePrivateKey()
+	vote.PublicKey = otherKey.PublicKey().Bytes()
+	if err := vote.VerifySignature(); err.Code() != types.ErrSignatureVerificationFailed().Code() {
+		t.Fatal(err)
+	}
+}
+
+func TestVote_ConsensusSignature(t *testing.T) {
+	privateKey, _ := crypto.GeneratePrivateKey()
+	block := newTestingVoteBlock("block")
+	// the signature of a consensus vote message is evidence as it is
+	bz, err := typesCons.SignableBytes(1, typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT, 2, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := privateKey.Sign(bz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vote := &Vote{
+		PublicKey: privateKey.PublicKey().Bytes(),
+		Height:    1,
+		Round:     2,
+		Type:      uint32(typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT),
+		Block:     block,
+		Signature: signature,
+	}
+	if err := vote.VerifySignature(); err != nil {
+		t.Fatal(err)
+	}
+	// the step is signed, so the vote of another step doesn't verify
+	vote.Type = uint32(typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT)
+	if err := vote.VerifySignature(); err.Code() != types.CodeSignatureVerificationFailedError {
+		t.Fatal(err)
+	}
+}
+
+// newTestingVote returns a prepare vote of `privateKey` at height 1 and round 2 for the block named `name`
+func newTestingVote(t *testing.T, privateKey crypto.PrivateKey, name string) *Vote {
+	vote := &Vote{
+		Height: 1,
+		Round:  2,
+		Type:   uint32(typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE),
+		Block:  newTestingVoteBlock(name),
+	}
+	if err := vote.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	return vote
+}
+
+func newTestingVoteBlock(name string) *types.Block {
+	return &types.Block{
+		BlockHeader: &types.BlockHeader{
+			Height:    1,
+			Hash:      name,
+			NetworkId: "testnet",
+		},
+	}
 }
@@ -0,0 +1,222 @@
+package utility
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// A partial unstake lowers an actor's stake without taking them out of sessions or consensus. The unstaked tokens
+// move from the actor's stake pool to the UNBONDING_POOL, and are paid to the output address once the unstaking
+// period of the actor type has passed
+
+// partialUnstakeAmounts parses the amount to unstake and returns it along with the stake that remains, which must
+// still meet the minimum stake of the actor type
+func partialUnstakeAmounts(stakedTokens *big.Int, amountToUnstake string, minimumStake *big.Int) (amount, remaining *big.Int, err types.Error) {
+	amount, err = types.StringToBigInt(amountToUnstake)
+	if err != nil {
+		return nil, nil, err
+	}
+	// a negative amount would raise the stake out of the unbonding pool
+	if amount.Sign() <= 0 {
+		return nil, nil, types.ErrInvalidAmount()
+	}
+	remaining = new(big.Int).Sub(stakedTokens, amount)
+	if types.BigIntLessThan(remaining, minimumStake) {
+		return nil, nil, types.ErrMinimumStake()
+	}
+	return amount, remaining, nil
+}
+
+// QueueUnbondingStake moves `amount` out of the stake pool into the UNBONDING_POOL until `releaseHeight`. Unstakes of
+// the same actor released at the same height are merged if they are paid to the same output address and leave the
+// same `validator`, which is the validator whose stake or delegation the tokens leave, if any. A merged unbonding
+// stake starts at the height of the last unstake, so it stays slashable for evidence up to that height
+func (u *UtilityContext) QueueUnbondingStake(address, output, validator []byte, amount *big.Int, releaseHeight int64, stakePool string) types.Error {
+	if err := u.SubPoolAmount(stakePool, types.BigIntToString(amount)); err != nil {
+		return err
+	}
+	if err := u.AddPoolAmount(typesUtil.UnbondingPoolName, amount); err != nil {
+		return err
+	}
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	unbondingStake, err := u.GetUnbondingStake(releaseHeight, address, output, validator)
+	if err != nil {
+		return err
+	}
+	if unbondingStake == nil {
+		unbondingStake = &typesUtil.UnbondingStake{
+			Address:       address,
+			OutputAddress: output,
+			Amount:        types.BigIntToString(big.NewInt(0)),
+			ReleaseHeight: releaseHeight,
+			StakePool:     stakePool,
+			Validator:     validator,
+		}
+	}
+	unbondingStake.StartHeight = latestHeight
+	total, err := types.StringToBigInt(unbondingStake.Amount)
+	if err != nil {
+		return err
+	}
+	unbondingStake.Amount = types.BigIntToString(total.Add(total, amount))
+	return u.SetUnbondingStake(unbondingStake)
+}
+
+// ReleaseUnbondingStakes pays the stakes unbonding until the current height to their output addresses
+func (u *UtilityContext) ReleaseUnbondingStakes() types.Error {
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	unbondingStakes, err := u.GetUnbondingStakesReleasedAt(latestHeight)
+	if err != nil {
+		return err
+	}
+	store := u.Store()
+	for _, unbondingStake := range unbondingStakes {
+		if err := u.SubPoolAmount(typesUtil.UnbondingPoolName, unbondingStake.Amount); err != nil {
+			return err
+		}
+		if err := u.AddAccountAmountString(unbondingStake.OutputAddress, unbondingStake.Amount); err != nil {
+			return err
+		}
+		if er := store.DeleteUnbondingStake(unbondingStake.ReleaseHeight, unbondingStakeID(unbondingStake)); er != nil {
+			return types.ErrDeleteUnbondingStake(er)
+		}
+		u.emitEvent(&typesUtil.Event{
+			Type:      typesUtil.EventType_EVENT_TYPE_UNBONDING_RELEASE,
+			Address:   unbondingStake.Address,
+			Recipient: unbondingStake.OutputAddress,
+			Pool:      typesUtil.UnbondingPoolName,
+			Amount:    unbondingStake.Amount,
+		})
+	}
+	return nil
+}
+
+// SlashUnbondingStakes burns `percentage` of the tokens unbonding from the validator that were still bonded to it at
+// `infractionHeight`, so undelegating or partially unstaking doesn't escape the slash of evidence submitted before the
+// tokens are released. It returns the amount burned
+func (u *UtilityContext) SlashUnbondingStakes(validator []byte, infractionHeight int64, percentage int) (*big.Int, types.Error) {
+	return u.slashUnbondingStakes(validator, infractionHeight, percentage, nil)
+}
+
+// slashUnbondingStakes is `SlashUnbondingStakes` paying the `reward` out of the slashed tokens
+func (u *UtilityContext) slashUnbondingStakes(validator []byte, infractionHeight int64, percentage int, reward *slashReward) (*big.Int, types.Error) {
+	unbondingStakes, err := u.GetAllUnbondingStakes()
+	if err != nil {
+		return nil, err
+	}
+	burned := big.NewInt(0)
+	for _, unbondingStake := range unbondingStakes {
+		if !bytes.Equal(unbondingStake.Validator, validator) || unbondingStake.StartHeight < infractionHeight {
+			continue
+		}
+		amount, err := types.StringToBigInt(unbondingStake.Amount)
+		if err != nil {
+			return nil, err
+		}
+		slashed := types.PercentageOf(amount, percentage)
+		if slashed.Sign() == 0 {
+			continue
+		}
+		if err := u.slashFromPool(typesUtil.UnbondingPoolName, unbondingStake.Address, slashed, reward); err != nil {
+			return nil, err
+		}
+		unbondingStake.Amount = types.BigIntToString(amount.Sub(amount, slashed))
+		if err := u.SetUnbondingStake(unbondingStake); err != nil {
+			return nil, err
+		}
+		burned.Add(burned, slashed)
+	}
+	return burned, nil
+}
+
+// GetActorUnbondingStakes returns the partially unstaked tokens of `address` that are not released yet
+func (u *UtilityContext) GetActorUnbondingStakes(address []byte) ([]*typesUtil.UnbondingStake, types.Error) {
+	unbondingStakes, err := u.GetAllUnbondingStakes()
+	if err != nil {
+		return nil, err
+	}
+	actorUnbondingStakes := make([]*typesUtil.UnbondingStake, 0)
+	for _, unbondingStake := range unbondingStakes {
+		if bytes.Equal(unbondingStake.Address, address) {
+			actorUnbondingStakes = append(actorUnbondingStakes, unbondingStake)
+		}
+	}
+	return actorUnbondingStakes, nil
+}
+
+// GetUnbondingStake returns nil if `address` has nothing unbonding from `validator` to `output` until `releaseHeight`
+func (u *UtilityContext) GetUnbondingStake(releaseHeight int64, address, output, validator []byte) (*typesUtil.UnbondingStake, types.Error) {
+	store := u.Store()
+	bz, er := store.GetUnbondingStake(releaseHeight, unbondingStakeID(&typesUtil.UnbondingStake{Address: address, OutputAddress: output, Validator: validator}))
+	if er != nil {
+		return nil, types.ErrGetUnbondingStake(er)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	unbondingStake := &typesUtil.UnbondingStake{}
+	if er := u.Codec().Unmarshal(bz, unbondingStake); er != nil {
+		return nil, types.ErrProtoUnmarshal(er)
+	}
+	return unbondingStake, nil
+}
+
+func (u *UtilityContext) SetUnbondingStake(unbondingStake *typesUtil.UnbondingStake) types.Error {
+	store := u.Store()
+	bz, er := u.Codec().Marshal(unbondingStake)
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.SetUnbondingStake(unbondingStake.ReleaseHeight, unbondingStakeID(unbondingStake), bz); er != nil {
+		return types.ErrSetUnbondingStake(er)
+	}
+	return nil
+}
+
+// unbondingStakeID tells apart the unbonding stakes released at the same height, so the unstakes of an actor that are
+// paid to different output addresses or leave different validators are kept apart
+func unbondingStakeID(unbondingStake *typesUtil.UnbondingStake) []byte {
+	id := make([]byte, 0, len(unbondingStake.Address)+len(unbondingStake.OutputAddress)+len(unbondingStake.Validator))
+	id = append(id, unbondingStake.Address...)
+	id = append(id, unbondingStake.OutputAddress...)
+	return append(id, unbondingStake.Validator...)
+}
+
+func (u *UtilityContext) GetUnbondingStakesReleasedAt(height int64) ([]*typesUtil.UnbondingStake, types.Error) {
+	store := u.Store()
+	unbondingStakesBz, er := store.GetUnbondingStakesReleasedAt(height)
+	if er != nil {
+		return nil, types.ErrGetUnbondingStake(er)
+	}
+	return u.unmarshalUnbondingStakes(unbondingStakesBz)
+}
+
+func (u *UtilityContext) GetAllUnbondingStakes() ([]*typesUtil.UnbondingStake, types.Error) {
+	store := u.Store()
+	unbondingStakesBz, er := store.GetAllUnbondingStakes()
+	if er != nil {
+		return nil, types.ErrGetUnbondingStake(er)
+	}
+	return u.unmarshalUnbondingStakes(unbondingStakesBz)
+}
+
+func (u *UtilityContext) unmarshalUnbondingStakes(unbondingStakesBz [][]byte) ([]*typesUtil.UnbondingStake, types.Error) {
+	unbondingStakes := make([]*typesUtil.UnbondingStake, 0, len(unbondingStakesBz))
+	for _, bz := range unbondingStakesBz {
+		unbondingStake := &typesUtil.UnbondingStake{}
+		if er := u.Codec().Unmarshal(bz, unbondingStake); er != nil {
+			return nil, types.ErrProtoUnmarshal(er)
+		}
+		unbondingStakes = append(unbondingStakes, unbondingStake)
+	}
+	return unbondingStakes, nil
+}
@@ -0,0 +1,85 @@
+package utility
+
+import (
+	"github.com/pokt-network/pocket/shared/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// A `MessageUpgrade` schedules the activation of a protocol version at a future height. Nodes running another version
+// halt before applying the block at that height (see `ApplyBlock`), so their operators can restart them with the new
+// version instead of forking from the nodes that upgraded
+
+// ProtocolVersion is the version of the protocol implemented by this node
+const ProtocolVersion = "0.0.1"
+
+func (u *UtilityContext) HandleMessageUpgrade(message *typesUtil.MessageUpgrade) types.Error {
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	if message.Height <= latestHeight {
+		return types.ErrInvalidActivationHeight(message.Height)
+	}
+	if err := u.SetUpgrade(&typesUtil.Upgrade{
+		Version: message.Version,
+		Height:  message.Height,
+	}); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:             typesUtil.EventType_EVENT_TYPE_UPGRADE,
+		Version:          message.Version,
+		ActivationHeight: message.Height,
+	})
+	return nil
+}
+
+// CheckUpgrade returns an error if the scheduled upgrade is active at the latest height and its version isn't the
+// `ProtocolVersion` of this node
+func (u *UtilityContext) CheckUpgrade() types.Error {
+	upgrade, err := u.GetUpgrade()
+	if err != nil {
+		return err
+	}
+	if upgrade == nil || upgrade.Height > u.LatestHeight || upgrade.Version == ProtocolVersion {
+		return nil
+	}
+	return types.ErrUpgradeRequired(upgrade.Version, upgrade.Height)
+}
+
+// GetUpgrade returns nil if no upgrade was ever scheduled
+func (u *UtilityContext) GetUpgrade() (*typesUtil.Upgrade, types.Error) {
+	store := u.Store()
+	bz, er := store.GetUpgrade()
+	if er != nil {
+		return nil, types.ErrGetUpgrade(er)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	upgrade := &typesUtil.Upgrade{}
+	if er := u.Codec().Unmarshal(bz, upgrade); er != nil {
+		return nil, types.ErrProtoUnmarshal(er)
+	}
+	return upgrade, nil
+}
+
+func (u *UtilityContext) SetUpgrade(upgrade *typesUtil.Upgrade) types.Error {
+	store := u.Store()
+	bz, er := u.Codec().Marshal(upgrade)
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.SetUpgrade(bz); er != nil {
+		return types.ErrSetUpgrade(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) GetMessageUpgradeSignerCandidates(msg *typesUtil.MessageUpgrade) ([][]byte, types.Error) {
+	owner, err := u.getBytesParam(typesUtil.UpgradeOwner)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{owner}, nil
+}
@@ -1,10 +1,12 @@
 package utility
 
 import (
+	"bytes"
 	"math/big"
 
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
@@ -34,6 +36,10 @@ func (u *UtilityContext) HandleMessageStakeValidator(message *typesUtil.MessageS
 	if signerAccountAmount.Sign() == -1 {
 		return types.ErrInsufficientAmountError()
 	}
+	// locked tokens may be staked if the stake returns to the vesting account
+	if err := u.checkLockedStake(message.Signer, message.OutputAddress, amount); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.SetAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -54,7 +60,8 @@ func (u *UtilityContext) HandleMessageStakeValidator(message *typesUtil.MessageS
 	if err := u.InsertValidator(publicKey.Address(), message.PublicKey, message.OutputAddress, message.ServiceUrl, message.Amount); err != nil {
 		return err
 	}
-	return nil
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_STAKE, typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, publicKey.Address(), amount, typesUtil.StatusCause_STATUS_CAUSE_SELF)
+	return u.SetValidatorCommission(publicKey.Address(), message.Commission)
 }
 
 func (u *UtilityContext) HandleMessageEditStakeValidator(message *typesUtil.MessageEditStakeValidator) types.Error {
@@ -78,6 +85,14 @@ func (u *UtilityContext) HandleMessageEditStakeValidator(message *typesUtil.Mess
 	if signerAccountAmount.Sign() == -1 {
 		return types.ErrInsufficientAmountError()
 	}
+	// locked tokens may be staked if the stake returns to the vesting account
+	output, err := u.GetValidatorOutputAddress(message.Address)
+	if err != nil {
+		return err
+	}
+	if err := u.checkLockedStake(message.Signer, output, amountToAdd); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.SetAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -90,6 +105,10 @@ func (u *UtilityContext) HandleMessageEditStakeValidator(message *typesUtil.Mess
 	if err := u.UpdateValidator(message.Address, message.ServiceUrl, message.AmountToAdd); err != nil {
 		return err
 	}
+	u.emitActorEvent(typesUtil.EventType_EVENT_TYPE_EDIT_STAKE, typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, message.Address, amountToAdd)
+	if message.Commission != nil {
+		return u.SetValidatorCommission(message.Address, *message.Commission)
+	}
 	return nil
 }
 
@@ -109,6 +128,55 @@ func (u *UtilityContext) HandleMessageUnstakeValidator(message *typesUtil.Messag
 	if err := u.SetValidatorUnstakingHeightAndStatus(message.Address, unstakingHeight); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_BEGIN_UNSTAKE, typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
+	return nil
+}
+
+// HandleMessagePartialUnstakeValidator lowers the stake of the validator, which stays staked, and queues the difference
+// for release to the output address after the unstaking period
+func (u *UtilityContext) HandleMessagePartialUnstakeValidator(message *typesUtil.MessagePartialUnstakeValidator) types.Error {
+	status, err := u.GetValidatorStatus(message.Address)
+	if err != nil {
+		return err
+	}
+	// validate is staked
+	if status != typesUtil.StakedStatus {
+		return types.ErrInvalidStatus(status, typesUtil.StakedStatus)
+	}
+	stakedTokens, err := u.GetValidatorStakedTokens(message.Address)
+	if err != nil {
+		return err
+	}
+	minStake, err := u.GetValidatorMinimumStake()
+	if err != nil {
+		return err
+	}
+	amount, remaining, err := partialUnstakeAmounts(stakedTokens, message.Amount, minStake)
+	if err != nil {
+		return err
+	}
+	if err := u.SetValidatorStakedTokens(message.Address, remaining); err != nil {
+		return err
+	}
+	unstakingHeight, err := u.CalculateValidatorUnstakingHeight()
+	if err != nil {
+		return err
+	}
+	output, err := u.GetValidatorOutputAddress(message.Address)
+	if err != nil {
+		return err
+	}
+	if err := u.QueueUnbondingStake(message.Address, output, message.Address, amount, unstakingHeight, typesUtil.ValidatorStakePoolName); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_PARTIAL_UNSTAKE,
+		ActorType: typesUtil.ActorType_ACTOR_TYPE_VALIDATOR,
+		Address:   message.Address,
+		Recipient: output,
+		Pool:      typesUtil.ValidatorStakePoolName,
+		Amount:    types.BigIntToString(amount),
+	})
 	return nil
 }
 
@@ -125,9 +193,21 @@ func (u *UtilityContext) UnstakeValidatorsThatAreReady() types.Error {
 		if err := u.AddAccountAmountString(validator.GetOutputAddress(), validator.GetStakeAmount()); err != nil {
 			return err
 		}
+		if err := u.ReleaseDelegations(validator.GetAddress()); err != nil {
+			return err
+		}
 		if err := u.DeleteValidator(validator.GetAddress()); err != nil {
 			return err
 		}
+		u.emitEvent(&typesUtil.Event{
+			Type:        typesUtil.EventType_EVENT_TYPE_UNSTAKE,
+			ActorType:   typesUtil.ActorType_ACTOR_TYPE_VALIDATOR,
+			Address:     validator.GetAddress(),
+			Recipient:   validator.GetOutputAddress(),
+			Pool:        typesUtil.ValidatorStakePoolName,
+			Amount:      validator.GetStakeAmount(),
+			StatusCause: typesUtil.StatusCause_STATUS_CAUSE_UNSTAKING_PERIOD,
+		})
 	}
 	return nil
 }
@@ -166,6 +246,7 @@ func (u *UtilityContext) HandleMessagePauseValidator(message *typesUtil.MessageP
 	if err := u.SetValidatorPauseHeight(message.Address, height); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_PAUSE, typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -191,6 +272,7 @@ func (u *UtilityContext) HandleMessageUnpauseValidator(message *typesUtil.Messag
 	if err := u.SetValidatorPauseHeight(message.Address, typesUtil.HeightNotUsed); err != nil {
 		return err
 	}
+	u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_UNPAUSE, typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, message.Address, nil, typesUtil.StatusCause_STATUS_CAUSE_SELF)
 	return nil
 }
 
@@ -216,12 +298,13 @@ func (u *UtilityContext) HandleByzantineValidators(lastBlockByzantineValidators
 			if err := u.SetValidatorPauseHeightAndMissedBlocks(address, latestBlockHeight, typesUtil.HeightNotUsed); err != nil {
 				return err
 			}
+			u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_PAUSE, typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, address, nil, typesUtil.StatusCause_STATUS_CAUSE_MISSED_BLOCKS)
 			// burn validator for missing blocks
 			burnPercentage, err := u.GetMissedBlocksBurnPercentage()
 			if err != nil {
 				return err
 			}
-			if err := u.BurnValidator(address, burnPercentage); err != nil {
+			if _, err := u.BurnValidator(address, burnPercentage); err != nil {
 				return err
 			}
 		} else if err := u.SetValidatorMissedBlocks(address, numberOfMissedBlocks); err != nil {
@@ -231,6 +314,8 @@ func (u *UtilityContext) HandleByzantineValidators(lastBlockByzantineValidators
 	return nil
 }
 
+// HandleProposalRewards pays the proposer the FEE_POOL, which holds the proposer's share of the fees of the block
+// and the tips (see DistributeFee). The delegators of the proposer earn their part of it, less the commission
 func (u *UtilityContext) HandleProposalRewards(proposer []byte) types.Error {
 	feesAndRewardsCollected, err := u.GetPoolAmount(typesUtil.FeePoolName)
 	if err != nil {
@@ -239,33 +324,52 @@ func (u *UtilityContext) HandleProposalRewards(proposer []byte) types.Error {
 	if err := u.SetPoolAmount(typesUtil.FeePoolName, big.NewInt(0)); err != nil {
 		return err
 	}
-	proposerCutPercentage, err := u.GetProposerPercentageOfFees()
+	exists, err := u.GetValidatorExists(proposer)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err := u.AddAccountAmount(proposer, feesAndRewardsCollected); err != nil {
+			return err
+		}
+		u.emitPoolEvent(typesUtil.EventType_EVENT_TYPE_REWARD, typesUtil.FeePoolName, proposer, feesAndRewardsCollected)
+		return nil
+	}
+	validator, err := u.GetValidator(proposer)
 	if err != nil {
 		return err
 	}
-	daoCutPercentage := 100 - proposerCutPercentage
-	if daoCutPercentage < 0 || daoCutPercentage > 100 {
-		return types.ErrInvalidProposerCutPercentage()
+	toDelegators, err := u.RewardDelegators(validator, feesAndRewardsCollected)
+	if err != nil {
+		return err
 	}
-	amountToProposerFloat := new(big.Float).SetInt(feesAndRewardsCollected)
-	amountToProposerFloat.Mul(amountToProposerFloat, big.NewFloat(float64(proposerCutPercentage)))
-	amountToProposerFloat.Quo(amountToProposerFloat, big.NewFloat(100))
-	amountToProposer, _ := amountToProposerFloat.Int(nil)
-	amountToDAO := feesAndRewardsCollected.Sub(feesAndRewardsCollected, amountToProposer)
-	if err := u.AddAccountAmount(proposer, amountToProposer); err != nil {
+	if err := u.AddPoolAmount(typesUtil.ValidatorStakePoolName, toDelegators); err != nil {
 		return err
 	}
-	if err := u.AddPoolAmount(typesUtil.DAOPoolName, amountToDAO); err != nil {
+	toProposer := feesAndRewardsCollected.Sub(feesAndRewardsCollected, toDelegators)
+	if err := u.AddAccountAmount(proposer, toProposer); err != nil {
 		return err
 	}
+	u.emitActorEvent(typesUtil.EventType_EVENT_TYPE_REWARD, typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, proposer, toProposer)
+	// the delegators' part stays in the VALIDATOR_STAKE_POOL
+	u.emitPoolEvent(typesUtil.EventType_EVENT_TYPE_REWARD, typesUtil.ValidatorStakePoolName, proposer, toDelegators)
 	return nil
 }
 
+// HandleMessageDoubleSign slashes a validator that signed two conflicting votes for the same height, round and step of
+// this chain, and rewards the reporter with a percentage of the slashed stake. Each double sign may only be reported once
 func (u *UtilityContext) HandleMessageDoubleSign(message *typesUtil.MessageDoubleSign) types.Error {
+	// the message verifies both vote signatures and that they conflict
+	if err := message.ValidateBasic(); err != nil {
+		return err
+	}
 	latestHeight, err := u.GetLatestHeight()
 	if err != nil {
 		return err
 	}
+	if message.VoteA.Height > latestHeight {
+		return types.ErrInvalidBlockHeight()
+	}
 	evidenceAge := latestHeight - message.VoteA.Height
 	maxEvidenceAge, err := u.GetMaxEvidenceAgeInBlocks()
 	if err != nil {
@@ -274,60 +378,113 @@ func (u *UtilityContext) HandleMessageDoubleSign(message *typesUtil.MessageDoubl
 	if evidenceAge > int64(maxEvidenceAge) {
 		return types.ErrMaxEvidenceAge()
 	}
-	pk, er := crypto.NewPublicKeyFromBytes(message.VoteB.PublicKey)
+	// votes are only evidence on the chain of the blocks they are for
+	chainId, err := u.GetChainId()
+	if err != nil {
+		return err
+	}
+	for _, vote := range []*typesUtil.Vote{message.VoteA, message.VoteB} {
+		if vote.ChainId() != chainId {
+			return types.ErrWrongChainId(vote.ChainId(), chainId)
+		}
+	}
+	pk, er := crypto.NewPublicKeyFromBytes(message.VoteA.PublicKey)
 	if er != nil {
 		return types.ErrNewPublicKeyFromBytes(er)
 	}
 	doubleSigner := pk.Address()
+	if err := u.validateStakedValidatorAtHeight(doubleSigner, message.VoteA.Height); err != nil {
+		return err
+	}
+	store := u.Store()
+	exists, er := store.GetDoubleSignEvidenceExists(doubleSigner, message.VoteA.Height, message.VoteA.Round)
+	if er != nil {
+		return types.ErrGetEvidence(er)
+	}
+	if exists {
+		return types.ErrDuplicateEvidence()
+	}
+	if er := store.SetDoubleSignEvidence(doubleSigner, message.VoteA.Height, message.VoteA.Round); er != nil {
+		return types.ErrSetEvidence(er)
+	}
 	// burn validator for double signing blocks
 	burnPercentage, err := u.GetDoubleSignBurnPercentage()
 	if err != nil {
 		return err
 	}
-	if err := u.BurnValidator(doubleSigner, burnPercentage); err != nil {
+	// the reporter is rewarded with a cut of the slashed stake, which is burned otherwise
+	rewardPercentage, err := u.GetDoubleSignReporterRewardPercentage()
+	if err != nil {
 		return err
 	}
-	return nil
+	reward := &slashReward{recipient: message.ReporterAddress, percentage: rewardPercentage}
+	if _, err := u.slashValidator(doubleSigner, burnPercentage, reward); err != nil {
+		return err
+	}
+	// the tokens that began unbonding from the validator after the double sign are slashed as well
+	_, err = u.slashUnbondingStakes(doubleSigner, message.VoteA.Height, burnPercentage, reward)
+	return err
 }
 
-func (u *UtilityContext) BurnValidator(address []byte, percentage int) types.Error {
+// validateStakedValidatorAtHeight checks that `address` was a staked validator in the state at `height`
+func (u *UtilityContext) validateStakedValidatorAtHeight(address []byte, height int64) types.Error {
+	store := u.Store()
+	validators, er := store.GetAllValidators(height)
+	if er != nil {
+		return types.ErrGetAllValidators(er)
+	}
+	for _, validator := range validators {
+		if bytes.Equal(validator.Address, address) && validator.Status == typesUtil.StakedStatus {
+			return nil
+		}
+	}
+	return types.ErrNotValidatorAtHeight(address, height)
+}
+
+// BurnValidator burns `percentage` of the validator's stake and of the tokens delegated to it, and returns the
+// amount burned
+func (u *UtilityContext) BurnValidator(address []byte, percentage int) (burned *big.Int, err types.Error) {
+	return u.slashValidator(address, percentage, nil)
+}
+
+// slashValidator takes `percentage` of the validator's stake and of the tokens delegated to it, pays the `reward` out
+// of them and burns the rest. It returns the amount slashed
+func (u *UtilityContext) slashValidator(address []byte, percentage int, reward *slashReward) (slashed *big.Int, err types.Error) {
 	tokens, err := u.GetValidatorStakedTokens(address)
 	if err != nil {
-		return err
-	}
-	zeroBigInt := big.NewInt(0)
-	tokensFloat := new(big.Float).SetInt(tokens)
-	tokensFloat.Mul(tokensFloat, big.NewFloat(float64(percentage)))
-	tokensFloat.Quo(tokensFloat, big.NewFloat(100))
-	truncatedTokens, _ := tokensFloat.Int(nil)
-	if truncatedTokens.Cmp(zeroBigInt) == -1 {
-		truncatedTokens = zeroBigInt
+		return nil, err
 	}
+	truncatedTokens := types.PercentageOf(tokens, percentage)
 	newTokensAfterBurn := big.NewInt(0).Sub(tokens, truncatedTokens)
 	// remove from pool
-	if err := u.SubPoolAmount(typesUtil.ValidatorStakePoolName, types.BigIntToString(truncatedTokens)); err != nil {
-		return err
+	if err := u.slashFromPool(typesUtil.ValidatorStakePoolName, address, truncatedTokens, reward); err != nil {
+		return nil, err
 	}
 	// remove from validator
 	if err := u.SetValidatorStakedTokens(address, newTokensAfterBurn); err != nil {
-		return err
+		return nil, err
 	}
 	// check to see if they fell below minimum stake
 	minStake, err := u.GetValidatorMinimumStake()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// fell below minimum stake
-	if minStake.Cmp(truncatedTokens) == 1 {
+	if minStake.Cmp(newTokensAfterBurn) == 1 {
 		unstakingHeight, err := u.CalculateValidatorUnstakingHeight()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := u.SetValidatorUnstakingHeightAndStatus(address, unstakingHeight); err != nil {
-			return err
+			return nil, err
 		}
+		u.emitStatusEvent(typesUtil.EventType_EVENT_TYPE_BEGIN_UNSTAKE, typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, address, nil, typesUtil.StatusCause_STATUS_CAUSE_SLASH)
 	}
-	return nil
+	slashedDelegations, err := u.slashDelegators(address, percentage, reward)
+	if err != nil {
+		return nil, err
+	}
+	return truncatedTokens.Add(truncatedTokens, slashedDelegations), nil
 }
 
 func (u *UtilityContext) GetValidatorExists(address []byte) (bool, types.Error) {
@@ -384,13 +541,42 @@ func (u *UtilityContext) UnstakeValidatorsPausedBefore(pausedBeforeHeight int64)
 	if err != nil {
 		return err
 	}
+	wasUnstaking, err := u.getUnstakingValidators()
+	if err != nil {
+		return err
+	}
 	er := store.SetValidatorsStatusAndUnstakingHeightPausedBefore(pausedBeforeHeight, unstakingHeight, typesUtil.UnstakingStatus)
 	if er != nil {
 		return types.ErrSetStatusPausedBefore(er, pausedBeforeHeight)
 	}
+	unstaking, err := u.getUnstakingValidators()
+	if err != nil {
+		return err
+	}
+	u.emitBeginUnstakeEvents(typesUtil.ActorType_ACTOR_TYPE_VALIDATOR, wasUnstaking, unstaking)
 	return nil
 }
 
+// getUnstakingValidators returns the addresses of the unstaking validators, in the order of the store
+func (u *UtilityContext) getUnstakingValidators() ([][]byte, types.Error) {
+	store := u.Store()
+	height, er := store.GetHeight()
+	if er != nil {
+		return nil, types.ErrGetLatestHeight(er)
+	}
+	validators, er := store.GetAllValidators(height)
+	if er != nil {
+		return nil, types.ErrGetAllValidators(er)
+	}
+	var unstaking [][]byte
+	for _, validator := range validators {
+		if validator.Status == typesUtil.UnstakingStatus {
+			unstaking = append(unstaking, validator.Address)
+		}
+	}
+	return unstaking, nil
+}
+
 func (u *UtilityContext) GetValidatorStatus(address []byte) (int, types.Error) {
 	store := u.Store()
 	status, er := store.GetValidatorStatus(address)
@@ -477,6 +663,39 @@ func (u *UtilityContext) SetValidatorStakedTokens(address []byte, tokens *big.In
 	return nil
 }
 
+func (u *UtilityContext) GetValidator(address []byte) (*typesGenesis.Validator, types.Error) {
+	exists, err := u.GetValidatorExists(address)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, types.ErrNotExists()
+	}
+	store := u.Store()
+	validator, _, er := store.GetValidator(address)
+	if er != nil {
+		return nil, types.ErrGetExists(er)
+	}
+	return validator, nil
+}
+
+func (u *UtilityContext) SetValidatorCommission(address []byte, commission int32) types.Error {
+	store := u.Store()
+	if er := store.SetValidatorCommission(address, int(commission)); er != nil {
+		return types.ErrSetCommission(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) SetValidatorDelegatedTokensAndShares(address []byte, tokens, shares *big.Int) types.Error {
+	store := u.Store()
+	er := store.SetValidatorDelegatedTokensAndShares(address, types.BigIntToString(tokens), types.BigIntToString(shares))
+	if er != nil {
+		return types.ErrSetValidatorStakedTokens(er)
+	}
+	return nil
+}
+
 func (u *UtilityContext) SetValidatorPauseHeightAndMissedBlocks(address []byte, pauseHeight int64, missedBlocks int) types.Error {
 	store := u.Store()
 	if err := store.SetValidatorPauseHeightAndMissedBlocks(address, pauseHeight, missedBlocks); err != nil {
@@ -518,6 +737,17 @@ func (u *UtilityContext) GetMessageUnstakeValidatorSignerCandidates(msg *typesUt
 	return candidates, nil
 }
 
+func (u *UtilityContext) GetMessagePartialUnstakeValidatorSignerCandidates(msg *typesUtil.MessagePartialUnstakeValidator) ([][]byte, types.Error) {
+	output, err := u.GetValidatorOutputAddress(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Address)
+	return candidates, nil
+}
+
 func (u *UtilityContext) GetMessageUnpauseValidatorSignerCandidates(msg *typesUtil.MessageUnpauseValidator) ([][]byte, types.Error) {
 	output, err := u.GetValidatorOutputAddress(msg.Address)
 	if err != nil {
@@ -0,0 +1,156 @@
+package utility
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/types"
+	typesGenesis "github.com/pokt-network/pocket/shared/types/genesis"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// A vesting account holds tokens that are locked by a schedule (see `VestingSchedule`). The unvested part of the
+// balance can't be sent or pay fees, but it may be staked or delegated as long as the tokens come back to the
+// vesting account, where they are locked again until they vest
+
+func (u *UtilityContext) HandleMessageCreateVestingAccount(message *typesUtil.MessageCreateVestingAccount) types.Error {
+	amount, err := types.StringToBigInt(message.Amount)
+	if err != nil {
+		return err
+	}
+	// a negative amount would move tokens from the recipient to the sender
+	if amount.Sign() <= 0 {
+		return types.ErrInvalidAmount()
+	}
+	vesting, err := u.GetAccountVesting(message.ToAddress)
+	if err != nil {
+		return err
+	}
+	if vesting != nil {
+		return types.ErrVestingExists()
+	}
+	if err := u.SubtractAccountAmount(message.FromAddress, amount); err != nil {
+		return err
+	}
+	if err := u.AddAccountAmount(message.ToAddress, amount); err != nil {
+		return err
+	}
+	latestHeight, err := u.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	if err := u.SetAccountVesting(message.ToAddress, &typesGenesis.VestingSchedule{
+		LockedAmount: message.Amount,
+		StartHeight:  latestHeight,
+		CliffHeight:  latestHeight + message.CliffBlocks,
+		EndHeight:    latestHeight + message.VestingBlocks,
+	}); err != nil {
+		return err
+	}
+	u.emitEvent(&typesUtil.Event{
+		Type:      typesUtil.EventType_EVENT_TYPE_CREATE_VESTING_ACCOUNT,
+		Address:   message.FromAddress,
+		Recipient: message.ToAddress,
+		Amount:    types.BigIntToString(amount),
+	})
+	return nil
+}
+
+func (u *UtilityContext) GetMessageCreateVestingAccountSignerCandidates(msg *typesUtil.MessageCreateVestingAccount) ([][]byte, types.Error) {
+	return [][]byte{msg.FromAddress}, nil
+}
+
+// AccountBalance is the balance of an account split by whether it can be spent
+type AccountBalance struct {
+	Amount    *big.Int // the whole balance, locked tokens included
+	Vested    *big.Int // the part of the vesting schedule released so far
+	Unvested  *big.Int // the part of the vesting schedule that is still locked
+	Spendable *big.Int // the part of the balance that isn't locked
+}
+
+func (u *UtilityContext) GetAccountBalance(address []byte) (*AccountBalance, types.Error) {
+	amount, err := u.GetAccountAmount(address)
+	if err != nil {
+		return nil, err
+	}
+	balance := &AccountBalance{
+		Amount:   amount,
+		Vested:   big.NewInt(0),
+		Unvested: big.NewInt(0),
+	}
+	vesting, err := u.GetAccountVesting(address)
+	if err != nil {
+		return nil, err
+	}
+	if vesting != nil {
+		latestHeight, err := u.GetLatestHeight()
+		if err != nil {
+			return nil, err
+		}
+		if balance.Unvested, err = vesting.Unvested(latestHeight); err != nil {
+			return nil, err
+		}
+		locked, err := types.StringToBigInt(vesting.LockedAmount)
+		if err != nil {
+			return nil, err
+		}
+		balance.Vested = locked.Sub(locked, balance.Unvested)
+	}
+	// the unvested tokens may be staked, so less than all of them may be in the account
+	balance.Spendable = new(big.Int).Sub(amount, balance.Unvested)
+	if balance.Spendable.Sign() < 0 {
+		balance.Spendable = big.NewInt(0)
+	}
+	return balance, nil
+}
+
+// GetSpendableAmount returns the balance of the account less its unvested tokens
+func (u *UtilityContext) GetSpendableAmount(address []byte) (*big.Int, types.Error) {
+	balance, err := u.GetAccountBalance(address)
+	if err != nil {
+		return nil, err
+	}
+	return balance.Spendable, nil
+}
+
+// checkLockedStake allows staking more than the spendable amount of the signer only if the stake returns to the
+// signer, so unstaking can't unlock unvested tokens
+func (u *UtilityContext) checkLockedStake(signer, output []byte, amount *big.Int) types.Error {
+	spendable, err := u.GetSpendableAmount(signer)
+	if err != nil {
+		return err
+	}
+	if amount.Cmp(spendable) > 0 && !bytes.Equal(signer, output) {
+		return types.ErrLockedStakeOutput()
+	}
+	return nil
+}
+
+// GetAccountVesting returns nil if the account has no vesting schedule
+func (u *UtilityContext) GetAccountVesting(address []byte) (*typesGenesis.VestingSchedule, types.Error) {
+	store := u.Store()
+	bz, er := store.GetAccountVesting(address)
+	if er != nil {
+		return nil, types.ErrGetVesting(er)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	vesting := &typesGenesis.VestingSchedule{}
+	if er := u.Codec().Unmarshal(bz, vesting); er != nil {
+		return nil, types.ErrProtoUnmarshal(er)
+	}
+	return vesting, nil
+}
+
+func (u *UtilityContext) SetAccountVesting(address []byte, vesting *typesGenesis.VestingSchedule) types.Error {
+	store := u.Store()
+	bz, er := u.Codec().Marshal(vesting)
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	if er := store.SetAccountVesting(address, bz); er != nil {
+		return types.ErrSetVesting(er)
+	}
+	return nil
+}